@@ -18,13 +18,20 @@ import (
 	"github.com/cnpf/feeder-backend/graph"
 	"github.com/cnpf/feeder-backend/graph/generated"
 	"github.com/cnpf/feeder-backend/graph/resolver"
+	"github.com/cnpf/feeder-backend/internal/api"
 	"github.com/cnpf/feeder-backend/internal/auth"
 	"github.com/cnpf/feeder-backend/internal/domain"
+	"github.com/cnpf/feeder-backend/internal/notify"
 	"github.com/cnpf/feeder-backend/internal/repository/mongodb"
 	"github.com/cnpf/feeder-backend/internal/usecase"
 )
 
-const defaultPort = "4000"
+const (
+	defaultPort           = "4000"
+	trashPurgeInterval    = time.Hour
+	reportPublishInterval = time.Minute
+	uploadCleanupInterval = time.Hour
+)
 
 func main() {
 	// Load .env file
@@ -47,14 +54,47 @@ func main() {
 	}
 	log.Println("Connected to MongoDB")
 
+	// Ensure indexes (geo index for venues, etc.)
+	if err := mongodb.EnsureIndexes(context.Background(), db); err != nil {
+		log.Printf("Failed to ensure MongoDB indexes: %v", err)
+	}
+
+	// Give IDs to report photos stored before photos had them
+	if err := mongodb.AssignReportPhotoIDs(context.Background(), db); err != nil {
+		log.Printf("Failed to assign report photo IDs: %v", err)
+	}
+
+	// Count the storage of users who stored files before storage quotas existed
+	if err := mongodb.InitStorageUsage(context.Background(), db); err != nil {
+		log.Printf("Failed to initialize storage usage: %v", err)
+	}
+
 	// Initialize repositories (infrastructure layer)
 	userRepo := mongodb.NewUserRepository(db)
 	reportRepo := mongodb.NewReportRepository(db)
 	competitionRepo := mongodb.NewCompetitionRepository(db)
 	registrationRepo := mongodb.NewRegistrationRepository(db)
+	venueRepo := mongodb.NewVenueRepository(db)
+	resultRepo := mongodb.NewResultRepository(db)
+	penaltyRepo := mongodb.NewPenaltyRepository(db)
+	checkInRepo := mongodb.NewCheckInRepository(db)
+	protestRepo := mongodb.NewProtestRepository(db)
+	notificationRepo := mongodb.NewNotificationRepository(db)
+	templateRepo := mongodb.NewCompetitionTemplateRepository(db)
+	revisionRepo := mongodb.NewRevisionRepository(db)
+	commentRepo := mongodb.NewCommentRepository(db)
+	reactionRepo := mongodb.NewReactionRepository(db)
+	reportViewRepo := mongodb.NewReportViewRepository(db)
+	uploadRepo := mongodb.NewUploadRepository(db)
+	txCtx, cancelTx := context.WithTimeout(context.Background(), 10*time.Second)
+	txManager, err := mongodb.NewTxManager(txCtx, db)
+	cancelTx()
+	if err != nil {
+		log.Fatalf("Failed to initialize transactions: %v", err)
+	}
 
 	// Initialize use case (application layer) - uses repository interfaces
-	useCase := usecase.NewUseCase(userRepo, reportRepo, competitionRepo, registrationRepo)
+	useCase := usecase.NewUseCase(userRepo, reportRepo, competitionRepo, registrationRepo, venueRepo, resultRepo, penaltyRepo, checkInRepo, protestRepo, notificationRepo, templateRepo, revisionRepo, commentRepo, reactionRepo, reportViewRepo, uploadRepo, txManager, notify.NewMailerFromEnv(), time.Duration(cfg.TrashRetentionDays)*24*time.Hour, cfg.StorageLimits)
 
 	// Initialize resolver (presentation layer) - uses use case
 	// TEMPORARY: Passing repositories for backward compatibility during migration
@@ -79,9 +119,9 @@ func main() {
 
 	router.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{corsOrigin},
-		AllowMethods:     []string{"GET", "POST", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization"},
-		ExposeHeaders:    []string{"Content-Length"},
+		AllowMethods:     []string{"GET", "POST", "HEAD", "PATCH", "DELETE", "OPTIONS"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "Tus-Resumable", "Upload-Length", "Upload-Offset", "Upload-Metadata"},
+		ExposeHeaders:    []string{"Content-Length", "Location", "Tus-Resumable", "Tus-Version", "Tus-Extension", "Tus-Max-Size", "Upload-Offset", "Upload-Length", "Upload-Expires"},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 	}))
@@ -98,6 +138,9 @@ func main() {
 	// GraphQL endpoint
 	router.POST("/graphql", graphqlHandler(resolver))
 
+	// Plain HTTP endpoints (calendar feeds, downloads)
+	api.NewHandler(useCase).RegisterRoutes(router)
+
 	// Get port
 	port := cfg.Port
 	if port == "" {
@@ -118,6 +161,13 @@ func main() {
 		}
 	}()
 
+	// Purge items whose trash retention period has ended, publish scheduled reports and delete abandoned uploads
+	purgeCtx, stopPurge := context.WithCancel(context.Background())
+	defer stopPurge()
+	go runTrashPurge(purgeCtx, useCase)
+	go runScheduledPublishing(purgeCtx, useCase)
+	go runUploadCleanup(purgeCtx, useCase)
+
 	// Wait for interrupt signal
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -136,6 +186,48 @@ func main() {
 	log.Println("Server exited")
 }
 
+// runTrashPurge purges the trash on startup and then every hour until ctx is cancelled
+func runTrashPurge(ctx context.Context, useCase usecase.UseCase) {
+	ticker := time.NewTicker(trashPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := useCase.PurgeTrash(ctx)
+		if err != nil {
+			log.Printf("Failed to purge trash: %v", err)
+		} else if purged > 0 {
+			log.Printf("Purged %d item(s) from the trash", purged)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runScheduledPublishing publishes scheduled reports whose time has come, every minute until ctx is cancelled
+func runScheduledPublishing(ctx context.Context, useCase usecase.UseCase) {
+	ticker := time.NewTicker(reportPublishInterval)
+	defer ticker.Stop()
+
+	for {
+		published, err := useCase.PublishScheduledReports(ctx)
+		if err != nil {
+			log.Printf("Failed to publish scheduled reports: %v", err)
+		} else if published > 0 {
+			log.Printf("Published %d scheduled report(s)", published)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 func playgroundHandler() gin.HandlerFunc {
 	h := playground.Handler("GraphQL Playground", "/graphql")
 	return func(c *gin.Context) {
@@ -160,3 +252,24 @@ func graphqlHandler(resolver *resolver.Resolver) gin.HandlerFunc {
 		h.ServeHTTP(c.Writer, c.Request)
 	}
 }
+
+// runUploadCleanup deletes abandoned resumable uploads, every hour until ctx is cancelled
+func runUploadCleanup(ctx context.Context, useCase usecase.UseCase) {
+	ticker := time.NewTicker(uploadCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := useCase.PurgeExpiredUploads(ctx)
+		if err != nil {
+			log.Printf("Failed to delete expired uploads: %v", err)
+		} else if purged > 0 {
+			log.Printf("Deleted %d expired upload(s)", purged)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
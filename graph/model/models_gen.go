@@ -25,12 +25,34 @@ type ChatResponse struct {
 }
 
 type ChatResult struct {
-	ID          string  `json:"id"`
-	Type        string  `json:"type"`
-	Title       string  `json:"title"`
-	HasPhotos   bool    `json:"hasPhotos"`
-	PhotosCount int     `json:"photosCount"`
-	Location    *string `json:"location,omitempty"`
+	ID          string       `json:"id"`
+	Type        string       `json:"type"`
+	Title       string       `json:"title"`
+	HasPhotos   bool         `json:"hasPhotos"`
+	PhotosCount int          `json:"photosCount"`
+	Location    *string      `json:"location,omitempty"`
+	Competition *Competition `json:"competition,omitempty"`
+}
+
+type CheckIn struct {
+	Registration *Registration `json:"registration"`
+	Tour         int           `json:"tour"`
+	JudgeID      string        `json:"judgeId"`
+	CheckedInAt  scalars.Time  `json:"checkedInAt"`
+}
+
+type CheckInResult struct {
+	CheckIn          *CheckIn `json:"checkIn"`
+	AlreadyCheckedIn bool     `json:"alreadyCheckedIn"`
+}
+
+type CheckInStatus struct {
+	CompetitionID string          `json:"competitionId"`
+	Tour          int             `json:"tour"`
+	Total         int             `json:"total"`
+	ArrivedCount  int             `json:"arrivedCount"`
+	Arrived       []*CheckIn      `json:"arrived"`
+	Missing       []*Registration `json:"missing"`
 }
 
 type Coach struct {
@@ -43,51 +65,228 @@ type CoachInput struct {
 	LastName  string `json:"lastName"`
 }
 
+type Comment struct {
+	ID        string        `json:"id"`
+	ReportID  string        `json:"reportId"`
+	ParentID  *string       `json:"parentId,omitempty"`
+	AuthorID  string        `json:"authorId"`
+	Author    *Author       `json:"author"`
+	Text      string        `json:"text"`
+	Status    string        `json:"status"`
+	Deleted   bool          `json:"deleted"`
+	CanEdit   bool          `json:"canEdit"`
+	EditedAt  *scalars.Time `json:"editedAt,omitempty"`
+	CreatedAt scalars.Time  `json:"createdAt"`
+}
+
+type CommentInput struct {
+	ReportID string  `json:"reportId"`
+	ParentID *string `json:"parentId,omitempty"`
+	Text     string  `json:"text"`
+}
+
 type Competition struct {
-	ID               string        `json:"id"`
-	Title            string        `json:"title"`
-	StartDate        scalars.Time  `json:"startDate"`
-	EndDate          scalars.Time  `json:"endDate"`
-	Location         string        `json:"location"`
-	Tours            []*Tour       `json:"tours"`
-	OpeningDate      *scalars.Time `json:"openingDate,omitempty"`
-	OpeningTime      *string       `json:"openingTime,omitempty"`
-	IndividualFormat bool          `json:"individualFormat"`
-	TeamFormat       bool          `json:"teamFormat"`
-	Fee              *float64      `json:"fee,omitempty"`
-	TeamLimit        *int          `json:"teamLimit,omitempty"`
-	Regulations      *string       `json:"regulations,omitempty"`
-	CreatedAt        *scalars.Time `json:"createdAt,omitempty"`
-	UpdatedAt        *scalars.Time `json:"updatedAt,omitempty"`
+	ID                   string            `json:"id"`
+	Title                string            `json:"title"`
+	StartDate            scalars.Time      `json:"startDate"`
+	EndDate              scalars.Time      `json:"endDate"`
+	Location             string            `json:"location"`
+	VenueID              *string           `json:"venueId,omitempty"`
+	Venue                *Venue            `json:"venue,omitempty"`
+	Tours                []*Tour           `json:"tours"`
+	OpeningDate          *scalars.Time     `json:"openingDate,omitempty"`
+	OpeningTime          *string           `json:"openingTime,omitempty"`
+	IndividualFormat     bool              `json:"individualFormat"`
+	TeamFormat           bool              `json:"teamFormat"`
+	Fee                  *float64          `json:"fee,omitempty"`
+	TeamLimit            *int              `json:"teamLimit,omitempty"`
+	Regulations          *string           `json:"regulations,omitempty"`
+	JudgeIds             []string          `json:"judgeIds"`
+	ProtestWindowMinutes *int              `json:"protestWindowMinutes,omitempty"`
+	Eligibility          *EligibilityRules `json:"eligibility,omitempty"`
+	TeamRules            *TeamRules        `json:"teamRules"`
+	Reports              []*Report         `json:"reports"`
+	CreatedAt            *scalars.Time     `json:"createdAt,omitempty"`
+	UpdatedAt            *scalars.Time     `json:"updatedAt,omitempty"`
+	DeletedAt            *scalars.Time     `json:"deletedAt,omitempty"`
+	DeletedBy            *string           `json:"deletedBy,omitempty"`
 }
 
 type CompetitionInput struct {
-	Title            string       `json:"title"`
-	StartDate        string       `json:"startDate"`
-	EndDate          string       `json:"endDate"`
-	Location         string       `json:"location"`
-	Tours            []*TourInput `json:"tours"`
-	OpeningDate      *string      `json:"openingDate,omitempty"`
-	OpeningTime      *string      `json:"openingTime,omitempty"`
-	IndividualFormat bool         `json:"individualFormat"`
-	TeamFormat       bool         `json:"teamFormat"`
-	Fee              *string      `json:"fee,omitempty"`
-	TeamLimit        *string      `json:"teamLimit,omitempty"`
-	Regulations      *string      `json:"regulations,omitempty"`
+	Title                string                 `json:"title"`
+	StartDate            string                 `json:"startDate"`
+	EndDate              string                 `json:"endDate"`
+	Location             string                 `json:"location"`
+	VenueID              *string                `json:"venueId,omitempty"`
+	Tours                []*TourInput           `json:"tours"`
+	OpeningDate          *string                `json:"openingDate,omitempty"`
+	OpeningTime          *string                `json:"openingTime,omitempty"`
+	IndividualFormat     bool                   `json:"individualFormat"`
+	TeamFormat           bool                   `json:"teamFormat"`
+	Fee                  *string                `json:"fee,omitempty"`
+	TeamLimit            *string                `json:"teamLimit,omitempty"`
+	Regulations          *string                `json:"regulations,omitempty"`
+	ProtestWindowMinutes *int                   `json:"protestWindowMinutes,omitempty"`
+	Eligibility          *EligibilityRulesInput `json:"eligibility,omitempty"`
+	TeamRules            *TeamRulesInput        `json:"teamRules,omitempty"`
+}
+
+type CompetitionPrefill struct {
+	Title                string            `json:"title"`
+	StartDate            string            `json:"startDate"`
+	EndDate              string            `json:"endDate"`
+	Location             string            `json:"location"`
+	VenueID              *string           `json:"venueId,omitempty"`
+	Tours                []*TourPrefill    `json:"tours"`
+	OpeningDate          *string           `json:"openingDate,omitempty"`
+	OpeningTime          *string           `json:"openingTime,omitempty"`
+	IndividualFormat     bool              `json:"individualFormat"`
+	TeamFormat           bool              `json:"teamFormat"`
+	Fee                  *string           `json:"fee,omitempty"`
+	TeamLimit            *string           `json:"teamLimit,omitempty"`
+	Regulations          *string           `json:"regulations,omitempty"`
+	ProtestWindowMinutes *int              `json:"protestWindowMinutes,omitempty"`
+	Eligibility          *EligibilityRules `json:"eligibility,omitempty"`
+	TeamRules            *TeamRules        `json:"teamRules,omitempty"`
+}
+
+type CompetitionResultSummary struct {
+	Competition       *Competition  `json:"competition"`
+	Registration      *Registration `json:"registration"`
+	Place             *int          `json:"place,omitempty"`
+	TotalParticipants int           `json:"totalParticipants"`
+	TotalWeight       int           `json:"totalWeight"`
+	TotalFish         int           `json:"totalFish"`
+	Disqualified      bool          `json:"disqualified"`
+}
+
+type CompetitionTemplate struct {
+	ID                   string            `json:"id"`
+	Name                 string            `json:"name"`
+	Title                string            `json:"title"`
+	Location             string            `json:"location"`
+	VenueID              *string           `json:"venueId,omitempty"`
+	DurationDays         int               `json:"durationDays"`
+	Tours                []*TemplateTour   `json:"tours"`
+	OpeningOffsetDays    *int              `json:"openingOffsetDays,omitempty"`
+	OpeningTime          *string           `json:"openingTime,omitempty"`
+	IndividualFormat     bool              `json:"individualFormat"`
+	TeamFormat           bool              `json:"teamFormat"`
+	Fee                  *float64          `json:"fee,omitempty"`
+	TeamLimit            *int              `json:"teamLimit,omitempty"`
+	Regulations          *string           `json:"regulations,omitempty"`
+	ProtestWindowMinutes *int              `json:"protestWindowMinutes,omitempty"`
+	Eligibility          *EligibilityRules `json:"eligibility,omitempty"`
+	TeamRules            *TeamRules        `json:"teamRules,omitempty"`
+	CreatedAt            *scalars.Time     `json:"createdAt,omitempty"`
 }
 
 type CreateRegistrationInput struct {
-	CompetitionID string              `json:"competitionId"`
-	Type          string              `json:"type"`
-	TeamName      *string             `json:"teamName,omitempty"`
-	Participants  []*ParticipantInput `json:"participants"`
-	Coach         *CoachInput         `json:"coach,omitempty"`
+	CompetitionID             string              `json:"competitionId"`
+	Type                      string              `json:"type"`
+	TeamName                  *string             `json:"teamName,omitempty"`
+	Participants              []*ParticipantInput `json:"participants"`
+	Coach                     *CoachInput         `json:"coach,omitempty"`
+	Coaches                   []*CoachInput       `json:"coaches,omitempty"`
+	EligibilityOverrideReason *string             `json:"eligibilityOverrideReason,omitempty"`
 }
 
 type CreateReportInput struct {
-	Title  string            `json:"title"`
-	Text   string            `json:"text"`
-	Photos []*graphql.Upload `json:"photos,omitempty"`
+	Title          string                `json:"title"`
+	Text           string                `json:"text"`
+	Photos         []*graphql.Upload     `json:"photos,omitempty"`
+	PhotoDetails   []*PhotoDetailsInput  `json:"photoDetails,omitempty"`
+	UploadedPhotos []*UploadedPhotoInput `json:"uploadedPhotos,omitempty"`
+	Status         *string               `json:"status,omitempty"`
+	PublishAt      *string               `json:"publishAt,omitempty"`
+	CompetitionID  *string               `json:"competitionId,omitempty"`
+	Tour           *int                  `json:"tour,omitempty"`
+	Tags           []string              `json:"tags,omitempty"`
+	Category       *string               `json:"category,omitempty"`
+}
+
+type Dashboard struct {
+	Upcoming                 []*MyRegistration           `json:"upcoming"`
+	RecentResults            []*CompetitionResultSummary `json:"recentResults"`
+	Reports                  []*Report                   `json:"reports"`
+	Drafts                   []*Report                   `json:"drafts"`
+	UnreadNotificationsCount int                         `json:"unreadNotificationsCount"`
+}
+
+type DecideProtestInput struct {
+	Upheld    bool   `json:"upheld"`
+	Decision  string `json:"decision"`
+	Weight    *int   `json:"weight,omitempty"`
+	FishCount *int   `json:"fishCount,omitempty"`
+}
+
+type DeletionEffect struct {
+	Entity string `json:"entity"`
+	Action string `json:"action"`
+	Count  int    `json:"count"`
+}
+
+type DeletionPreview struct {
+	Blocked         bool              `json:"blocked"`
+	BlockReasons    []string          `json:"blockReasons"`
+	Effects         []*DeletionEffect `json:"effects"`
+	RestorableUntil *scalars.Time     `json:"restorableUntil,omitempty"`
+}
+
+type EligibilityOverride struct {
+	AdminID    string       `json:"adminId"`
+	Reason     string       `json:"reason"`
+	Violations []string     `json:"violations"`
+	CreatedAt  scalars.Time `json:"createdAt"`
+}
+
+type EligibilityRules struct {
+	Category        *string `json:"category,omitempty"`
+	MinAge          *int    `json:"minAge,omitempty"`
+	MaxAge          *int    `json:"maxAge,omitempty"`
+	Gender          *string `json:"gender,omitempty"`
+	LicenseRequired bool    `json:"licenseRequired"`
+}
+
+type EligibilityRulesInput struct {
+	Category        *string `json:"category,omitempty"`
+	MinAge          *int    `json:"minAge,omitempty"`
+	MaxAge          *int    `json:"maxAge,omitempty"`
+	Gender          *string `json:"gender,omitempty"`
+	LicenseRequired *bool   `json:"licenseRequired,omitempty"`
+}
+
+type FieldChange struct {
+	Field    string  `json:"field"`
+	OldValue *string `json:"oldValue,omitempty"`
+	NewValue *string `json:"newValue,omitempty"`
+}
+
+type GalleryPhoto struct {
+	Photo       *ReportPhoto `json:"photo"`
+	ReportID    string       `json:"reportId"`
+	ReportTitle string       `json:"reportTitle"`
+	Tour        *int         `json:"tour,omitempty"`
+}
+
+type GeoPoint struct {
+	Lat float64 `json:"lat"`
+	Lon float64 `json:"lon"`
+}
+
+type ImportResult struct {
+	DryRun   bool               `json:"dryRun"`
+	Total    int                `json:"total"`
+	Valid    int                `json:"valid"`
+	Imported int                `json:"imported"`
+	Rows     []*ImportRowResult `json:"rows"`
+}
+
+type ImportRowResult struct {
+	Row   int     `json:"row"`
+	Ok    bool    `json:"ok"`
+	Error *string `json:"error,omitempty"`
+	ID    *string `json:"id,omitempty"`
 }
 
 type LoginInput struct {
@@ -98,23 +297,126 @@ type LoginInput struct {
 type Mutation struct {
 }
 
+type MyRegistration struct {
+	Registration  *Registration `json:"registration"`
+	Competition   *Competition  `json:"competition"`
+	PaymentStatus string        `json:"paymentStatus"`
+}
+
+type NearInput struct {
+	Lat      float64 `json:"lat"`
+	Lon      float64 `json:"lon"`
+	RadiusKm float64 `json:"radiusKm"`
+}
+
+type Notification struct {
+	ID         string       `json:"id"`
+	Type       string       `json:"type"`
+	Title      string       `json:"title"`
+	Message    string       `json:"message"`
+	EntityType *string      `json:"entityType,omitempty"`
+	EntityID   *string      `json:"entityId,omitempty"`
+	Read       bool         `json:"read"`
+	CreatedAt  scalars.Time `json:"createdAt"`
+}
+
 type Participant struct {
-	FirstName string `json:"firstName"`
-	LastName  string `json:"lastName"`
+	FirstName     string        `json:"firstName"`
+	LastName      string        `json:"lastName"`
+	BirthDate     *scalars.Time `json:"birthDate,omitempty"`
+	Gender        *string       `json:"gender,omitempty"`
+	LicenseNumber *string       `json:"licenseNumber,omitempty"`
+	Reserve       bool          `json:"reserve"`
+	Captain       bool          `json:"captain"`
 }
 
 type ParticipantInput struct {
-	FirstName string `json:"firstName"`
-	LastName  string `json:"lastName"`
+	FirstName     string  `json:"firstName"`
+	LastName      string  `json:"lastName"`
+	BirthDate     *string `json:"birthDate,omitempty"`
+	Gender        *string `json:"gender,omitempty"`
+	LicenseNumber *string `json:"licenseNumber,omitempty"`
+	Reserve       *bool   `json:"reserve,omitempty"`
+	Captain       *bool   `json:"captain,omitempty"`
+}
+
+type Penalty struct {
+	ID             string           `json:"id"`
+	CompetitionID  string           `json:"competitionId"`
+	RegistrationID string           `json:"registrationId"`
+	Tour           *int             `json:"tour,omitempty"`
+	Type           string           `json:"type"`
+	Reason         string           `json:"reason"`
+	JudgeID        string           `json:"judgeId"`
+	Deleted        bool             `json:"deleted"`
+	History        []*PenaltyChange `json:"history"`
+	CreatedAt      *scalars.Time    `json:"createdAt,omitempty"`
+	UpdatedAt      *scalars.Time    `json:"updatedAt,omitempty"`
+}
+
+type PenaltyChange struct {
+	Action    string       `json:"action"`
+	UserID    string       `json:"userId"`
+	Tour      *int         `json:"tour,omitempty"`
+	Type      string       `json:"type"`
+	Reason    string       `json:"reason"`
+	ChangedAt scalars.Time `json:"changedAt"`
+}
+
+type PenaltyInput struct {
+	RegistrationID string `json:"registrationId"`
+	Tour           *int   `json:"tour,omitempty"`
+	Type           string `json:"type"`
+	Reason         string `json:"reason"`
 }
 
 type Photo struct {
 	URL string `json:"url"`
 }
 
+type PhotoDetailsInput struct {
+	Caption      *string `json:"caption,omitempty"`
+	Photographer *string `json:"photographer,omitempty"`
+}
+
+type PhotoUpdateInput struct {
+	ID           string  `json:"id"`
+	Caption      *string `json:"caption,omitempty"`
+	Photographer *string `json:"photographer,omitempty"`
+}
+
+type Protest struct {
+	ID                   string        `json:"id"`
+	CompetitionID        string        `json:"competitionId"`
+	RegistrationID       string        `json:"registrationId"`
+	FiledBy              string        `json:"filedBy"`
+	TargetType           string        `json:"targetType"`
+	TargetID             string        `json:"targetId"`
+	TargetRegistrationID string        `json:"targetRegistrationId"`
+	Reason               string        `json:"reason"`
+	Status               string        `json:"status"`
+	Decision             *string       `json:"decision,omitempty"`
+	DecidedBy            *string       `json:"decidedBy,omitempty"`
+	DecidedAt            *scalars.Time `json:"decidedAt,omitempty"`
+	CreatedAt            *scalars.Time `json:"createdAt,omitempty"`
+}
+
+type ProtestInput struct {
+	RegistrationID string `json:"registrationId"`
+	TargetType     string `json:"targetType"`
+	TargetID       string `json:"targetId"`
+	Reason         string `json:"reason"`
+}
+
 type Query struct {
 }
 
+type ReactionCount struct {
+	Type    string `json:"type"`
+	Count   int    `json:"count"`
+	Reacted bool   `json:"reacted"`
+}
+
 type RegisterInput struct {
 	Email           string          `json:"email"`
 	Username        string          `json:"username"`
@@ -124,28 +426,124 @@ type RegisterInput struct {
 }
 
 type Registration struct {
-	ID            string         `json:"id"`
-	CompetitionID string         `json:"competitionId"`
-	UserID        string         `json:"userId"`
-	Type          string         `json:"type"`
-	TeamName      *string        `json:"teamName,omitempty"`
-	Participants  []*Participant `json:"participants"`
-	Coach         *Coach         `json:"coach,omitempty"`
-	CanEdit       bool           `json:"canEdit"`
-	CreatedAt     scalars.Time   `json:"createdAt"`
-	UpdatedAt     scalars.Time   `json:"updatedAt"`
+	ID                  string               `json:"id"`
+	CompetitionID       string               `json:"competitionId"`
+	UserID              string               `json:"userId"`
+	Type                string               `json:"type"`
+	TeamName            *string              `json:"teamName,omitempty"`
+	Participants        []*Participant       `json:"participants"`
+	Coach               *Coach               `json:"coach,omitempty"`
+	Coaches             []*Coach             `json:"coaches"`
+	Sector              *string              `json:"sector,omitempty"`
+	Peg                 *int                 `json:"peg,omitempty"`
+	CheckInCode         *string              `json:"checkInCode,omitempty"`
+	EligibilityOverride *EligibilityOverride `json:"eligibilityOverride,omitempty"`
+	PaidAt              *scalars.Time        `json:"paidAt,omitempty"`
+	CanEdit             bool                 `json:"canEdit"`
+	CreatedAt           scalars.Time         `json:"createdAt"`
+	UpdatedAt           scalars.Time         `json:"updatedAt"`
+	DeletedAt           *scalars.Time        `json:"deletedAt,omitempty"`
+	DeletedBy           *string              `json:"deletedBy,omitempty"`
 }
 
 type Report struct {
-	ID        string        `json:"id"`
-	Title     string        `json:"title"`
-	Text      string        `json:"text"`
-	CreatedAt *scalars.Time `json:"createdAt,omitempty"`
-	UpdatedAt *scalars.Time `json:"updatedAt,omitempty"`
-	AuthorID  string        `json:"authorId"`
-	Author    *Author       `json:"author"`
-	Photos    []*Photo      `json:"photos"`
-	CanEdit   bool          `json:"canEdit"`
+	ID            string           `json:"id"`
+	Title         string           `json:"title"`
+	Text          string           `json:"text"`
+	TextHTML      string           `json:"textHtml"`
+	CreatedAt     *scalars.Time    `json:"createdAt,omitempty"`
+	UpdatedAt     *scalars.Time    `json:"updatedAt,omitempty"`
+	AuthorID      string           `json:"authorId"`
+	Author        *Author          `json:"author"`
+	Photos        []*ReportPhoto   `json:"photos"`
+	CanEdit       bool             `json:"canEdit"`
+	CompetitionID *string          `json:"competitionId,omitempty"`
+	Competition   *Competition     `json:"competition,omitempty"`
+	Tour          *int             `json:"tour,omitempty"`
+	Tags          []string         `json:"tags"`
+	Category      *string          `json:"category,omitempty"`
+	CommentsCount int              `json:"commentsCount"`
+	Reactions     []*ReactionCount `json:"reactions"`
+	ViewCount     int              `json:"viewCount"`
+	Status        string           `json:"status"`
+	PublishAt     *scalars.Time    `json:"publishAt,omitempty"`
+	PublishedAt   *scalars.Time    `json:"publishedAt,omitempty"`
+	DeletedAt     *scalars.Time    `json:"deletedAt,omitempty"`
+	DeletedBy     *string          `json:"deletedBy,omitempty"`
+}
+
+type ReportCategory struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type ReportPhoto struct {
+	ID           string  `json:"id"`
+	URL          string  `json:"url"`
+	Caption      *string `json:"caption,omitempty"`
+	Photographer *string `json:"photographer,omitempty"`
+	Position     int     `json:"position"`
+}
+
+type RevertResult struct {
+	Report      *Report      `json:"report,omitempty"`
+	Competition *Competition `json:"competition,omitempty"`
+}
+
+type Revision struct {
+	ID         string         `json:"id"`
+	EntityType string         `json:"entityType"`
+	EntityID   string         `json:"entityId"`
+	Version    int            `json:"version"`
+	EditorID   string         `json:"editorId"`
+	Editor     *Author        `json:"editor"`
+	Changes    []*FieldChange `json:"changes"`
+	CreatedAt  scalars.Time   `json:"createdAt"`
+}
+
+type Standing struct {
+	Place        int           `json:"place"`
+	Registration *Registration `json:"registration"`
+	TourWeights  []int         `json:"tourWeights"`
+	TotalWeight  int           `json:"totalWeight"`
+	TotalFish    int           `json:"totalFish"`
+	Disqualified bool          `json:"disqualified"`
+	Penalties    []*Penalty    `json:"penalties"`
+}
+
+type StorageUsage struct {
+	Role            string `json:"role"`
+	Used            int    `json:"used"`
+	Quota           int    `json:"quota"`
+	MaxReportPhotos int    `json:"maxReportPhotos"`
+	MaxPhotoSize    int    `json:"maxPhotoSize"`
+	MaxAvatarSize   int    `json:"maxAvatarSize"`
+}
+
+type TagCount struct {
+	Tag   string `json:"tag"`
+	Count int    `json:"count"`
+}
+
+type TeamRules struct {
+	MinSize         int  `json:"minSize"`
+	MaxSize         int  `json:"maxSize"`
+	MaxReserves     int  `json:"maxReserves"`
+	MaxCoaches      int  `json:"maxCoaches"`
+	CaptainRequired bool `json:"captainRequired"`
+}
+
+type TeamRulesInput struct {
+	MinSize         int   `json:"minSize"`
+	MaxSize         int   `json:"maxSize"`
+	MaxReserves     *int  `json:"maxReserves,omitempty"`
+	MaxCoaches      *int  `json:"maxCoaches,omitempty"`
+	CaptainRequired *bool `json:"captainRequired,omitempty"`
+}
+
+type TemplateTour struct {
+	DayOffset int    `json:"dayOffset"`
+	Time      string `json:"time"`
 }
 
 type Tour struct {
@@ -158,6 +556,41 @@ type TourInput struct {
 	Time string `json:"time"`
 }
 
+type TourPrefill struct {
+	Date string `json:"date"`
+	Time string `json:"time"`
+}
+
+type TourResult struct {
+	ID             string        `json:"id"`
+	CompetitionID  string        `json:"competitionId"`
+	RegistrationID string        `json:"registrationId"`
+	Tour           int           `json:"tour"`
+	Weight         int           `json:"weight"`
+	FishCount      int           `json:"fishCount"`
+	UpdatedAt      *scalars.Time `json:"updatedAt,omitempty"`
+}
+
+type TourResultInput struct {
+	RegistrationID string `json:"registrationId"`
+	Tour           int    `json:"tour"`
+	Weight         int    `json:"weight"`
+	FishCount      int    `json:"fishCount"`
+}
+
+type Trash struct {
+	RetentionDays int             `json:"retentionDays"`
+	Competitions  []*Competition  `json:"competitions"`
+	Reports       []*Report       `json:"reports"`
+	Registrations []*Registration `json:"registrations"`
+}
+
+type UpdatePenaltyInput struct {
+	Tour   *int   `json:"tour,omitempty"`
+	Type   string `json:"type"`
+	Reason string `json:"reason"`
+}
+
 type UpdateProfileInput struct {
 	Username     *string         `json:"username,omitempty"`
 	RemoveAvatar *bool           `json:"removeAvatar,omitempty"`
@@ -165,17 +598,35 @@ type UpdateProfileInput struct {
 }
 
 type UpdateRegistrationInput struct {
-	TeamName     *string             `json:"teamName,omitempty"`
-	Participants []*ParticipantInput `json:"participants"`
-	Coach        *CoachInput         `json:"coach,omitempty"`
+	TeamName                  *string             `json:"teamName,omitempty"`
+	Participants              []*ParticipantInput `json:"participants"`
+	Coach                     *CoachInput         `json:"coach,omitempty"`
+	Coaches                   []*CoachInput       `json:"coaches,omitempty"`
+	EligibilityOverrideReason *string             `json:"eligibilityOverrideReason,omitempty"`
 }
 
 type UpdateReportInput struct {
-	Title           *string           `json:"title,omitempty"`
-	Text            *string           `json:"text,omitempty"`
-	RemovePhoto     []int             `json:"removePhoto,omitempty"`
-	RemoveAllPhotos *bool             `json:"removeAllPhotos,omitempty"`
-	Photos          []*graphql.Upload `json:"photos,omitempty"`
+	Title             *string               `json:"title,omitempty"`
+	Text              *string               `json:"text,omitempty"`
+	RemovePhoto       []int                 `json:"removePhoto,omitempty"`
+	RemovePhotoIds    []string              `json:"removePhotoIds,omitempty"`
+	RemoveAllPhotos   *bool                 `json:"removeAllPhotos,omitempty"`
+	Photos            []*graphql.Upload     `json:"photos,omitempty"`
+	PhotoDetails      []*PhotoDetailsInput  `json:"photoDetails,omitempty"`
+	UploadedPhotos    []*UploadedPhotoInput `json:"uploadedPhotos,omitempty"`
+	UpdatePhotos      []*PhotoUpdateInput   `json:"updatePhotos,omitempty"`
+	PhotoOrder        []string              `json:"photoOrder,omitempty"`
+	CompetitionID     *string               `json:"competitionId,omitempty"`
+	Tour              *int                  `json:"tour,omitempty"`
+	UnlinkCompetition *bool                 `json:"unlinkCompetition,omitempty"`
+	Tags              []string              `json:"tags,omitempty"`
+	Category          *string               `json:"category,omitempty"`
+}
+
+type UploadedPhotoInput struct {
+	UploadID     string  `json:"uploadId"`
+	Caption      *string `json:"caption,omitempty"`
+	Photographer *string `json:"photographer,omitempty"`
 }
 
 type User struct {
@@ -186,3 +637,40 @@ type User struct {
 	HasAvatar bool    `json:"hasAvatar"`
 	AvatarURL *string `json:"avatarUrl,omitempty"`
 }
+
+type Venue struct {
+	ID         string         `json:"id"`
+	NameRu     string         `json:"nameRu"`
+	NameRo     string         `json:"nameRo"`
+	Location   *GeoPoint      `json:"location"`
+	WaterBody  *string        `json:"waterBody,omitempty"`
+	Sectors    []*VenueSector `json:"sectors"`
+	Directions *string        `json:"directions,omitempty"`
+	Photos     []*Photo       `json:"photos"`
+	CreatedAt  *scalars.Time  `json:"createdAt,omitempty"`
+	UpdatedAt  *scalars.Time  `json:"updatedAt,omitempty"`
+}
+
+type VenueInput struct {
+	NameRu          string              `json:"nameRu"`
+	NameRo          string              `json:"nameRo"`
+	Lat             float64             `json:"lat"`
+	Lon             float64             `json:"lon"`
+	WaterBody       *string             `json:"waterBody,omitempty"`
+	Sectors         []*VenueSectorInput `json:"sectors,omitempty"`
+	Directions      *string             `json:"directions,omitempty"`
+	RemoveAllPhotos *bool               `json:"removeAllPhotos,omitempty"`
+	Photos          []*graphql.Upload   `json:"photos,omitempty"`
+}
+
+type VenueSector struct {
+	Name    string `json:"name"`
+	PegFrom int    `json:"pegFrom"`
+	PegTo   int    `json:"pegTo"`
+}
+
+type VenueSectorInput struct {
+	Name    string `json:"name"`
+	PegFrom int    `json:"pegFrom"`
+	PegTo   int    `json:"pegTo"`
+}
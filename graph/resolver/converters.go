@@ -10,6 +10,7 @@ import (
 	"github.com/cnpf/feeder-backend/internal/auth"
 	"github.com/cnpf/feeder-backend/internal/domain/entity"
 	"github.com/cnpf/feeder-backend/internal/repository/mongodb"
+	"github.com/cnpf/feeder-backend/internal/usecase"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -132,17 +133,25 @@ func (r *Resolver) formatReport(ctx context.Context, reportDoc bson.M, currentUs
 	} else {
 		author = &model.Author{
 			ID:        authorID,
-			Username:  "unknown",
+			Username:  usecase.DeletedUserName,
 			HasAvatar: false,
 		}
 	}
 
 	// Format photos
-	photos := []*model.Photo{}
+	photos := []*model.ReportPhoto{}
 	if photosArray, ok := reportDoc["photos"].(bson.A); ok {
-		for i := range photosArray {
-			photos = append(photos, &model.Photo{
-				URL: fmt.Sprintf("/api/reports/%s/photos/%d", reportID, i),
+		for i, photo := range photosArray {
+			var photoID string
+			if photoDoc, ok := photo.(bson.M); ok {
+				if oid, ok := photoDoc["id"].(primitive.ObjectID); ok {
+					photoID = oid.Hex()
+				}
+			}
+			photos = append(photos, &model.ReportPhoto{
+				ID:       photoID,
+				URL:      fmt.Sprintf("/api/reports/%s/photos/%s", reportID, photoID),
+				Position: i,
 			})
 		}
 	}
@@ -320,16 +329,18 @@ func (r *Resolver) formatReportFromEntity(ctx context.Context, report *entity.Re
 	} else {
 		author = &model.Author{
 			ID:        report.AuthorID,
-			Username:  "unknown",
+			Username:  usecase.DeletedUserName,
 			HasAvatar: false,
 		}
 	}
 
 	// Format photos
-	photos := []*model.Photo{}
-	for i := range report.Photos {
-		photos = append(photos, &model.Photo{
-			URL: fmt.Sprintf("/api/reports/%s/photos/%d", report.ID, i),
+	photos := []*model.ReportPhoto{}
+	for i, photo := range report.Photos {
+		photos = append(photos, &model.ReportPhoto{
+			ID:       photo.ID,
+			URL:      fmt.Sprintf("/api/reports/%s/photos/%s", report.ID, photo.ID),
+			Position: i,
 		})
 	}
 
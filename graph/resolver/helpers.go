@@ -2,14 +2,19 @@ package resolver
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"os"
 	"strings"
 	"unicode"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/gin-gonic/gin"
 
 	"github.com/cnpf/feeder-backend/graph"
+	"github.com/cnpf/feeder-backend/graph/model"
 	"github.com/cnpf/feeder-backend/internal/auth"
+	"github.com/cnpf/feeder-backend/internal/usecase"
 )
 
 // GetGinContext extracts Gin context from GraphQL context
@@ -62,6 +67,107 @@ func clearAuthCookie(ctx context.Context) {
 	}
 }
 
+// toPhotoUploads converts GraphQL uploads to UseCase PhotoUpload values
+func toPhotoUploads(uploads []*graphql.Upload) []*usecase.PhotoUpload {
+	photos := make([]*usecase.PhotoUpload, 0, len(uploads))
+	for _, upload := range uploads {
+		if upload == nil {
+			continue
+		}
+		photos = append(photos, &usecase.PhotoUpload{
+			File:        upload.File,
+			Size:        upload.Size,
+			ContentType: upload.ContentType,
+		})
+	}
+	return photos
+}
+
+// toPhotoDetails converts the captions and photographer credits of uploaded photos
+func toPhotoDetails(inputs []*model.PhotoDetailsInput) []usecase.PhotoDetails {
+	details := make([]usecase.PhotoDetails, len(inputs))
+	for i, input := range inputs {
+		details[i] = usecase.PhotoDetails{Caption: input.Caption, Photographer: input.Photographer}
+	}
+	return details
+}
+
+// toUploadedPhotos converts references to completed resumable uploads with their details
+func toUploadedPhotos(inputs []*model.UploadedPhotoInput) []usecase.UploadedPhoto {
+	photos := make([]usecase.UploadedPhoto, len(inputs))
+	for i, input := range inputs {
+		photos[i] = usecase.UploadedPhoto{
+			UploadID:     input.UploadID,
+			PhotoDetails: usecase.PhotoDetails{Caption: input.Caption, Photographer: input.Photographer},
+		}
+	}
+	return photos
+}
+
+// toReportPhotoChanges converts the photo fields of a report update; photos are addressed by ID
+func toReportPhotoChanges(input model.UpdateReportInput) *usecase.ReportPhotosInput {
+	photos := &usecase.ReportPhotosInput{
+		Uploads:   toPhotoUploads(input.Photos),
+		Details:   toPhotoDetails(input.PhotoDetails),
+		Uploaded:  toUploadedPhotos(input.UploadedPhotos),
+		Remove:    input.RemovePhotoIds,
+		RemoveAt:  input.RemovePhoto,
+		RemoveAll: input.RemoveAllPhotos != nil && *input.RemoveAllPhotos,
+		Order:     input.PhotoOrder,
+	}
+	for _, update := range input.UpdatePhotos {
+		photos.Updates = append(photos.Updates, usecase.PhotoUpdate{
+			ID:           update.ID,
+			PhotoDetails: usecase.PhotoDetails{Caption: update.Caption, Photographer: update.Photographer},
+		})
+	}
+	return photos
+}
+
+// toReportLink converts the competition link fields of a report input; nil when none is set
+func toReportLink(competitionID *string, tour *int, unlink *bool) *usecase.ReportLinkInput {
+	if competitionID == nil && tour == nil && (unlink == nil || !*unlink) {
+		return nil
+	}
+	return &usecase.ReportLinkInput{
+		CompetitionID: competitionID,
+		Tour:          tour,
+		Unlink:        unlink != nil && *unlink,
+	}
+}
+
+// toReportTaxonomy converts the tags and category fields of a report input; nil when none is set
+func toReportTaxonomy(tags []string, category *string) *usecase.ReportTaxonomyInput {
+	if tags == nil && category == nil {
+		return nil
+	}
+	return &usecase.ReportTaxonomyInput{Tags: tags, Category: category}
+}
+
+// reportViewerKey identifies the reader of a report for view counting: the user, or the client address and browser
+// of anonymous readers. Only a hash is stored
+func reportViewerKey(ctx context.Context, userID string) string {
+	ginCtx := GetGinContext(ctx)
+	if ginCtx == nil {
+		return ""
+	}
+	viewer := "user:" + userID
+	if userID == "" {
+		viewer = "anon:" + ginCtx.ClientIP() + "|" + ginCtx.Request.UserAgent()
+	}
+	sum := sha256.Sum256([]byte(viewer))
+	return hex.EncodeToString(sum[:])
+}
+
+// toImportFile converts a GraphQL upload to a UseCase ImportFile
+func toImportFile(upload graphql.Upload) *usecase.ImportFile {
+	return &usecase.ImportFile{
+		File:     upload.File,
+		Size:     upload.Size,
+		FileName: upload.Filename,
+	}
+}
+
 // isAllowed checks if user is allowed to perform action
 func isAllowed(user *auth.CurrentUser, authorID string) bool {
 	if user == nil {
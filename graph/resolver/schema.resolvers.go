@@ -9,22 +9,40 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"math"
-	"strconv"
-	"strings"
-	"time"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/cnpf/feeder-backend/graph/generated"
 	"github.com/cnpf/feeder-backend/graph/model"
 	"github.com/cnpf/feeder-backend/internal/domain/entity"
-	apperrors "github.com/cnpf/feeder-backend/internal/errors"
 	"github.com/cnpf/feeder-backend/internal/gemini"
 	"github.com/cnpf/feeder-backend/internal/search"
 	"github.com/cnpf/feeder-backend/internal/usecase"
-	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+// Venue is the resolver for the venue field.
+func (r *competitionResolver) Venue(ctx context.Context, obj *model.Competition) (*model.Venue, error) {
+	if obj.VenueID == nil || *obj.VenueID == "" {
+		return nil, nil
+	}
+
+	venue, err := r.useCase.GetVenue(ctx, *obj.VenueID)
+	if err != nil {
+		return nil, nil // Venue was removed; competition still has its location string
+	}
+	return venue, nil
+}
+
+// Reports is the resolver for the reports field.
+func (r *competitionResolver) Reports(ctx context.Context, obj *model.Competition, limit *int) ([]*model.Report, error) {
+	userID := ""
+	if currentUser, err := getCurrentUserFromContext(ctx); err == nil && currentUser != nil {
+		userID = currentUser.ID
+	}
+
+	return r.useCase.GetReports(ctx, userID, limit, usecase.ReportsFilter{CompetitionID: &obj.ID})
+}
+
 // Register is the resolver for the register field.
 func (r *mutationResolver) Register(ctx context.Context, input model.RegisterInput) (*model.AuthResult, error) {
 	// Convert GraphQL upload to UseCase PhotoUpload
@@ -122,80 +140,24 @@ func (r *mutationResolver) CreateReport(ctx context.Context, input model.CreateR
 		return nil, fmt.Errorf("Не авторизован")
 	}
 
-	// Validate input
-	title := strings.TrimSpace(input.Title)
-	text := strings.TrimSpace(input.Text)
-
-	if len(title) < 3 || len(title) > 120 {
-		return nil, fmt.Errorf("title must be between 3 and 120 characters")
-	}
-	if len(text) < 1 || len(text) > 5000 {
-		return nil, fmt.Errorf("text must be between 1 and 5000 characters")
+	if input.CompetitionID != nil && *input.CompetitionID != "" && !primitive.IsValidObjectID(*input.CompetitionID) {
+		return nil, fmt.Errorf("Неверный ID соревнования")
 	}
 
-	// Process photo uploads
-	photos := bson.A{}
-	if len(input.Photos) > 0 {
-		const maxPhotos = 10
-		const maxPhotoSize = 2 * 1024 * 1024 // 2MB
-
-		if len(input.Photos) > maxPhotos {
-			return nil, fmt.Errorf("too many photos (max %d)", maxPhotos)
-		}
-
-		for _, upload := range input.Photos {
-			if upload == nil {
-				continue
-			}
-
-			// Validate file type
-			if !strings.HasPrefix(upload.ContentType, "image/") {
-				return nil, fmt.Errorf("photo must be an image")
-			}
-
-			// Validate file size
-			if upload.Size > maxPhotoSize {
-				return nil, fmt.Errorf("photo file too large (max 2MB)")
-			}
-
-			// Read file data
-			data, err := io.ReadAll(upload.File)
-			if err != nil {
-				return nil, apperrors.WrapError("не удалось прочитать файл фотографии", err)
-			}
-
-			photos = append(photos, bson.M{
-				"contentType": upload.ContentType,
-				"data":        data,
-			})
+	link := toReportLink(input.CompetitionID, input.Tour, nil)
+	taxonomy := toReportTaxonomy(input.Tags, input.Category)
+	for _, uploaded := range input.UploadedPhotos {
+		if !primitive.IsValidObjectID(uploaded.UploadID) {
+			return nil, fmt.Errorf("Неверный ID загрузки")
 		}
 	}
 
-	// Convert photos from bson.A to []interface{}
-	photosList := make([]interface{}, len(photos))
-	copy(photosList, photos)
-
-	// Create entity.Report
-	reportEntity := &entity.Report{
-		AuthorID:  user.ID,
-		Title:     title,
-		Text:      text,
-		Photos:    photosList,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
-	}
-
-	reportID, err := r.reportRepo.Create(ctx, reportEntity)
-	if err != nil {
-		return nil, apperrors.WrapError("не удалось создать отчет", err)
-	}
-
-	reportDoc, err := r.reportRepo.FindByID(ctx, reportID)
-	if err != nil {
-		return nil, apperrors.WrapError("не удалось найти созданный отчет", err)
+	photos := &usecase.ReportPhotosInput{
+		Uploads:  toPhotoUploads(input.Photos),
+		Details:  toPhotoDetails(input.PhotoDetails),
+		Uploaded: toUploadedPhotos(input.UploadedPhotos),
 	}
-
-	return r.formatReportFromEntity(ctx, reportDoc, user)
+	return r.useCase.CreateReport(ctx, user.ID, input.Title, input.Text, input.Status, input.PublishAt, link, taxonomy, photos)
 }
 
 // UpdateReport is the resolver for the updateReport field.
@@ -209,117 +171,29 @@ func (r *mutationResolver) UpdateReport(ctx context.Context, id string, input mo
 		return nil, fmt.Errorf("Неверный ID")
 	}
 
-	// Check if report exists and get author
-	authorID, err := r.reportRepo.GetAuthorID(ctx, id)
-	if err != nil {
-		return nil, fmt.Errorf("Отчет не найден")
-	}
-
-	if !isAllowed(user, authorID) {
-		return nil, fmt.Errorf("Доступ запрещен")
-	}
-
-	reportDoc, err := r.reportRepo.FindByID(ctx, id)
-	if err != nil {
-		return nil, fmt.Errorf("Отчет не найден")
-	}
-
-	// Start with existing report data
-	updatedReport := &entity.Report{
-		ID:        reportDoc.ID,
-		AuthorID:  reportDoc.AuthorID,
-		Title:     reportDoc.Title,
-		Text:      reportDoc.Text,
-		Photos:    reportDoc.Photos,
-		CreatedAt: reportDoc.CreatedAt,
-		UpdatedAt: time.Now(),
-	}
-
-	// Update title if provided
-	if input.Title != nil {
-		title := strings.TrimSpace(*input.Title)
-		if len(title) < 3 || len(title) > 120 {
-			return nil, fmt.Errorf("title must be between 3 and 120 characters")
-		}
-		updatedReport.Title = title
+	if input.CompetitionID != nil && *input.CompetitionID != "" && !primitive.IsValidObjectID(*input.CompetitionID) {
+		return nil, fmt.Errorf("Неверный ID соревнования")
 	}
 
-	// Update text if provided
-	if input.Text != nil {
-		text := strings.TrimSpace(*input.Text)
-		if len(text) < 1 || len(text) > 5000 {
-			return nil, fmt.Errorf("text must be between 1 and 5000 characters")
-		}
-		updatedReport.Text = text
+	photoIDs := append(append([]string{}, input.RemovePhotoIds...), input.PhotoOrder...)
+	for _, update := range input.UpdatePhotos {
+		photoIDs = append(photoIDs, update.ID)
 	}
-
-	// Handle photo removal
-	if input.RemoveAllPhotos != nil && *input.RemoveAllPhotos {
-		updatedReport.Photos = []interface{}{}
-	} else if len(input.RemovePhoto) > 0 {
-		removeIdx := make(map[int]bool)
-		for _, idx := range input.RemovePhoto {
-			removeIdx[idx] = true
-		}
-		newPhotos := []interface{}{}
-		for i, photo := range updatedReport.Photos {
-			if !removeIdx[i] {
-				newPhotos = append(newPhotos, photo)
-			}
+	for _, photoID := range photoIDs {
+		if !primitive.IsValidObjectID(photoID) {
+			return nil, fmt.Errorf("Неверный ID фотографии")
 		}
-		updatedReport.Photos = newPhotos
 	}
-
-	// Handle new photo uploads
-	if len(input.Photos) > 0 {
-		const maxPhotos = 10
-		const maxPhotoSize = 2 * 1024 * 1024 // 2MB
-
-		currentCount := len(updatedReport.Photos)
-		if currentCount+len(input.Photos) > maxPhotos {
-			return nil, fmt.Errorf("too many photos (max %d)", maxPhotos)
-		}
-
-		// Process new uploads
-		for _, upload := range input.Photos {
-			if upload == nil {
-				continue
-			}
-
-			// Validate file type
-			if !strings.HasPrefix(upload.ContentType, "image/") {
-				return nil, fmt.Errorf("photo must be an image")
-			}
-
-			// Validate file size
-			if upload.Size > maxPhotoSize {
-				return nil, fmt.Errorf("photo file too large (max 2MB)")
-			}
-
-			// Read file data
-			data, err := io.ReadAll(upload.File)
-			if err != nil {
-				return nil, apperrors.WrapError("не удалось прочитать файл фотографии", err)
-			}
-
-			updatedReport.Photos = append(updatedReport.Photos, map[string]interface{}{
-				"contentType": upload.ContentType,
-				"data":        data,
-			})
+	for _, uploaded := range input.UploadedPhotos {
+		if !primitive.IsValidObjectID(uploaded.UploadID) {
+			return nil, fmt.Errorf("Неверный ID загрузки")
 		}
 	}
+	photos := toReportPhotoChanges(input)
 
-	err = r.reportRepo.Update(ctx, id, updatedReport)
-	if err != nil {
-		return nil, apperrors.WrapError("не удалось обновить отчет", err)
-	}
-
-	updatedReportDoc, err := r.reportRepo.FindByID(ctx, id)
-	if err != nil {
-		return nil, apperrors.WrapError("не удалось найти обновленный отчет", err)
-	}
-
-	return r.formatReportFromEntity(ctx, updatedReportDoc, user)
+	link := toReportLink(input.CompetitionID, input.Tour, input.UnlinkCompetition)
+	taxonomy := toReportTaxonomy(input.Tags, input.Category)
+	return r.useCase.UpdateReport(ctx, user.ID, id, input.Title, input.Text, link, taxonomy, photos)
 }
 
 // DeleteReport is the resolver for the deleteReport field.
@@ -333,143 +207,81 @@ func (r *mutationResolver) DeleteReport(ctx context.Context, id string) (bool, e
 		return false, fmt.Errorf("invalid id")
 	}
 
-	// Check if report exists and get author
-	authorID, err := r.reportRepo.GetAuthorID(ctx, id)
-	if err != nil {
-		return false, fmt.Errorf("report not found")
-	}
+	// Moves the report to the trash (author or admin)
+	return r.useCase.DeleteReport(ctx, user.ID, id)
+}
 
-	if !isAllowed(user, authorID) {
-		return false, fmt.Errorf("Доступ запрещен")
+// PublishReport is the resolver for the publishReport field.
+func (r *mutationResolver) PublishReport(ctx context.Context, id string) (*model.Report, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
 	}
 
-	err = r.reportRepo.Delete(ctx, id)
-	if err != nil {
-		return false, apperrors.WrapError("не удалось удалить отчет", err)
+	if !primitive.IsValidObjectID(id) {
+		return nil, fmt.Errorf("Неверный ID")
 	}
 
-	return true, nil
+	return r.useCase.PublishReport(ctx, user.ID, id)
 }
 
-// CreateCompetition is the resolver for the createCompetition field.
-func (r *mutationResolver) CreateCompetition(ctx context.Context, input model.CompetitionInput) (*model.Competition, error) {
+// ScheduleReport is the resolver for the scheduleReport field.
+func (r *mutationResolver) ScheduleReport(ctx context.Context, id string, publishAt string) (*model.Report, error) {
 	user, err := getCurrentUserFromContext(ctx)
 	if err != nil || user == nil {
 		return nil, fmt.Errorf("Не авторизован")
 	}
-	if !user.IsAdmin {
-		return nil, fmt.Errorf("Доступ запрещен")
-	}
-
-	if !input.IndividualFormat && !input.TeamFormat {
-		return nil, fmt.Errorf("выберите хотя бы один формат соревнований")
-	}
 
-	// Parse dates
-	startDate, err := time.Parse(time.RFC3339, input.StartDate)
-	if err != nil {
-		return nil, fmt.Errorf("invalid startDate: %w", err)
-	}
-	endDate, err := time.Parse(time.RFC3339, input.EndDate)
-	if err != nil {
-		return nil, fmt.Errorf("invalid endDate: %w", err)
+	if !primitive.IsValidObjectID(id) {
+		return nil, fmt.Errorf("Неверный ID")
 	}
 
-	// Parse tours
-	tours := bson.A{}
-	for _, tourInput := range input.Tours {
-		tourDate, err := time.Parse(time.RFC3339, tourInput.Date)
-		if err != nil {
-			return nil, fmt.Errorf("invalid tour date: %w", err)
-		}
-		tours = append(tours, bson.M{
-			"date": primitive.NewDateTimeFromTime(tourDate),
-			"time": tourInput.Time,
-		})
-	}
+	return r.useCase.ScheduleReport(ctx, user.ID, id, publishAt)
+}
 
-	var fee *float64
-	if input.Fee != nil && *input.Fee != "" {
-		f, err := strconv.ParseFloat(*input.Fee, 64)
-		if err != nil {
-			return nil, fmt.Errorf("invalid fee: %w", err)
-		}
-		fee = &f
+// UnpublishReport is the resolver for the unpublishReport field.
+func (r *mutationResolver) UnpublishReport(ctx context.Context, id string) (*model.Report, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
 	}
 
-	var teamLimit *int32
-	if input.TeamLimit != nil && *input.TeamLimit != "" {
-		tl, err := strconv.ParseInt(*input.TeamLimit, 10, 32)
-		if err != nil {
-			return nil, fmt.Errorf("invalid teamLimit: %w", err)
-		}
-		t := int32(tl)
-		teamLimit = &t
+	if !primitive.IsValidObjectID(id) {
+		return nil, fmt.Errorf("Неверный ID")
 	}
 
-	// Convert tours to entity.Tour
-	entityTours := make([]entity.Tour, len(input.Tours))
-	for i, tourInput := range input.Tours {
-		tourDate, err := time.Parse(time.RFC3339, tourInput.Date)
-		if err != nil {
-			return nil, fmt.Errorf("invalid tour date: %w", err)
-		}
-		entityTours[i] = entity.Tour{
-			Date: tourDate,
-			Time: tourInput.Time,
-		}
-	}
+	return r.useCase.UnpublishReport(ctx, user.ID, id)
+}
 
-	var openingDateEntity *time.Time
-	if input.OpeningDate != nil && *input.OpeningDate != "" {
-		od, err := time.Parse(time.RFC3339, *input.OpeningDate)
-		if err != nil {
-			return nil, fmt.Errorf("invalid openingDate: %w", err)
-		}
-		openingDateEntity = &od
+// ToggleReaction is the resolver for the toggleReaction field.
+func (r *mutationResolver) ToggleReaction(ctx context.Context, reportID string, typeArg string) (*model.Report, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
 	}
 
-	var teamLimitInt *int
-	if teamLimit != nil {
-		t := int(*teamLimit)
-		teamLimitInt = &t
+	if !primitive.IsValidObjectID(reportID) {
+		return nil, fmt.Errorf("Неверный ID")
 	}
 
-	var regulationsStr *string
-	if input.Regulations != nil {
-		s := strings.TrimSpace(*input.Regulations)
-		regulationsStr = &s
-	}
+	return r.useCase.ToggleReaction(ctx, user.ID, reportID, typeArg)
+}
 
-	// Create entity.Competition
-	competitionEntity := &entity.Competition{
-		Title:            strings.TrimSpace(input.Title),
-		StartDate:        &startDate,
-		EndDate:          &endDate,
-		Location:         strings.TrimSpace(input.Location),
-		Tours:            entityTours,
-		OpeningDate:      openingDateEntity,
-		OpeningTime:      input.OpeningTime,
-		IndividualFormat: input.IndividualFormat,
-		TeamFormat:       input.TeamFormat,
-		Fee:              fee,
-		TeamLimit:        teamLimitInt,
-		Regulations:      regulationsStr,
-		CreatedAt:        time.Now(),
-		UpdatedAt:        time.Now(),
+// CreateCompetition is the resolver for the createCompetition field.
+func (r *mutationResolver) CreateCompetition(ctx context.Context, input model.CompetitionInput) (*model.Competition, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
 	}
-
-	competitionID, err := r.competitionRepo.Create(ctx, competitionEntity)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create competition: %w", err)
+	if !user.IsAdmin {
+		return nil, fmt.Errorf("Доступ запрещен")
 	}
 
-	competitionDoc, err := r.competitionRepo.FindByID(ctx, competitionID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to find created competition: %w", err)
+	if input.VenueID != nil && *input.VenueID != "" && !primitive.IsValidObjectID(*input.VenueID) {
+		return nil, fmt.Errorf("invalid venueId")
 	}
 
-	return formatCompetitionFromEntity(competitionDoc)
+	return r.useCase.CreateCompetition(ctx, &input)
 }
 
 // UpdateCompetition is the resolver for the updateCompetition field.
@@ -485,110 +297,11 @@ func (r *mutationResolver) UpdateCompetition(ctx context.Context, id string, inp
 	if !primitive.IsValidObjectID(id) {
 		return nil, fmt.Errorf("invalid id")
 	}
-
-	if !input.IndividualFormat && !input.TeamFormat {
-		return nil, fmt.Errorf("выберите хотя бы один формат соревнований")
-	}
-
-	// Check if competition exists
-	_, err = r.competitionRepo.FindByID(ctx, id)
-	if err != nil {
-		return nil, fmt.Errorf("competition not found")
-	}
-
-	// Parse dates
-	startDate, err := time.Parse(time.RFC3339, input.StartDate)
-	if err != nil {
-		return nil, fmt.Errorf("invalid startDate: %w", err)
-	}
-	endDate, err := time.Parse(time.RFC3339, input.EndDate)
-	if err != nil {
-		return nil, fmt.Errorf("invalid endDate: %w", err)
-	}
-
-	// Get existing competition
-	existingCompetition, err := r.competitionRepo.FindByID(ctx, id)
-	if err != nil {
-		return nil, fmt.Errorf("competition not found")
-	}
-
-	// Convert tours to entity.Tour
-	entityTours := make([]entity.Tour, len(input.Tours))
-	for i, tourInput := range input.Tours {
-		tourDate, err := time.Parse(time.RFC3339, tourInput.Date)
-		if err != nil {
-			return nil, fmt.Errorf("invalid tour date: %w", err)
-		}
-		entityTours[i] = entity.Tour{
-			Date: tourDate,
-			Time: tourInput.Time,
-		}
-	}
-
-	var openingDateEntity *time.Time
-	if input.OpeningDate != nil && *input.OpeningDate != "" {
-		od, err := time.Parse(time.RFC3339, *input.OpeningDate)
-		if err != nil {
-			return nil, fmt.Errorf("invalid openingDate: %w", err)
-		}
-		openingDateEntity = &od
-	}
-
-	var fee *float64
-	if input.Fee != nil && *input.Fee != "" {
-		f, err := strconv.ParseFloat(*input.Fee, 64)
-		if err != nil {
-			return nil, fmt.Errorf("invalid fee: %w", err)
-		}
-		fee = &f
-	}
-
-	var teamLimitInt *int
-	if input.TeamLimit != nil && *input.TeamLimit != "" {
-		tl, err := strconv.ParseInt(*input.TeamLimit, 10, 32)
-		if err != nil {
-			return nil, fmt.Errorf("invalid teamLimit: %w", err)
-		}
-		t := int(tl)
-		teamLimitInt = &t
-	}
-
-	var regulationsStr *string
-	if input.Regulations != nil {
-		s := strings.TrimSpace(*input.Regulations)
-		regulationsStr = &s
-	}
-
-	// Update entity.Competition
-	updatedCompetition := &entity.Competition{
-		ID:               existingCompetition.ID,
-		Title:            strings.TrimSpace(input.Title),
-		StartDate:        &startDate,
-		EndDate:          &endDate,
-		Location:         strings.TrimSpace(input.Location),
-		Tours:            entityTours,
-		OpeningDate:      openingDateEntity,
-		OpeningTime:      input.OpeningTime,
-		IndividualFormat: input.IndividualFormat,
-		TeamFormat:       input.TeamFormat,
-		Fee:              fee,
-		TeamLimit:        teamLimitInt,
-		Regulations:      regulationsStr,
-		CreatedAt:        existingCompetition.CreatedAt,
-		UpdatedAt:        time.Now(),
-	}
-
-	err = r.competitionRepo.Update(ctx, id, updatedCompetition)
-	if err != nil {
-		return nil, fmt.Errorf("failed to update competition: %w", err)
+	if input.VenueID != nil && *input.VenueID != "" && !primitive.IsValidObjectID(*input.VenueID) {
+		return nil, fmt.Errorf("invalid venueId")
 	}
 
-	competitionDoc, err := r.competitionRepo.FindByID(ctx, id)
-	if err != nil {
-		return nil, fmt.Errorf("failed to find updated competition: %w", err)
-	}
-
-	return formatCompetitionFromEntity(competitionDoc)
+	return r.useCase.UpdateCompetition(ctx, user.ID, id, &input)
 }
 
 // DeleteCompetition is the resolver for the deleteCompetition field.
@@ -605,12 +318,7 @@ func (r *mutationResolver) DeleteCompetition(ctx context.Context, id string) (bo
 		return false, fmt.Errorf("invalid id")
 	}
 
-	err = r.competitionRepo.Delete(ctx, id)
-	if err != nil {
-		return false, fmt.Errorf("failed to delete competition: %w", err)
-	}
-
-	return true, nil
+	return r.useCase.DeleteCompetition(ctx, user.ID, id)
 }
 
 // AdminUpdateUser is the resolver for the adminUpdateUser field.
@@ -693,17 +401,8 @@ func (r *mutationResolver) AdminDeleteUser(ctx context.Context, id string) (bool
 		return false, fmt.Errorf("invalid user id")
 	}
 
-	// Don't allow deleting yourself
-	if user.ID == id {
-		return false, fmt.Errorf("cannot delete yourself")
-	}
-
-	err = r.userRepo.Delete(ctx, id)
-	if err != nil {
-		return false, fmt.Errorf("failed to delete user: %w", err)
-	}
-
-	return true, nil
+	// Self-deletion, the last admin and related records are handled by the deletion rules
+	return r.useCase.AdminDeleteUser(ctx, user.ID, id)
 }
 
 // CreateRegistration is the resolver for the createRegistration field.
@@ -717,20 +416,32 @@ func (r *mutationResolver) CreateRegistration(ctx context.Context, input model.C
 	participants := make([]usecase.ParticipantInput, len(input.Participants))
 	for i, p := range input.Participants {
 		participants[i] = usecase.ParticipantInput{
-			FirstName: p.FirstName,
-			LastName:  p.LastName,
+			FirstName:     p.FirstName,
+			LastName:      p.LastName,
+			BirthDate:     p.BirthDate,
+			Gender:        p.Gender,
+			LicenseNumber: p.LicenseNumber,
+			Reserve:       p.Reserve != nil && *p.Reserve,
+			Captain:       p.Captain != nil && *p.Captain,
 		}
 	}
 
-	var coach *usecase.CoachInput
+	// The legacy single coach goes first, followed by the coaches list
+	coaches := make([]usecase.CoachInput, 0, len(input.Coaches)+1)
 	if input.Coach != nil {
-		coach = &usecase.CoachInput{
+		coaches = append(coaches, usecase.CoachInput{
 			FirstName: input.Coach.FirstName,
 			LastName:  input.Coach.LastName,
-		}
+		})
+	}
+	for _, c := range input.Coaches {
+		coaches = append(coaches, usecase.CoachInput{
+			FirstName: c.FirstName,
+			LastName:  c.LastName,
+		})
 	}
 
-	return r.useCase.CreateRegistration(ctx, user.ID, input.CompetitionID, input.Type, input.TeamName, participants, coach)
+	return r.useCase.CreateRegistration(ctx, user.ID, input.CompetitionID, input.Type, input.TeamName, participants, coaches, input.EligibilityOverrideReason)
 }
 
 // UpdateRegistration is the resolver for the updateRegistration field.
@@ -744,20 +455,32 @@ func (r *mutationResolver) UpdateRegistration(ctx context.Context, id string, in
 	participants := make([]usecase.ParticipantInput, len(input.Participants))
 	for i, p := range input.Participants {
 		participants[i] = usecase.ParticipantInput{
-			FirstName: p.FirstName,
-			LastName:  p.LastName,
+			FirstName:     p.FirstName,
+			LastName:      p.LastName,
+			BirthDate:     p.BirthDate,
+			Gender:        p.Gender,
+			LicenseNumber: p.LicenseNumber,
+			Reserve:       p.Reserve != nil && *p.Reserve,
+			Captain:       p.Captain != nil && *p.Captain,
 		}
 	}
 
-	var coach *usecase.CoachInput
+	// The legacy single coach goes first, followed by the coaches list
+	coaches := make([]usecase.CoachInput, 0, len(input.Coaches)+1)
 	if input.Coach != nil {
-		coach = &usecase.CoachInput{
+		coaches = append(coaches, usecase.CoachInput{
 			FirstName: input.Coach.FirstName,
 			LastName:  input.Coach.LastName,
-		}
+		})
+	}
+	for _, c := range input.Coaches {
+		coaches = append(coaches, usecase.CoachInput{
+			FirstName: c.FirstName,
+			LastName:  c.LastName,
+		})
 	}
 
-	return r.useCase.UpdateRegistration(ctx, user.ID, id, input.TeamName, participants, coach)
+	return r.useCase.UpdateRegistration(ctx, user.ID, id, input.TeamName, participants, coaches, input.EligibilityOverrideReason)
 }
 
 // DeleteRegistration is the resolver for the deleteRegistration field.
@@ -770,100 +493,55 @@ func (r *mutationResolver) DeleteRegistration(ctx context.Context, id string) (b
 	return r.useCase.DeleteRegistration(ctx, user.ID, id)
 }
 
-// Me is the resolver for the me field.
-func (r *queryResolver) Me(ctx context.Context) (*model.User, error) {
-	// Extract userID from context
-	currentUser, err := getCurrentUserFromContext(ctx)
-	if err != nil || currentUser == nil {
-		return nil, nil
+// CreateVenue is the resolver for the createVenue field.
+func (r *mutationResolver) CreateVenue(ctx context.Context, input model.VenueInput) (*model.Venue, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
 	}
-
-	// Call UseCase
-	return r.useCase.GetCurrentUser(ctx, currentUser.ID)
-}
-
-// Reports is the resolver for the reports field.
-func (r *queryResolver) Reports(ctx context.Context, limit *int) ([]*model.Report, error) {
-	currentUser, err := getCurrentUserFromContext(ctx)
-	if err != nil {
-		currentUser = nil
+	if !user.IsAdmin {
+		return nil, fmt.Errorf("Доступ запрещен")
 	}
 
-	reportLimit := 20
-	if limit != nil {
-		reportLimit = int(math.Max(1, math.Min(30, float64(*limit))))
-	}
+	return r.useCase.CreateVenue(ctx, &input, toPhotoUploads(input.Photos))
+}
 
-	reportDocs, err := r.reportRepo.FindAll(ctx, reportLimit)
-	if err != nil {
-		return nil, fmt.Errorf("failed to query reports: %w", err)
+// UpdateVenue is the resolver for the updateVenue field.
+func (r *mutationResolver) UpdateVenue(ctx context.Context, id string, input model.VenueInput) (*model.Venue, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
 	}
-
-	var results []*model.Report
-	for _, reportDoc := range reportDocs {
-		report, err := r.formatReportFromEntity(ctx, reportDoc, currentUser)
-		if err != nil {
-			continue
-		}
-		results = append(results, report)
+	if !user.IsAdmin {
+		return nil, fmt.Errorf("Доступ запрещен")
 	}
 
-	return results, nil
-}
-
-// Report is the resolver for the report field.
-func (r *queryResolver) Report(ctx context.Context, id string) (*model.Report, error) {
 	if !primitive.IsValidObjectID(id) {
-		return nil, fmt.Errorf("invalid id")
-	}
-
-	currentUser, authErr := getCurrentUserFromContext(ctx)
-	if authErr != nil {
-		currentUser = nil
-	}
-	reportDoc, err := r.reportRepo.FindByID(ctx, id)
-	if err != nil {
-		return nil, fmt.Errorf("report not found")
+		return nil, fmt.Errorf("Неверный ID")
 	}
 
-	return r.formatReportFromEntity(ctx, reportDoc, currentUser)
+	return r.useCase.UpdateVenue(ctx, id, &input, toPhotoUploads(input.Photos))
 }
 
-// Competitions is the resolver for the competitions field.
-func (r *queryResolver) Competitions(ctx context.Context) ([]*model.Competition, error) {
-	competitionDocs, err := r.competitionRepo.FindAll(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to query competitions: %w", err)
+// DeleteVenue is the resolver for the deleteVenue field.
+func (r *mutationResolver) DeleteVenue(ctx context.Context, id string) (bool, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return false, fmt.Errorf("Не авторизован")
 	}
-
-	var results []*model.Competition
-	for _, competitionDoc := range competitionDocs {
-		competition, err := formatCompetitionFromEntity(competitionDoc)
-		if err != nil {
-			continue
-		}
-		results = append(results, competition)
+	if !user.IsAdmin {
+		return false, fmt.Errorf("Доступ запрещен")
 	}
 
-	return results, nil
-}
-
-// Competition is the resolver for the competition field.
-func (r *queryResolver) Competition(ctx context.Context, id string) (*model.Competition, error) {
 	if !primitive.IsValidObjectID(id) {
-		return nil, fmt.Errorf("invalid id")
-	}
-
-	competitionDoc, err := r.competitionRepo.FindByID(ctx, id)
-	if err != nil {
-		return nil, fmt.Errorf("competition not found")
+		return false, fmt.Errorf("Неверный ID")
 	}
 
-	return formatCompetitionFromEntity(competitionDoc)
+	return r.useCase.DeleteVenue(ctx, id)
 }
 
-// AdminUsers is the resolver for the adminUsers field.
-func (r *queryResolver) AdminUsers(ctx context.Context) ([]*model.User, error) {
+// AssignSector is the resolver for the assignSector field.
+func (r *mutationResolver) AssignSector(ctx context.Context, registrationID string, sector *string, peg *int) (*model.Registration, error) {
 	user, err := getCurrentUserFromContext(ctx)
 	if err != nil || user == nil {
 		return nil, fmt.Errorf("Не авторизован")
@@ -872,21 +550,15 @@ func (r *queryResolver) AdminUsers(ctx context.Context) ([]*model.User, error) {
 		return nil, fmt.Errorf("Доступ запрещен")
 	}
 
-	userDocs, err := r.userRepo.FindAll(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to query users: %w", err)
-	}
-
-	var results []*model.User
-	for _, userDoc := range userDocs {
-		results = append(results, formatUserFromEntity(userDoc))
+	if !primitive.IsValidObjectID(registrationID) {
+		return nil, fmt.Errorf("Неверный ID")
 	}
 
-	return results, nil
+	return r.useCase.AssignSector(ctx, user.ID, registrationID, sector, peg)
 }
 
-// AdminUser is the resolver for the adminUser field.
-func (r *queryResolver) AdminUser(ctx context.Context, id string) (*model.User, error) {
+// SetRegistrationPaid is the resolver for the setRegistrationPaid field.
+func (r *mutationResolver) SetRegistrationPaid(ctx context.Context, registrationID string, paid bool) (*model.Registration, error) {
 	user, err := getCurrentUserFromContext(ctx)
 	if err != nil || user == nil {
 		return nil, fmt.Errorf("Не авторизован")
@@ -895,11 +567,553 @@ func (r *queryResolver) AdminUser(ctx context.Context, id string) (*model.User,
 		return nil, fmt.Errorf("Доступ запрещен")
 	}
 
-	if !primitive.IsValidObjectID(id) {
-		return nil, fmt.Errorf("invalid user id")
+	if !primitive.IsValidObjectID(registrationID) {
+		return nil, fmt.Errorf("Неверный ID")
 	}
 
-	userDoc, err := r.userRepo.FindByID(ctx, id)
+	return r.useCase.SetRegistrationPaid(ctx, user.ID, registrationID, paid)
+}
+
+// SetTourResult is the resolver for the setTourResult field.
+func (r *mutationResolver) SetTourResult(ctx context.Context, input model.TourResultInput) (*model.TourResult, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	if !user.IsAdmin {
+		return nil, fmt.Errorf("Доступ запрещен")
+	}
+
+	if !primitive.IsValidObjectID(input.RegistrationID) {
+		return nil, fmt.Errorf("Неверный ID")
+	}
+
+	return r.useCase.SetTourResult(ctx, &input)
+}
+
+// DeleteTourResult is the resolver for the deleteTourResult field.
+func (r *mutationResolver) DeleteTourResult(ctx context.Context, id string) (bool, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return false, fmt.Errorf("Не авторизован")
+	}
+	if !user.IsAdmin {
+		return false, fmt.Errorf("Доступ запрещен")
+	}
+
+	if !primitive.IsValidObjectID(id) {
+		return false, fmt.Errorf("Неверный ID")
+	}
+
+	return r.useCase.DeleteTourResult(ctx, id)
+}
+
+// ImportCompetitions is the resolver for the importCompetitions field.
+func (r *mutationResolver) ImportCompetitions(ctx context.Context, file graphql.Upload, dryRun bool) (*model.ImportResult, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	if !user.IsAdmin {
+		return nil, fmt.Errorf("Доступ запрещен")
+	}
+
+	return r.useCase.ImportCompetitions(ctx, toImportFile(file), dryRun)
+}
+
+// ImportRegistrations is the resolver for the importRegistrations field.
+func (r *mutationResolver) ImportRegistrations(ctx context.Context, competitionID string, file graphql.Upload, dryRun bool) (*model.ImportResult, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	if !user.IsAdmin {
+		return nil, fmt.Errorf("Доступ запрещен")
+	}
+
+	if !primitive.IsValidObjectID(competitionID) {
+		return nil, fmt.Errorf("Неверный ID")
+	}
+
+	return r.useCase.ImportRegistrations(ctx, user.ID, competitionID, toImportFile(file), dryRun)
+}
+
+// DuplicateCompetition is the resolver for the duplicateCompetition field.
+func (r *mutationResolver) DuplicateCompetition(ctx context.Context, id string, shiftDates *bool, startDate *string) (*model.Competition, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	if !user.IsAdmin {
+		return nil, fmt.Errorf("Доступ запрещен")
+	}
+	if !primitive.IsValidObjectID(id) {
+		return nil, fmt.Errorf("Неверный ID")
+	}
+
+	return r.useCase.DuplicateCompetition(ctx, id, shiftDates, startDate)
+}
+
+// SaveCompetitionTemplate is the resolver for the saveCompetitionTemplate field.
+func (r *mutationResolver) SaveCompetitionTemplate(ctx context.Context, competitionID string, name string) (*model.CompetitionTemplate, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	if !user.IsAdmin {
+		return nil, fmt.Errorf("Доступ запрещен")
+	}
+	if !primitive.IsValidObjectID(competitionID) {
+		return nil, fmt.Errorf("Неверный ID")
+	}
+
+	return r.useCase.SaveCompetitionTemplate(ctx, competitionID, name)
+}
+
+// DeleteCompetitionTemplate is the resolver for the deleteCompetitionTemplate field.
+func (r *mutationResolver) DeleteCompetitionTemplate(ctx context.Context, id string) (bool, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return false, fmt.Errorf("Не авторизован")
+	}
+	if !user.IsAdmin {
+		return false, fmt.Errorf("Доступ запрещен")
+	}
+	if !primitive.IsValidObjectID(id) {
+		return false, fmt.Errorf("Неверный ID")
+	}
+
+	return r.useCase.DeleteCompetitionTemplate(ctx, id)
+}
+
+// SetCompetitionJudges is the resolver for the setCompetitionJudges field.
+func (r *mutationResolver) SetCompetitionJudges(ctx context.Context, competitionID string, userIds []string) (*model.Competition, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	if !user.IsAdmin {
+		return nil, fmt.Errorf("Доступ запрещен")
+	}
+	if !primitive.IsValidObjectID(competitionID) {
+		return nil, fmt.Errorf("Неверный ID")
+	}
+
+	for _, id := range userIds {
+		if !primitive.IsValidObjectID(id) {
+			return nil, fmt.Errorf("Неверный ID пользователя")
+		}
+	}
+
+	return r.useCase.SetCompetitionJudges(ctx, competitionID, userIds)
+}
+
+// CreatePenalty is the resolver for the createPenalty field.
+func (r *mutationResolver) CreatePenalty(ctx context.Context, input model.PenaltyInput) (*model.Penalty, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	if !primitive.IsValidObjectID(input.RegistrationID) {
+		return nil, fmt.Errorf("Неверный ID")
+	}
+
+	return r.useCase.CreatePenalty(ctx, user.ID, &input)
+}
+
+// UpdatePenalty is the resolver for the updatePenalty field.
+func (r *mutationResolver) UpdatePenalty(ctx context.Context, id string, input model.UpdatePenaltyInput) (*model.Penalty, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	if !primitive.IsValidObjectID(id) {
+		return nil, fmt.Errorf("Неверный ID")
+	}
+
+	return r.useCase.UpdatePenalty(ctx, user.ID, id, &input)
+}
+
+// DeletePenalty is the resolver for the deletePenalty field.
+func (r *mutationResolver) DeletePenalty(ctx context.Context, id string) (bool, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return false, fmt.Errorf("Не авторизован")
+	}
+	if !primitive.IsValidObjectID(id) {
+		return false, fmt.Errorf("Неверный ID")
+	}
+
+	return r.useCase.DeletePenalty(ctx, user.ID, id)
+}
+
+// FileProtest is the resolver for the fileProtest field.
+func (r *mutationResolver) FileProtest(ctx context.Context, input model.ProtestInput) (*model.Protest, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	if !primitive.IsValidObjectID(input.RegistrationID) || !primitive.IsValidObjectID(input.TargetID) {
+		return nil, fmt.Errorf("Неверный ID")
+	}
+
+	return r.useCase.FileProtest(ctx, user.ID, &input)
+}
+
+// WithdrawProtest is the resolver for the withdrawProtest field.
+func (r *mutationResolver) WithdrawProtest(ctx context.Context, id string) (*model.Protest, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	if !primitive.IsValidObjectID(id) {
+		return nil, fmt.Errorf("Неверный ID")
+	}
+
+	return r.useCase.WithdrawProtest(ctx, user.ID, id)
+}
+
+// DecideProtest is the resolver for the decideProtest field.
+func (r *mutationResolver) DecideProtest(ctx context.Context, id string, input model.DecideProtestInput) (*model.Protest, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	if !primitive.IsValidObjectID(id) {
+		return nil, fmt.Errorf("Неверный ID")
+	}
+
+	return r.useCase.DecideProtest(ctx, user.ID, id, &input)
+}
+
+// MarkNotificationsRead is the resolver for the markNotificationsRead field.
+func (r *mutationResolver) MarkNotificationsRead(ctx context.Context, ids []string) (bool, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return false, fmt.Errorf("Не авторизован")
+	}
+	for _, id := range ids {
+		if !primitive.IsValidObjectID(id) {
+			return false, fmt.Errorf("Неверный ID")
+		}
+	}
+
+	return r.useCase.MarkNotificationsRead(ctx, user.ID, ids)
+}
+
+// RegenerateCalendarFeedURL is the resolver for the regenerateCalendarFeedUrl field.
+func (r *mutationResolver) RegenerateCalendarFeedURL(ctx context.Context) (string, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return "", fmt.Errorf("Не авторизован")
+	}
+
+	return r.useCase.RegenerateCalendarFeedURL(ctx, user.ID)
+}
+
+// CheckIn is the resolver for the checkIn field.
+func (r *mutationResolver) CheckIn(ctx context.Context, code string, tour int) (*model.CheckInResult, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+
+	return r.useCase.CheckIn(ctx, user.ID, code, tour)
+}
+
+// CancelCheckIn is the resolver for the cancelCheckIn field.
+func (r *mutationResolver) CancelCheckIn(ctx context.Context, registrationID string, tour int) (bool, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return false, fmt.Errorf("Не авторизован")
+	}
+	if !primitive.IsValidObjectID(registrationID) {
+		return false, fmt.Errorf("Неверный ID")
+	}
+
+	return r.useCase.CancelCheckIn(ctx, user.ID, registrationID, tour)
+}
+
+// RestoreCompetition is the resolver for the restoreCompetition field.
+func (r *mutationResolver) RestoreCompetition(ctx context.Context, id string) (*model.Competition, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	if !user.IsAdmin {
+		return nil, fmt.Errorf("Доступ запрещен")
+	}
+	if !primitive.IsValidObjectID(id) {
+		return nil, fmt.Errorf("Неверный ID")
+	}
+
+	return r.useCase.RestoreCompetition(ctx, id)
+}
+
+// RestoreReport is the resolver for the restoreReport field.
+func (r *mutationResolver) RestoreReport(ctx context.Context, id string) (*model.Report, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	if !user.IsAdmin {
+		return nil, fmt.Errorf("Доступ запрещен")
+	}
+	if !primitive.IsValidObjectID(id) {
+		return nil, fmt.Errorf("Неверный ID")
+	}
+
+	return r.useCase.RestoreReport(ctx, user.ID, id)
+}
+
+// RestoreRegistration is the resolver for the restoreRegistration field.
+func (r *mutationResolver) RestoreRegistration(ctx context.Context, id string) (*model.Registration, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	if !user.IsAdmin {
+		return nil, fmt.Errorf("Доступ запрещен")
+	}
+	if !primitive.IsValidObjectID(id) {
+		return nil, fmt.Errorf("Неверный ID")
+	}
+
+	return r.useCase.RestoreRegistration(ctx, user.ID, id)
+}
+
+// RevertToRevision is the resolver for the revertToRevision field.
+func (r *mutationResolver) RevertToRevision(ctx context.Context, revisionID string) (*model.RevertResult, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+
+	if !primitive.IsValidObjectID(revisionID) {
+		return nil, fmt.Errorf("Неверный ID")
+	}
+
+	return r.useCase.RevertToRevision(ctx, user.ID, revisionID)
+}
+
+// CreateComment is the resolver for the createComment field.
+func (r *mutationResolver) CreateComment(ctx context.Context, input model.CommentInput) (*model.Comment, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+
+	if !primitive.IsValidObjectID(input.ReportID) {
+		return nil, fmt.Errorf("Неверный ID отчета")
+	}
+	if input.ParentID != nil && !primitive.IsValidObjectID(*input.ParentID) {
+		return nil, fmt.Errorf("Неверный ID комментария")
+	}
+
+	return r.useCase.CreateComment(ctx, user.ID, &input)
+}
+
+// UpdateComment is the resolver for the updateComment field.
+func (r *mutationResolver) UpdateComment(ctx context.Context, id string, text string) (*model.Comment, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+
+	if !primitive.IsValidObjectID(id) {
+		return nil, fmt.Errorf("Неверный ID")
+	}
+
+	return r.useCase.UpdateComment(ctx, user.ID, id, text)
+}
+
+// DeleteComment is the resolver for the deleteComment field.
+func (r *mutationResolver) DeleteComment(ctx context.Context, id string) (bool, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return false, fmt.Errorf("Не авторизован")
+	}
+
+	if !primitive.IsValidObjectID(id) {
+		return false, fmt.Errorf("Неверный ID")
+	}
+
+	return r.useCase.DeleteComment(ctx, user.ID, id)
+}
+
+// HideComment is the resolver for the hideComment field.
+func (r *mutationResolver) HideComment(ctx context.Context, id string) (*model.Comment, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	if !user.IsAdmin {
+		return nil, fmt.Errorf("Доступ запрещен")
+	}
+	if !primitive.IsValidObjectID(id) {
+		return nil, fmt.Errorf("Неверный ID")
+	}
+
+	return r.useCase.HideComment(ctx, user.ID, id)
+}
+
+// ApproveComment is the resolver for the approveComment field.
+func (r *mutationResolver) ApproveComment(ctx context.Context, id string) (*model.Comment, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	if !user.IsAdmin {
+		return nil, fmt.Errorf("Доступ запрещен")
+	}
+	if !primitive.IsValidObjectID(id) {
+		return nil, fmt.Errorf("Неверный ID")
+	}
+
+	return r.useCase.ApproveComment(ctx, user.ID, id)
+}
+
+// Me is the resolver for the me field.
+func (r *queryResolver) Me(ctx context.Context) (*model.User, error) {
+	// Extract userID from context
+	currentUser, err := getCurrentUserFromContext(ctx)
+	if err != nil || currentUser == nil {
+		return nil, nil
+	}
+
+	// Call UseCase
+	return r.useCase.GetCurrentUser(ctx, currentUser.ID)
+}
+
+// MyStorageUsage is the resolver for the myStorageUsage field.
+func (r *queryResolver) MyStorageUsage(ctx context.Context) (*model.StorageUsage, error) {
+	currentUser, err := getCurrentUserFromContext(ctx)
+	if err != nil || currentUser == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+
+	return r.useCase.GetStorageUsage(ctx, currentUser.ID)
+}
+
+// Reports is the resolver for the reports field.
+func (r *queryResolver) Reports(ctx context.Context, limit *int, competitionID *string, tags []string, category *string) ([]*model.Report, error) {
+	userID := ""
+	if currentUser, err := getCurrentUserFromContext(ctx); err == nil && currentUser != nil {
+		userID = currentUser.ID
+	}
+
+	if competitionID != nil && *competitionID != "" && !primitive.IsValidObjectID(*competitionID) {
+		return nil, fmt.Errorf("Неверный ID соревнования")
+	}
+
+	return r.useCase.GetReports(ctx, userID, limit, usecase.ReportsFilter{CompetitionID: competitionID, Tags: tags, Category: category})
+}
+
+// Report is the resolver for the report field.
+func (r *queryResolver) Report(ctx context.Context, id string) (*model.Report, error) {
+	if !primitive.IsValidObjectID(id) {
+		return nil, fmt.Errorf("invalid id")
+	}
+
+	userID := ""
+	if currentUser, err := getCurrentUserFromContext(ctx); err == nil && currentUser != nil {
+		userID = currentUser.ID
+	}
+
+	return r.useCase.GetReport(ctx, userID, reportViewerKey(ctx, userID), id)
+}
+
+// ReportDrafts is the resolver for the reportDrafts field.
+func (r *queryResolver) ReportDrafts(ctx context.Context, limit *int) ([]*model.Report, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+
+	return r.useCase.GetReportDrafts(ctx, user.ID, limit)
+}
+
+// PopularReports is the resolver for the popularReports field.
+func (r *queryResolver) PopularReports(ctx context.Context, period *string, limit *int) ([]*model.Report, error) {
+	userID := ""
+	if currentUser, err := getCurrentUserFromContext(ctx); err == nil && currentUser != nil {
+		userID = currentUser.ID
+	}
+
+	return r.useCase.GetPopularReports(ctx, userID, period, limit)
+}
+
+// TagSuggestions is the resolver for the tagSuggestions field.
+func (r *queryResolver) TagSuggestions(ctx context.Context, prefix *string, limit *int) ([]*model.TagCount, error) {
+	return r.useCase.GetTagSuggestions(ctx, prefix, limit)
+}
+
+// ReportCategories is the resolver for the reportCategories field.
+func (r *queryResolver) ReportCategories(ctx context.Context) ([]*model.ReportCategory, error) {
+	return r.useCase.GetReportCategories(), nil
+}
+
+// CompetitionGallery is the resolver for the competitionGallery field.
+func (r *queryResolver) CompetitionGallery(ctx context.Context, competitionID string, tour *int) ([]*model.GalleryPhoto, error) {
+	if !primitive.IsValidObjectID(competitionID) {
+		return nil, fmt.Errorf("Неверный ID соревнования")
+	}
+
+	return r.useCase.GetCompetitionGallery(ctx, competitionID, tour)
+}
+
+// Competitions is the resolver for the competitions field.
+func (r *queryResolver) Competitions(ctx context.Context) ([]*model.Competition, error) {
+	return r.useCase.GetCompetitions(ctx)
+}
+
+// Competition is the resolver for the competition field.
+func (r *queryResolver) Competition(ctx context.Context, id string) (*model.Competition, error) {
+	if !primitive.IsValidObjectID(id) {
+		return nil, fmt.Errorf("invalid id")
+	}
+
+	return r.useCase.GetCompetition(ctx, id)
+}
+
+// AdminUsers is the resolver for the adminUsers field.
+func (r *queryResolver) AdminUsers(ctx context.Context) ([]*model.User, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	if !user.IsAdmin {
+		return nil, fmt.Errorf("Доступ запрещен")
+	}
+
+	userDocs, err := r.userRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query users: %w", err)
+	}
+
+	var results []*model.User
+	for _, userDoc := range userDocs {
+		results = append(results, formatUserFromEntity(userDoc))
+	}
+
+	return results, nil
+}
+
+// AdminUser is the resolver for the adminUser field.
+func (r *queryResolver) AdminUser(ctx context.Context, id string) (*model.User, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	if !user.IsAdmin {
+		return nil, fmt.Errorf("Доступ запрещен")
+	}
+
+	if !primitive.IsValidObjectID(id) {
+		return nil, fmt.Errorf("invalid user id")
+	}
+
+	userDoc, err := r.userRepo.FindByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("user not found")
 	}
@@ -948,6 +1162,7 @@ func (r *queryResolver) Chat(ctx context.Context, query string) (*model.ChatResp
 			Location:    sr.Location,
 			HasPhotos:   sr.HasPhotos,
 			PhotosCount: sr.PhotosCount,
+			Competition: sr.CompetitionTitle,
 		}
 	}
 
@@ -976,6 +1191,10 @@ func (r *queryResolver) Chat(ctx context.Context, query string) (*model.ChatResp
 		if sr.Location != "" {
 			location = sr.Location
 		}
+		var competition *model.Competition
+		if sr.CompetitionID != "" {
+			competition, _ = r.useCase.GetCompetition(ctx, sr.CompetitionID)
+		}
 		results[i] = &model.ChatResult{
 			ID:          sr.ID,
 			Type:        sr.Type,
@@ -983,6 +1202,7 @@ func (r *queryResolver) Chat(ctx context.Context, query string) (*model.ChatResp
 			HasPhotos:   sr.HasPhotos,
 			PhotosCount: sr.PhotosCount,
 			Location:    &location,
+			Competition: competition,
 		}
 	}
 
@@ -1006,11 +1226,299 @@ func (r *queryResolver) Registrations(ctx context.Context, competitionID string)
 	return r.useCase.GetRegistrationsByCompetition(ctx, competitionID, currentUserID)
 }
 
+// Venues is the resolver for the venues field.
+func (r *queryResolver) Venues(ctx context.Context, near *model.NearInput) ([]*model.Venue, error) {
+	return r.useCase.GetVenues(ctx, near)
+}
+
+// Venue is the resolver for the venue field.
+func (r *queryResolver) Venue(ctx context.Context, id string) (*model.Venue, error) {
+	if !primitive.IsValidObjectID(id) {
+		return nil, fmt.Errorf("Неверный ID")
+	}
+
+	return r.useCase.GetVenue(ctx, id)
+}
+
+// CalendarFeedURL is the resolver for the calendarFeedUrl field.
+func (r *queryResolver) CalendarFeedURL(ctx context.Context) (*string, error) {
+	currentUser, err := getCurrentUserFromContext(ctx)
+	if err != nil || currentUser == nil {
+		return nil, nil
+	}
+
+	url, err := r.useCase.GetCalendarFeedURL(ctx, currentUser.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &url, nil
+}
+
+// TourResults is the resolver for the tourResults field.
+func (r *queryResolver) TourResults(ctx context.Context, competitionID string) ([]*model.TourResult, error) {
+	if !primitive.IsValidObjectID(competitionID) {
+		return nil, fmt.Errorf("Неверный ID")
+	}
+
+	return r.useCase.GetTourResults(ctx, competitionID)
+}
+
+// Standings is the resolver for the standings field.
+func (r *queryResolver) Standings(ctx context.Context, competitionID string) ([]*model.Standing, error) {
+	if !primitive.IsValidObjectID(competitionID) {
+		return nil, fmt.Errorf("Неверный ID")
+	}
+
+	currentUser, err := getCurrentUserFromContext(ctx)
+	if err != nil {
+		currentUser = nil
+	}
+	currentUserID := ""
+	if currentUser != nil {
+		currentUserID = currentUser.ID
+	}
+
+	return r.useCase.GetStandings(ctx, competitionID, currentUserID)
+}
+
+// CompetitionTemplates is the resolver for the competitionTemplates field.
+func (r *queryResolver) CompetitionTemplates(ctx context.Context) ([]*model.CompetitionTemplate, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	if !user.IsAdmin {
+		return nil, fmt.Errorf("Доступ запрещен")
+	}
+
+	return r.useCase.GetCompetitionTemplates(ctx)
+}
+
+// CompetitionPrefill is the resolver for the competitionPrefill field.
+func (r *queryResolver) CompetitionPrefill(ctx context.Context, templateID string, startDate string) (*model.CompetitionPrefill, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	if !user.IsAdmin {
+		return nil, fmt.Errorf("Доступ запрещен")
+	}
+	if !primitive.IsValidObjectID(templateID) {
+		return nil, fmt.Errorf("Неверный ID")
+	}
+
+	return r.useCase.GetCompetitionPrefill(ctx, templateID, startDate)
+}
+
+// Penalties is the resolver for the penalties field.
+func (r *queryResolver) Penalties(ctx context.Context, competitionID string) ([]*model.Penalty, error) {
+	if !primitive.IsValidObjectID(competitionID) {
+		return nil, fmt.Errorf("Неверный ID")
+	}
+
+	return r.useCase.GetPenalties(ctx, competitionID)
+}
+
+// Penalty is the resolver for the penalty field.
+func (r *queryResolver) Penalty(ctx context.Context, id string) (*model.Penalty, error) {
+	if !primitive.IsValidObjectID(id) {
+		return nil, fmt.Errorf("Неверный ID")
+	}
+
+	return r.useCase.GetPenalty(ctx, id)
+}
+
+// Protests is the resolver for the protests field.
+func (r *queryResolver) Protests(ctx context.Context, competitionID string, status *string) ([]*model.Protest, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	if !primitive.IsValidObjectID(competitionID) {
+		return nil, fmt.Errorf("Неверный ID")
+	}
+
+	return r.useCase.GetProtests(ctx, user.ID, competitionID, status)
+}
+
+// Notifications is the resolver for the notifications field.
+func (r *queryResolver) Notifications(ctx context.Context, unreadOnly *bool, limit *int) ([]*model.Notification, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+
+	return r.useCase.GetNotifications(ctx, user.ID, unreadOnly != nil && *unreadOnly, limit)
+}
+
+// UnreadNotificationsCount is the resolver for the unreadNotificationsCount field.
+func (r *queryResolver) UnreadNotificationsCount(ctx context.Context) (int, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return 0, fmt.Errorf("Не авторизован")
+	}
+
+	return r.useCase.GetUnreadNotificationsCount(ctx, user.ID)
+}
+
+// CheckInStatus is the resolver for the checkInStatus field.
+func (r *queryResolver) CheckInStatus(ctx context.Context, competitionID string, tour int) (*model.CheckInStatus, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	if !primitive.IsValidObjectID(competitionID) {
+		return nil, fmt.Errorf("Неверный ID")
+	}
+
+	return r.useCase.GetCheckInStatus(ctx, user.ID, competitionID, tour)
+}
+
+// MyRegistrations is the resolver for the myRegistrations field.
+func (r *queryResolver) MyRegistrations(ctx context.Context, upcomingOnly *bool) ([]*model.MyRegistration, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+
+	return r.useCase.GetMyRegistrations(ctx, user.ID, upcomingOnly != nil && *upcomingOnly)
+}
+
+// Dashboard is the resolver for the dashboard field.
+func (r *queryResolver) Dashboard(ctx context.Context) (*model.Dashboard, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+
+	return r.useCase.GetDashboard(ctx, user.ID)
+}
+
+// DeletedCompetitions is the resolver for the deletedCompetitions field.
+func (r *queryResolver) DeletedCompetitions(ctx context.Context) ([]*model.Competition, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	if !user.IsAdmin {
+		return nil, fmt.Errorf("Доступ запрещен")
+	}
+
+	return r.useCase.GetDeletedCompetitions(ctx)
+}
+
+// Trash is the resolver for the trash field.
+func (r *queryResolver) Trash(ctx context.Context) (*model.Trash, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	if !user.IsAdmin {
+		return nil, fmt.Errorf("Доступ запрещен")
+	}
+
+	return r.useCase.GetTrash(ctx, user.ID)
+}
+
+// CompetitionDeletionPreview is the resolver for the competitionDeletionPreview field.
+func (r *queryResolver) CompetitionDeletionPreview(ctx context.Context, id string) (*model.DeletionPreview, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	if !user.IsAdmin {
+		return nil, fmt.Errorf("Доступ запрещен")
+	}
+	if !primitive.IsValidObjectID(id) {
+		return nil, fmt.Errorf("Неверный ID")
+	}
+
+	return r.useCase.GetCompetitionDeletionPreview(ctx, id)
+}
+
+// UserDeletionPreview is the resolver for the userDeletionPreview field.
+func (r *queryResolver) UserDeletionPreview(ctx context.Context, id string) (*model.DeletionPreview, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	if !user.IsAdmin {
+		return nil, fmt.Errorf("Доступ запрещен")
+	}
+	if !primitive.IsValidObjectID(id) {
+		return nil, fmt.Errorf("Неверный ID")
+	}
+
+	return r.useCase.GetUserDeletionPreview(ctx, user.ID, id)
+}
+
+// Revisions is the resolver for the revisions field.
+func (r *queryResolver) Revisions(ctx context.Context, entityID string) ([]*model.Revision, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+
+	if !primitive.IsValidObjectID(entityID) {
+		return nil, fmt.Errorf("Неверный ID")
+	}
+
+	return r.useCase.GetRevisions(ctx, user.ID, entityID)
+}
+
+// Comments is the resolver for the comments field.
+func (r *queryResolver) Comments(ctx context.Context, reportID string) ([]*model.Comment, error) {
+	if !primitive.IsValidObjectID(reportID) {
+		return nil, fmt.Errorf("Неверный ID")
+	}
+
+	userID := ""
+	if currentUser, err := getCurrentUserFromContext(ctx); err == nil && currentUser != nil {
+		userID = currentUser.ID
+	}
+
+	return r.useCase.GetComments(ctx, userID, reportID)
+}
+
+// CommentModerationQueue is the resolver for the commentModerationQueue field.
+func (r *queryResolver) CommentModerationQueue(ctx context.Context, limit *int) ([]*model.Comment, error) {
+	user, err := getCurrentUserFromContext(ctx)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	if !user.IsAdmin {
+		return nil, fmt.Errorf("Доступ запрещен")
+	}
+
+	return r.useCase.GetCommentModerationQueue(ctx, user.ID, limit)
+}
+
+// Competition is the resolver for the competition field.
+func (r *reportResolver) Competition(ctx context.Context, obj *model.Report) (*model.Competition, error) {
+	if obj.CompetitionID == nil {
+		return nil, nil
+	}
+
+	competition, err := r.useCase.GetCompetition(ctx, *obj.CompetitionID)
+	if err != nil {
+		return nil, nil // Competition is in the trash
+	}
+	return competition, nil
+}
+
+// Competition returns generated.CompetitionResolver implementation.
+func (r *Resolver) Competition() generated.CompetitionResolver { return &competitionResolver{r} }
+
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
 // Query returns generated.QueryResolver implementation.
 func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
+// Report returns generated.ReportResolver implementation.
+func (r *Resolver) Report() generated.ReportResolver { return &reportResolver{r} }
+
+type competitionResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type reportResolver struct{ *Resolver }
@@ -0,0 +1,71 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/cnpf/feeder-backend/internal/auth"
+	"github.com/cnpf/feeder-backend/internal/usecase"
+)
+
+const calendarContentType = "text/calendar; charset=utf-8"
+
+// competitionsCalendar serves all competitions as an iCalendar feed
+func (h *Handler) competitionsCalendar(c *gin.Context) {
+	data, err := h.useCase.GetCompetitionsCalendar(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Content-Disposition", `inline; filename="competitions.ics"`)
+	c.Data(http.StatusOK, calendarContentType, data)
+}
+
+// competitionCalendar serves a single competition as a downloadable .ics file
+// The id may carry an ".ics" suffix: /api/calendar/competitions/<id>.ics
+func (h *Handler) competitionCalendar(c *gin.Context) {
+	id := strings.TrimSuffix(c.Param("id"), ".ics")
+	if !primitive.IsValidObjectID(id) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Неверный ID"})
+		return
+	}
+
+	data, err := h.useCase.GetCompetitionCalendar(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="competition-%s.ics"`, id))
+	c.Data(http.StatusOK, calendarContentType, data)
+}
+
+// personalCalendar serves the feed of competitions the token owner is registered for
+// Calendar apps cannot send cookies, so the user is identified by a signed URL token
+func (h *Handler) personalCalendar(c *gin.Context) {
+	token := strings.TrimSuffix(c.Param("token"), ".ics")
+	userID, version, err := auth.VerifyCalendarToken(token)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Неверная ссылка на календарь"})
+		return
+	}
+
+	data, err := h.useCase.GetUserCalendar(c.Request.Context(), userID, version)
+	if errors.Is(err, usecase.ErrCalendarLinkRevoked) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Content-Disposition", `inline; filename="my-competitions.ics"`)
+	c.Data(http.StatusOK, calendarContentType, data)
+}
@@ -0,0 +1,38 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/cnpf/feeder-backend/internal/auth"
+)
+
+// registrationQRCode serves the check-in QR code of a registration as PNG
+func (h *Handler) registrationQRCode(c *gin.Context) {
+	user, err := auth.GetCurrentUser(c)
+	if err != nil || user == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Не авторизован"})
+		return
+	}
+
+	id := c.Param("id")
+	if !primitive.IsValidObjectID(id) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Неверный ID"})
+		return
+	}
+
+	file, err := h.useCase.GetRegistrationQRCode(c.Request.Context(), user.ID, id)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if c.Query("download") != "" {
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, file.FileName))
+	}
+	c.Header("Cache-Control", "private, no-store")
+	c.Data(http.StatusOK, file.ContentType, file.Data)
+}
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/cnpf/feeder-backend/internal/auth"
+	"github.com/cnpf/feeder-backend/internal/usecase"
+)
+
+// competitionExport serves start lists and protocols of a competition to authenticated users
+// The document is "<kind>.<format>": /api/export/competitions/<id>/start-list.pdf, /protocol.xlsx, ...
+func (h *Handler) competitionExport(c *gin.Context) {
+	user, err := auth.GetCurrentUser(c)
+	if err != nil || user == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Не авторизован"})
+		return
+	}
+
+	id := c.Param("id")
+	if !primitive.IsValidObjectID(id) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Неверный ID"})
+		return
+	}
+
+	document := c.Param("document")
+	format := strings.TrimPrefix(path.Ext(document), ".")
+	kind := strings.TrimSuffix(document, path.Ext(document))
+
+	var file *usecase.ExportFile
+	switch kind {
+	case "start-list":
+		file, err = h.useCase.ExportStartList(c.Request.Context(), user.ID, id, format)
+	case "protocol":
+		file, err = h.useCase.ExportProtocol(c.Request.Context(), user.ID, id, format)
+	default:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Документ не найден"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, file.FileName))
+	c.Header("Cache-Control", "no-store")
+	c.Data(http.StatusOK, file.ContentType, file.Data)
+}
@@ -0,0 +1,58 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	atomContentType    = "application/atom+xml; charset=utf-8"
+	rssContentType     = "application/rss+xml; charset=utf-8"
+	sitemapContentType = "application/xml; charset=utf-8"
+)
+
+// feedContentType returns the content type of a feed format
+func feedContentType(format string) string {
+	if format == "rss" {
+		return rssContentType
+	}
+	return atomContentType
+}
+
+// reportsFeed serves the feed of published reports in the format
+func (h *Handler) reportsFeed(format string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		data, err := h.useCase.GetReportsFeed(c.Request.Context(), format)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.Data(http.StatusOK, feedContentType(format), data)
+	}
+}
+
+// competitionsFeed serves the feed of upcoming competitions in the format
+func (h *Handler) competitionsFeed(format string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		data, err := h.useCase.GetCompetitionsFeed(c.Request.Context(), format)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.Data(http.StatusOK, feedContentType(format), data)
+	}
+}
+
+// sitemap serves sitemap.xml with public reports and competitions
+func (h *Handler) sitemap(c *gin.Context) {
+	data, err := h.useCase.GetSitemap(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Data(http.StatusOK, sitemapContentType, data)
+}
@@ -0,0 +1,54 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/cnpf/feeder-backend/internal/usecase"
+)
+
+// Handler serves plain HTTP endpoints (feeds, file downloads) next to GraphQL
+// Like resolvers, handlers only deal with HTTP and delegate to the use case
+type Handler struct {
+	useCase usecase.UseCase
+}
+
+// NewHandler creates a new HTTP handler
+func NewHandler(useCase usecase.UseCase) *Handler {
+	return &Handler{useCase: useCase}
+}
+
+// RegisterRoutes registers all HTTP routes on the router
+func (h *Handler) RegisterRoutes(router gin.IRouter) {
+	// iCalendar feeds
+	router.GET("/api/calendar/competitions.ics", h.competitionsCalendar)
+	router.GET("/api/calendar/competitions/:id", h.competitionCalendar)
+	router.GET("/api/calendar/personal/:token", h.personalCalendar)
+
+	// Start lists and protocols (PDF, CSV, XLSX)
+	router.GET("/api/export/competitions/:id/:document", h.competitionExport)
+
+	// Report and venue photos
+	router.GET("/api/reports/:id/photos/:photo", h.reportPhoto)
+	router.GET("/api/venues/:id/photos/:photo", h.venuePhoto)
+
+	// Resumable photo uploads (tus), attached to reports by upload ID
+	router.OPTIONS("/api/uploads", h.uploadOptions)
+	router.POST("/api/uploads", h.createUpload)
+	router.HEAD("/api/uploads/:id", h.uploadStatus)
+	router.PATCH("/api/uploads/:id", h.appendUpload)
+	router.DELETE("/api/uploads/:id", h.deleteUpload)
+
+	// Check-in QR codes (PNG)
+	router.GET("/api/checkin/registrations/:id/qr.png", h.registrationQRCode)
+
+	// Atom/RSS feeds and sitemap for syndication and search engines
+	router.GET("/api/feeds/reports.atom", h.reportsFeed(usecase.FeedFormatAtom))
+	router.GET("/api/feeds/reports.rss", h.reportsFeed(usecase.FeedFormatRSS))
+	router.GET("/api/feeds/competitions.atom", h.competitionsFeed(usecase.FeedFormatAtom))
+	router.GET("/api/feeds/competitions.rss", h.competitionsFeed(usecase.FeedFormatRSS))
+	router.GET("/sitemap.xml", h.sitemap)
+
+	// Social previews of shared links (Open Graph / Twitter Card)
+	router.GET("/share/reports/:id", h.reportSharePage)
+	router.GET("/share/competitions/:id", h.competitionSharePage)
+}
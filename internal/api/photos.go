@@ -0,0 +1,58 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/cnpf/feeder-backend/internal/auth"
+)
+
+// reportPhoto serves a report photo by photo ID (or by position, for links made before photos had IDs)
+// Photos of drafts are served to their authors and admins only
+func (h *Handler) reportPhoto(c *gin.Context) {
+	id := c.Param("id")
+	if !primitive.IsValidObjectID(id) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Неверный ID"})
+		return
+	}
+
+	userID := ""
+	if user, err := auth.GetCurrentUser(c); err == nil && user != nil {
+		userID = user.ID
+	}
+
+	file, err := h.useCase.GetReportPhoto(c.Request.Context(), userID, id, c.Param("photo"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Cache-Control", "private, max-age=3600")
+	c.Data(http.StatusOK, file.ContentType, file.Data)
+}
+
+// venuePhoto serves a venue photo by position
+func (h *Handler) venuePhoto(c *gin.Context) {
+	id := c.Param("id")
+	if !primitive.IsValidObjectID(id) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Неверный ID"})
+		return
+	}
+	index, err := strconv.Atoi(c.Param("photo"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Неверный номер фотографии"})
+		return
+	}
+
+	file, err := h.useCase.GetVenuePhoto(c.Request.Context(), id, index)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Cache-Control", "public, max-age=3600")
+	c.Data(http.StatusOK, file.ContentType, file.Data)
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/cnpf/feeder-backend/internal/preview"
+	"github.com/cnpf/feeder-backend/internal/usecase"
+)
+
+const htmlContentType = "text/html; charset=utf-8"
+
+// reportSharePage serves the social preview of a report
+func (h *Handler) reportSharePage(c *gin.Context) {
+	h.sharePage(c, h.useCase.GetReportSharePage)
+}
+
+// competitionSharePage serves the social preview of a competition
+func (h *Handler) competitionSharePage(c *gin.Context) {
+	h.sharePage(c, h.useCase.GetCompetitionSharePage)
+}
+
+// sharePage renders the preview for link preview crawlers and redirects people to the SPA page
+func (h *Handler) sharePage(c *gin.Context, get func(ctx context.Context, id string) (*usecase.SharePage, error)) {
+	id := c.Param("id")
+	if !primitive.IsValidObjectID(id) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Неверный ID"})
+		return
+	}
+
+	page, err := get(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	// The response depends on the User-Agent, caches must not mix both variants
+	c.Header("Vary", "User-Agent")
+	if !preview.IsCrawler(c.Request.UserAgent()) {
+		c.Redirect(http.StatusFound, page.URL)
+		return
+	}
+	c.Data(http.StatusOK, htmlContentType, page.HTML)
+}
@@ -0,0 +1,201 @@
+package api
+
+import (
+	"encoding/base64"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/cnpf/feeder-backend/internal/auth"
+	"github.com/cnpf/feeder-backend/internal/usecase"
+)
+
+// Resumable uploads follow the core tus 1.0.0 protocol with the creation, expiration and termination
+// extensions (https://tus.io/protocols/resumable-upload), so standard clients such as tus-js-client work
+const (
+	tusVersion         = "1.0.0"
+	tusExtensions      = "creation,expiration,termination"
+	tusChunkMediaType  = "application/offset+octet-stream"
+	uploadsRoutePrefix = "/api/uploads/"
+)
+
+// uploadOptions describes the supported protocol version, extensions and the maximum file size,
+// which depends on the role of the user (without authorization, the size for regular users)
+func (h *Handler) uploadOptions(c *gin.Context) {
+	userID := ""
+	if user, err := auth.GetCurrentUser(c); err == nil && user != nil {
+		userID = user.ID
+	}
+	usage, err := h.useCase.GetStorageUsage(c.Request.Context(), userID)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Tus-Resumable", tusVersion)
+	c.Header("Tus-Version", tusVersion)
+	c.Header("Tus-Extension", tusExtensions)
+	c.Header("Tus-Max-Size", strconv.Itoa(usage.MaxPhotoSize))
+	c.Status(http.StatusNoContent)
+}
+
+// createUpload starts an upload of Upload-Length bytes; the type and name come from Upload-Metadata
+func (h *Handler) createUpload(c *gin.Context) {
+	c.Header("Tus-Resumable", tusVersion)
+	userID, ok := uploadUser(c)
+	if !ok {
+		return
+	}
+
+	length, err := strconv.ParseInt(c.GetHeader("Upload-Length"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Неверный заголовок Upload-Length"})
+		return
+	}
+	metadata := parseUploadMetadata(c.GetHeader("Upload-Metadata"))
+	contentType := metadata["filetype"]
+	if contentType == "" {
+		contentType = metadata["type"]
+	}
+	fileName := metadata["filename"]
+	if fileName == "" {
+		fileName = metadata["name"]
+	}
+
+	status, err := h.useCase.CreateUpload(c.Request.Context(), userID, length, contentType, fileName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Location", uploadsRoutePrefix+status.ID)
+	writeUploadStatus(c, status)
+	c.Status(http.StatusCreated)
+}
+
+// uploadStatus reports how many bytes of an upload have been received, so that the client can resume
+func (h *Handler) uploadStatus(c *gin.Context) {
+	c.Header("Tus-Resumable", tusVersion)
+	userID, ok := uploadUser(c)
+	if !ok {
+		return
+	}
+	id := c.Param("id")
+	if !primitive.IsValidObjectID(id) {
+		c.Status(http.StatusNotFound)
+		return
+	}
+
+	status, err := h.useCase.GetUpload(c.Request.Context(), userID, id)
+	if err != nil {
+		c.Status(http.StatusNotFound)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	writeUploadStatus(c, status)
+	c.Status(http.StatusOK)
+}
+
+// appendUpload receives the next chunk of an upload; it must start at the offset the server reported
+func (h *Handler) appendUpload(c *gin.Context) {
+	c.Header("Tus-Resumable", tusVersion)
+	userID, ok := uploadUser(c)
+	if !ok {
+		return
+	}
+	id := c.Param("id")
+	if !primitive.IsValidObjectID(id) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Загрузка не найдена"})
+		return
+	}
+	if c.ContentType() != tusChunkMediaType {
+		c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": "Ожидается Content-Type " + tusChunkMediaType})
+		return
+	}
+	offset, err := strconv.ParseInt(c.GetHeader("Upload-Offset"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Неверный заголовок Upload-Offset"})
+		return
+	}
+
+	// Clients resume by asking for the offset again (HEAD), which a conflict tells them to do
+	current, err := h.useCase.GetUpload(c.Request.Context(), userID, id)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if offset != current.Offset {
+		c.JSON(http.StatusConflict, gin.H{"error": "Смещение не совпадает с полученным объемом"})
+		return
+	}
+	if c.Request.ContentLength > current.Length-current.Offset {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "Данных больше, чем заявленный размер файла"})
+		return
+	}
+
+	status, err := h.useCase.AppendUpload(c.Request.Context(), userID, id, offset, c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	writeUploadStatus(c, status)
+	c.Status(http.StatusNoContent)
+}
+
+// deleteUpload cancels an upload and deletes the received bytes
+func (h *Handler) deleteUpload(c *gin.Context) {
+	c.Header("Tus-Resumable", tusVersion)
+	userID, ok := uploadUser(c)
+	if !ok {
+		return
+	}
+	id := c.Param("id")
+	if !primitive.IsValidObjectID(id) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Загрузка не найдена"})
+		return
+	}
+
+	if err := h.useCase.DeleteUpload(c.Request.Context(), userID, id); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// uploadUser returns the ID of the signed in user, or responds with 401
+func uploadUser(c *gin.Context) (string, bool) {
+	user, err := auth.GetCurrentUser(c)
+	if err != nil || user == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Не авторизован"})
+		return "", false
+	}
+	return user.ID, true
+}
+
+func writeUploadStatus(c *gin.Context, status *usecase.UploadStatus) {
+	c.Header("Upload-Offset", strconv.FormatInt(status.Offset, 10))
+	c.Header("Upload-Length", strconv.FormatInt(status.Length, 10))
+	c.Header("Upload-Expires", status.ExpiresAt.UTC().Format(http.TimeFormat))
+}
+
+// parseUploadMetadata decodes Upload-Metadata: comma-separated pairs of a key and a base64 value
+func parseUploadMetadata(header string) map[string]string {
+	metadata := make(map[string]string)
+	for _, pair := range strings.Split(header, ",") {
+		key, encoded, _ := strings.Cut(strings.TrimSpace(pair), " ")
+		if key == "" {
+			continue
+		}
+		value, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			continue
+		}
+		metadata[key] = string(value)
+	}
+	return metadata
+}
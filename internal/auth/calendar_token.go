@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// calendarTokenPurpose distinguishes calendar feed tokens from session tokens
+const calendarTokenPurpose = "calendar"
+
+// CalendarClaims represents claims of a personal calendar feed token
+type CalendarClaims struct {
+	Sub     string `json:"sub"` // userId
+	Purpose string `json:"purpose"`
+	Version int    `json:"ver,omitempty"` // Calendar token version of the user at signing time
+	jwt.RegisteredClaims
+}
+
+// SignCalendarToken creates a non-expiring token for the user's personal calendar feed URL
+// Calendar apps cannot send cookies, so the token is embedded in the URL; it stays valid
+// while the user's calendar token version equals version
+func SignCalendarToken(userID string, version int) (string, error) {
+	if jwtSecret == nil {
+		if err := InitJWT(); err != nil {
+			return "", err
+		}
+	}
+
+	claims := CalendarClaims{
+		Sub:     userID,
+		Purpose: calendarTokenPurpose,
+		Version: version,
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(jwtSecret)
+}
+
+// VerifyCalendarToken verifies the signature of a calendar feed token and returns the user ID and token version;
+// the caller checks the version against the user
+func VerifyCalendarToken(tokenString string) (string, int, error) {
+	if jwtSecret == nil {
+		if err := InitJWT(); err != nil {
+			return "", 0, err
+		}
+	}
+
+	token, err := jwt.ParseWithClaims(tokenString, &CalendarClaims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return jwtSecret, nil
+	})
+	if err != nil {
+		return "", 0, err
+	}
+
+	claims, ok := token.Claims.(*CalendarClaims)
+	if !ok || !token.Valid || claims.Purpose != calendarTokenPurpose || claims.Sub == "" {
+		return "", 0, fmt.Errorf("invalid calendar token")
+	}
+	return claims.Sub, claims.Version, nil
+}
@@ -0,0 +1,61 @@
+package auth
+
+import (
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// checkInTokenPurpose distinguishes check-in QR tokens from other tokens
+const checkInTokenPurpose = "checkin"
+
+// CheckInClaims represents claims of a registration check-in token (encoded in the QR code)
+type CheckInClaims struct {
+	Sub         string `json:"sub"` // registrationId
+	Competition string `json:"cid"`
+	Purpose     string `json:"purpose"`
+	jwt.RegisteredClaims
+}
+
+// SignCheckInToken creates the signed token printed as the registration QR code
+func SignCheckInToken(registrationID, competitionID string) (string, error) {
+	if jwtSecret == nil {
+		if err := InitJWT(); err != nil {
+			return "", err
+		}
+	}
+
+	claims := CheckInClaims{
+		Sub:         registrationID,
+		Competition: competitionID,
+		Purpose:     checkInTokenPurpose,
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(jwtSecret)
+}
+
+// VerifyCheckInToken verifies a check-in token and returns the registration and competition IDs
+func VerifyCheckInToken(tokenString string) (string, string, error) {
+	if jwtSecret == nil {
+		if err := InitJWT(); err != nil {
+			return "", "", err
+		}
+	}
+
+	token, err := jwt.ParseWithClaims(tokenString, &CheckInClaims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return jwtSecret, nil
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	claims, ok := token.Claims.(*CheckInClaims)
+	if !ok || !token.Valid || claims.Purpose != checkInTokenPurpose || claims.Sub == "" || claims.Competition == "" {
+		return "", "", fmt.Errorf("invalid check-in token")
+	}
+	return claims.Sub, claims.Competition, nil
+}
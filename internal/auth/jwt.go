@@ -24,8 +24,9 @@ func InitJWT() error {
 
 // AuthClaims represents JWT claims
 type AuthClaims struct {
-	Sub   string `json:"sub"` // userId
-	Email string `json:"email"`
+	Sub     string `json:"sub"` // userId
+	Email   string `json:"email"`
+	Purpose string `json:"purpose,omitempty"` // Set only on special-purpose tokens (e.g. calendar feeds)
 	jwt.RegisteredClaims
 }
 
@@ -69,7 +70,8 @@ func VerifyToken(tokenString string) (*AuthClaims, error) {
 		return nil, err
 	}
 
-	if claims, ok := token.Claims.(*AuthClaims); ok && token.Valid {
+	// Special-purpose tokens must not be accepted as session tokens
+	if claims, ok := token.Claims.(*AuthClaims); ok && token.Valid && claims.Purpose == "" {
 		return claims, nil
 	}
 
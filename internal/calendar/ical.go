@@ -0,0 +1,224 @@
+package calendar
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+)
+
+const (
+	prodID              = "-//CNPF Feeder//Competitions//RU"
+	uidDomain           = "cnpf-feeder"
+	defaultTimezone     = "Europe/Chisinau"
+	defaultTourDuration = 5 * time.Hour
+	maxLineOctets       = 75
+)
+
+// Options controls how competitions are rendered into a calendar
+type Options struct {
+	Name         string         // X-WR-CALNAME shown by calendar apps
+	Location     *time.Location // Time zone Tour.Time and OpeningTime are expressed in
+	TourDuration time.Duration  // Length of a tour event when only the start time is known
+}
+
+// DefaultOptions returns options with the time zone from CALENDAR_TIMEZONE (Europe/Chisinau by default)
+func DefaultOptions(name string) Options {
+	tz := os.Getenv("CALENDAR_TIMEZONE")
+	if tz == "" {
+		tz = defaultTimezone
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		loc = time.UTC
+	}
+	return Options{
+		Name:         name,
+		Location:     loc,
+		TourDuration: defaultTourDuration,
+	}
+}
+
+// Render renders competitions as an RFC 5545 calendar
+// Each tour becomes a VEVENT; the registration opening becomes a separate VEVENT
+func Render(competitions []*entity.Competition, opts Options) []byte {
+	if opts.Location == nil {
+		opts.Location = time.UTC
+	}
+	if opts.TourDuration <= 0 {
+		opts.TourDuration = defaultTourDuration
+	}
+
+	w := &writer{}
+	w.line("BEGIN:VCALENDAR")
+	w.line("VERSION:2.0")
+	w.line("PRODID:" + prodID)
+	w.line("CALSCALE:GREGORIAN")
+	w.line("METHOD:PUBLISH")
+	if opts.Name != "" {
+		w.line("X-WR-CALNAME:" + escapeText(opts.Name))
+	}
+	w.line("X-WR-TIMEZONE:" + opts.Location.String())
+
+	for _, competition := range competitions {
+		if competition == nil {
+			continue
+		}
+		stamp := competition.UpdatedAt
+		if stamp.IsZero() {
+			stamp = time.Now()
+		}
+
+		for i, tour := range competition.Tours {
+			summary := competition.Title
+			if len(competition.Tours) > 1 {
+				summary = fmt.Sprintf("%s — тур %d", competition.Title, i+1)
+			}
+			w.event(event{
+				uid:         fmt.Sprintf("%s-tour-%d@%s", competition.ID, i+1, uidDomain),
+				stamp:       stamp,
+				date:        tour.Date,
+				clock:       tour.Time,
+				duration:    opts.TourDuration,
+				summary:     summary,
+				location:    competition.Location,
+				description: describeCompetition(competition),
+			}, opts.Location)
+		}
+
+		if competition.OpeningDate != nil {
+			clock := ""
+			if competition.OpeningTime != nil {
+				clock = *competition.OpeningTime
+			}
+			w.event(event{
+				uid:         fmt.Sprintf("%s-registration@%s", competition.ID, uidDomain),
+				stamp:       stamp,
+				date:        *competition.OpeningDate,
+				clock:       clock,
+				duration:    30 * time.Minute,
+				summary:     "Открытие регистрации: " + competition.Title,
+				location:    competition.Location,
+				description: describeCompetition(competition),
+			}, opts.Location)
+		}
+	}
+
+	w.line("END:VCALENDAR")
+	return []byte(w.String())
+}
+
+// event is a single VEVENT before formatting
+type event struct {
+	uid         string
+	stamp       time.Time
+	date        time.Time
+	clock       string // "HH:MM"; empty means all-day event
+	duration    time.Duration
+	summary     string
+	location    string
+	description string
+}
+
+// writer accumulates content lines, folding them at 75 octets with CRLF endings
+type writer struct {
+	strings.Builder
+}
+
+func (w *writer) line(s string) {
+	limit := maxLineOctets
+	for len(s) > limit {
+		cut := limit
+		// Do not split a multi-byte UTF-8 sequence
+		for cut > 0 && s[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		w.WriteString(s[:cut])
+		w.WriteString("\r\n ")
+		s = s[cut:]
+		limit = maxLineOctets - 1 // Continuation lines start with a space
+	}
+	w.WriteString(s)
+	w.WriteString("\r\n")
+}
+
+func (w *writer) event(e event, loc *time.Location) {
+	// The stored date may be midnight UTC or local midnight converted to UTC;
+	// the calendar day is taken in the competition time zone in both cases
+	local := e.date.In(loc)
+	year, month, day := local.Date()
+
+	w.line("BEGIN:VEVENT")
+	w.line("UID:" + e.uid)
+	w.line("DTSTAMP:" + formatUTC(e.stamp))
+
+	if hour, minute, ok := parseClock(e.clock); ok {
+		start := time.Date(year, month, day, hour, minute, 0, 0, loc)
+		w.line("DTSTART:" + formatUTC(start))
+		w.line("DTEND:" + formatUTC(start.Add(e.duration)))
+	} else {
+		start := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		w.line("DTSTART;VALUE=DATE:" + start.Format("20060102"))
+		w.line("DTEND;VALUE=DATE:" + start.AddDate(0, 0, 1).Format("20060102"))
+	}
+
+	w.line("SUMMARY:" + escapeText(e.summary))
+	if e.location != "" {
+		w.line("LOCATION:" + escapeText(e.location))
+	}
+	if e.description != "" {
+		w.line("DESCRIPTION:" + escapeText(e.description))
+	}
+	w.line("END:VEVENT")
+}
+
+// describeCompetition builds a short plain-text description of a competition
+func describeCompetition(c *entity.Competition) string {
+	var parts []string
+	var formats []string
+	if c.IndividualFormat {
+		formats = append(formats, "личный зачет")
+	}
+	if c.TeamFormat {
+		formats = append(formats, "командный зачет")
+	}
+	if len(formats) > 0 {
+		parts = append(parts, "Формат: "+strings.Join(formats, ", "))
+	}
+	if c.Fee != nil {
+		parts = append(parts, fmt.Sprintf("Взнос: %.2f", *c.Fee))
+	}
+	if len(c.Tours) > 0 {
+		parts = append(parts, fmt.Sprintf("Туров: %d", len(c.Tours)))
+	}
+	return strings.Join(parts, "\n")
+}
+
+// parseClock parses "HH:MM" (also "H:MM" and "HH.MM")
+func parseClock(s string) (int, int, bool) {
+	s = strings.TrimSpace(strings.ReplaceAll(s, ".", ":"))
+	if s == "" {
+		return 0, 0, false
+	}
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, 0, false
+	}
+	return t.Hour(), t.Minute(), true
+}
+
+func formatUTC(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// escapeText escapes a TEXT value per RFC 5545 section 3.3.11
+func escapeText(s string) string {
+	s = strings.ReplaceAll(s, "\\", "\\\\")
+	s = strings.ReplaceAll(s, ";", "\\;")
+	s = strings.ReplaceAll(s, ",", "\\,")
+	s = strings.ReplaceAll(s, "\r\n", "\\n")
+	s = strings.ReplaceAll(s, "\n", "\\n")
+	return s
+}
@@ -2,6 +2,10 @@ package domain
 
 import (
 	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
 )
 
 // Config represents application configuration
@@ -20,6 +24,12 @@ type Config struct {
 	
 	// Logging
 	LogLevel     string
+	
+	// Trash
+	TrashRetentionDays int // Deleted items can be restored during this period, then they are purged
+	
+	// Storage
+	StorageLimits map[string]entity.StorageLimits // Upload limits by role
 }
 
 // LoadConfig loads configuration from environment variables
@@ -32,6 +42,25 @@ func LoadConfig() *Config {
 		MongoDBName: getEnv("MONGODB_NAME", ""),
 		AuthSecret:  getEnv("AUTH_SECRET", ""),
 		LogLevel:    getEnv("LOGLEVEL", "info"),
+		
+		TrashRetentionDays: getEnvInt("TRASH_RETENTION_DAYS", 30),
+		
+		StorageLimits: map[string]entity.StorageLimits{
+			entity.RoleUser:  getStorageLimits(entity.RoleUser, 200),
+			entity.RoleAdmin: getStorageLimits(entity.RoleAdmin, 2048),
+		},
+	}
+}
+
+// getStorageLimits loads the limits of a role from STORAGE_<ROLE>_* variables
+// (QUOTA_MB, MAX_REPORT_PHOTOS, MAX_PHOTO_KB, MAX_AVATAR_KB)
+func getStorageLimits(role string, defaultQuotaMB int) entity.StorageLimits {
+	prefix := "STORAGE_" + strings.ToUpper(role) + "_"
+	return entity.StorageLimits{
+		MaxReportPhotos: getEnvInt(prefix+"MAX_REPORT_PHOTOS", 10),
+		MaxPhotoSize:    int64(getEnvInt(prefix+"MAX_PHOTO_KB", 2048)) * 1024,
+		MaxAvatarSize:   int64(getEnvInt(prefix+"MAX_AVATAR_KB", 512)) * 1024,
+		Quota:           int64(getEnvInt(prefix+"QUOTA_MB", defaultQuotaMB)) * 1024 * 1024,
 	}
 }
 
@@ -41,3 +70,10 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+func getEnvInt(key string, defaultValue int) int {
+	if value, err := strconv.Atoi(os.Getenv(key)); err == nil && value > 0 {
+		return value
+	}
+	return defaultValue
+}
@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+// CheckIn records the arrival of a registration (angler or team) for a tour
+type CheckIn struct {
+	ID             string
+	CompetitionID  string
+	RegistrationID string
+	Tour           int    // 1-based tour number
+	JudgeID        string // User who scanned the QR code
+	CreatedAt      time.Time
+}
@@ -0,0 +1,28 @@
+package entity
+
+import "time"
+
+// CommentStatus is the moderation state of a comment
+type CommentStatus string
+
+const (
+	CommentStatusNew      CommentStatus = "new"      // Visible, waiting in the moderation queue
+	CommentStatusApproved CommentStatus = "approved" // Visible, checked by a moderator
+	CommentStatusHidden   CommentStatus = "hidden"   // Hidden by a moderator, visible to its author and admins only
+)
+
+// Comment is a message in the discussion of a report; replies reference their parent comment
+type Comment struct {
+	ID          string
+	ReportID    string
+	ParentID    *string // Comment this one replies to, nil for top-level comments
+	AuthorID    string
+	Text        string
+	Status      CommentStatus
+	ModeratedBy *string
+	ModeratedAt *time.Time
+	Deleted     bool // Deleted comment kept as a placeholder because it has replies
+	EditedAt    *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
@@ -8,6 +8,20 @@ type Tour struct {
 	Time string
 }
 
+// TeamRules defines the composition of a team registration (pairs, 3-person teams, 4+1 teams, ...)
+type TeamRules struct {
+	MinSize         int // Main participants, reserves not counted
+	MaxSize         int
+	MaxReserves     int
+	MaxCoaches      int
+	CaptainRequired bool
+}
+
+// DefaultTeamRules are the rules of competitions without explicit team rules: three anglers and a coach
+func DefaultTeamRules() TeamRules {
+	return TeamRules{MinSize: 3, MaxSize: 3, MaxReserves: 0, MaxCoaches: 1}
+}
+
 // Competition represents a competition domain entity
 type Competition struct {
 	ID               string
@@ -15,6 +29,7 @@ type Competition struct {
 	StartDate        *time.Time
 	EndDate          *time.Time
 	Location         string
+	VenueID          *string // Optional reference to a Venue
 	Tours            []Tour
 	OpeningDate      *time.Time
 	OpeningTime      *string
@@ -23,6 +38,20 @@ type Competition struct {
 	Fee              *float64
 	TeamLimit        *int
 	Regulations      *string
+	JudgeIDs         []string          // Users allowed to issue penalties
+	ProtestWindow    *int              // Minutes after a result or penalty is posted during which protests are accepted
+	Eligibility      *EligibilityRules // Optional participant restrictions (category, age, gender, license)
+	TeamRules        *TeamRules        // Team composition; nil means DefaultTeamRules
 	CreatedAt        time.Time
 	UpdatedAt        time.Time
+	DeletedAt        *time.Time // Set while the competition is in the trash, purged after the retention period
+	DeletedBy        *string
+}
+
+// TeamRulesOrDefault returns the team rules of the competition or the default ones
+func (c *Competition) TeamRulesOrDefault() TeamRules {
+	if c.TeamRules == nil {
+		return DefaultTeamRules()
+	}
+	return *c.TeamRules
 }
@@ -0,0 +1,33 @@
+package entity
+
+import "time"
+
+// TemplateTour is a tour of a competition template, relative to the start date
+type TemplateTour struct {
+	DayOffset int // Days after the competition start date
+	Time      string
+}
+
+// CompetitionTemplate is a saved competition setup reused for recurring events
+// Dates are stored as day offsets from the start date so the template fits any season
+type CompetitionTemplate struct {
+	ID                string
+	Name              string
+	Title             string
+	Location          string
+	VenueID           *string
+	DurationDays      int // EndDate - StartDate in days
+	Tours             []TemplateTour
+	OpeningOffsetDays *int // Registration opening relative to the start date (usually negative)
+	OpeningTime       *string
+	IndividualFormat  bool
+	TeamFormat        bool
+	Fee               *float64
+	TeamLimit         *int
+	Regulations       *string
+	ProtestWindow     *int
+	Eligibility       *EligibilityRules
+	TeamRules         *TeamRules
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
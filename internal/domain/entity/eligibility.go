@@ -0,0 +1,39 @@
+package entity
+
+import "time"
+
+// Gender of a participant, used by eligibility rules
+type Gender string
+
+const (
+	GenderMale   Gender = "male"
+	GenderFemale Gender = "female"
+)
+
+// IsValidGender checks if the gender is one of the known values
+func IsValidGender(g Gender) bool {
+	return g == GenderMale || g == GenderFemale
+}
+
+// EligibilityRules restricts who may take part in a competition (juniors, women, veterans, licensed anglers)
+// Every participant of a registration must satisfy all set rules; coaches are not checked
+type EligibilityRules struct {
+	Category        *string // Display name of the category, e.g. "Юниоры"
+	MinAge          *int    // Full years on the competition start date
+	MaxAge          *int
+	Gender          *Gender
+	LicenseRequired bool // Participants must provide a license number
+}
+
+// IsEmpty reports whether the rules restrict nothing
+func (r *EligibilityRules) IsEmpty() bool {
+	return r == nil || (r.MinAge == nil && r.MaxAge == nil && r.Gender == nil && !r.LicenseRequired)
+}
+
+// EligibilityOverride records an admin decision to accept a registration that breaks the eligibility rules
+type EligibilityOverride struct {
+	AdminID    string
+	Reason     string
+	Violations []string // Rule violations at the time of the override
+	CreatedAt  time.Time
+}
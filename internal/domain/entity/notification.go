@@ -0,0 +1,16 @@
+package entity
+
+import "time"
+
+// Notification is an in-app message for a user (also sent by e-mail when configured)
+type Notification struct {
+	ID         string
+	UserID     string
+	Type       string // e.g. "protest_filed", "protest_decided"
+	Title      string
+	Message    string
+	EntityType string // Related entity kind, e.g. "protest"
+	EntityID   string
+	ReadAt     *time.Time
+	CreatedAt  time.Time
+}
@@ -0,0 +1,56 @@
+package entity
+
+import "time"
+
+// PenaltyType represents the kind of rule violation sanction
+type PenaltyType string
+
+const (
+	PenaltyTypeWarning          PenaltyType = "warning"
+	PenaltyTypeYellowCard       PenaltyType = "yellow_card"
+	PenaltyTypeDisqualification PenaltyType = "disqualification"
+)
+
+// PenaltyAction represents what happened to a penalty in its history
+type PenaltyAction string
+
+const (
+	PenaltyActionCreated PenaltyAction = "created"
+	PenaltyActionUpdated PenaltyAction = "updated"
+	PenaltyActionDeleted PenaltyAction = "deleted"
+)
+
+// Penalty represents a sanction issued by a judge to a registration (angler or team)
+// Penalties are never removed physically: deletion sets DeletedAt and is kept in History
+type Penalty struct {
+	ID             string
+	CompetitionID  string
+	RegistrationID string
+	Tour           *int // 1-based tour number; nil means the whole competition
+	Type           PenaltyType
+	Reason         string
+	JudgeID        string // User who issued the penalty
+	History        []PenaltyChange
+	DeletedAt      *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// PenaltyChange is a history entry: the penalty state after a change and who made it
+type PenaltyChange struct {
+	Action    PenaltyAction
+	UserID    string
+	Tour      *int
+	Type      PenaltyType
+	Reason    string
+	ChangedAt time.Time
+}
+
+// IsValidPenaltyType checks if the penalty type is known
+func IsValidPenaltyType(t PenaltyType) bool {
+	switch t {
+	case PenaltyTypeWarning, PenaltyTypeYellowCard, PenaltyTypeDisqualification:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,39 @@
+package entity
+
+import "time"
+
+// ProtestTarget is what a protest disputes
+type ProtestTarget string
+
+const (
+	ProtestTargetResult  ProtestTarget = "result"
+	ProtestTargetPenalty ProtestTarget = "penalty"
+)
+
+// ProtestStatus represents the state of a protest
+type ProtestStatus string
+
+const (
+	ProtestStatusOpen      ProtestStatus = "open"
+	ProtestStatusUpheld    ProtestStatus = "upheld"
+	ProtestStatusRejected  ProtestStatus = "rejected"
+	ProtestStatusWithdrawn ProtestStatus = "withdrawn"
+)
+
+// Protest is a participant's formal objection to a tour result or a penalty, decided by the jury
+type Protest struct {
+	ID             string
+	CompetitionID  string
+	RegistrationID string // Registration of the participant filing the protest
+	FiledBy        string // User who filed the protest
+	TargetType     ProtestTarget
+	TargetID       string // TourResult or Penalty ID
+	TargetRegID    string // Registration the disputed result or penalty belongs to
+	Reason         string
+	Status         ProtestStatus
+	Decision       *string
+	DecidedBy      *string
+	DecidedAt      *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
@@ -0,0 +1,25 @@
+package entity
+
+// ReactionType is a kind of reader reaction to a report
+type ReactionType string
+
+const (
+	ReactionLike  ReactionType = "like"  // 👍
+	ReactionHeart ReactionType = "heart" // ❤️
+	ReactionFire  ReactionType = "fire"  // 🔥
+	ReactionFish  ReactionType = "fish"  // 🐟
+	ReactionWow   ReactionType = "wow"   // 😮
+)
+
+// ReactionTypes lists reaction types in the order they are shown
+var ReactionTypes = []ReactionType{ReactionLike, ReactionHeart, ReactionFire, ReactionFish, ReactionWow}
+
+// IsValidReactionType checks if the reaction type is supported
+func IsValidReactionType(reactionType ReactionType) bool {
+	for _, t := range ReactionTypes {
+		if t == reactionType {
+			return true
+		}
+	}
+	return false
+}
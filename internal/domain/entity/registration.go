@@ -18,15 +18,26 @@ type Registration struct {
 	Type            RegistrationType
 	TeamName        *string // Only for team registrations
 	Participants    []Participant
-	Coach           *Coach // Optional coach for team registrations
+	Coaches         []Coach // Team coaches, limited by the competition team rules
+	Sector          *string // Assigned by organizers (draw)
+	Peg             *int    // Peg number within the sector
+	EligibilityOverride *EligibilityOverride // Set when an admin accepted an ineligible registration
+	PaidAt          *time.Time // Entry fee payment confirmed by an admin
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
+	DeletedAt       *time.Time // Set while the registration is in the trash
+	DeletedBy       *string
 }
 
 // Participant represents a participant in a registration
 type Participant struct {
-	FirstName string
-	LastName  string
+	FirstName     string
+	LastName      string
+	BirthDate     *time.Time // Optional; required by competitions with age rules
+	Gender        *Gender
+	LicenseNumber *string
+	Reserve       bool // Reserve (substitute) team member, not counted in the team size
+	Captain       bool
 }
 
 // Coach represents a coach for team registration
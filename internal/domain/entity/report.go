@@ -2,13 +2,122 @@ package entity
 
 import "time"
 
-// Report represents a report domain entity
-type Report struct {
+// ReportStatus is the publication state of a report
+type ReportStatus string
+
+const (
+	ReportStatusDraft     ReportStatus = "draft"     // Visible to the author and admins only
+	ReportStatusScheduled ReportStatus = "scheduled" // Published by the background job at PublishAt
+	ReportStatusPublished ReportStatus = "published"
+)
+
+// IsValidReportStatus checks if the report status is one of the known values
+func IsValidReportStatus(status ReportStatus) bool {
+	return status == ReportStatusDraft || status == ReportStatusScheduled || status == ReportStatusPublished
+}
+
+// ReportCategory is one of the fixed report categories
+type ReportCategory string
+
+const (
+	ReportCategoryNews        ReportCategory = "news"
+	ReportCategoryCompetition ReportCategory = "competition_report"
+	ReportCategoryTechnique   ReportCategory = "technique"
+	ReportCategoryTackle      ReportCategory = "tackle_review"
+)
+
+// ReportCategoryNames lists report categories with their display names, in display order
+var ReportCategoryNames = []struct {
+	Category ReportCategory
+	Name     string
+}{
+	{ReportCategoryNews, "Новости"},
+	{ReportCategoryCompetition, "Отчет с соревнований"},
+	{ReportCategoryTechnique, "Техника ловли"},
+	{ReportCategoryTackle, "Обзор снастей"},
+}
+
+// IsValidReportCategory checks if the category is one of the fixed categories
+func IsValidReportCategory(category ReportCategory) bool {
+	for _, c := range ReportCategoryNames {
+		if c.Category == category {
+			return true
+		}
+	}
+	return false
+}
+
+// ReportFilter selects published reports; empty fields match all reports
+type ReportFilter struct {
+	CompetitionID *string
+	Tags          []string // Reports having all of the tags
+	Category      *ReportCategory
+}
+
+// TagCount is a tag with the number of published reports using it
+type TagCount struct {
+	Tag   string
+	Count int
+}
+
+// Modification is the time a record was last updated (sitemaps list pages with it)
+type Modification struct {
 	ID        string
-	AuthorID  string
-	Title     string
-	Text      string
-	Photos    []interface{} // Photo data
-	CreatedAt time.Time
 	UpdatedAt time.Time
 }
+
+// ReportPhoto is a photo of a report with a stable ID, addressed by it in URLs and edits
+type ReportPhoto struct {
+	ID           string
+	ContentType  string
+	Data         []byte // Not loaded by gallery queries
+	Caption      string
+	Photographer string // Credit, may differ from the report author
+	Position     int    // Display order within the report, lowest first
+	Size         int64  // Bytes of the image, also when Data is not loaded
+	UploadedBy   string // User whose storage quota the photo is counted against
+	CreatedAt    time.Time
+}
+
+// GalleryPhoto is a photo of a published report in the gallery of the competition the report is linked to
+type GalleryPhoto struct {
+	Photo       ReportPhoto
+	ReportID    string
+	ReportTitle string
+	Tour        *int
+}
+
+// Report represents a report domain entity
+type Report struct {
+	ID            string
+	AuthorID      string
+	Title         string
+	Text          string
+	Photos        []ReportPhoto // Ordered by Position
+	CompetitionID *string       // Optional competition the report is about
+	Tour          *int          // Optional tour number (1-based) within the competition
+	Tags          []string      // Normalized: lowercase, without '#'
+	Category      *ReportCategory
+	Status        ReportStatus
+	PublishAt     *time.Time // Scheduled publication time
+	PublishedAt   *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	DeletedAt     *time.Time // Set while the report is in the trash
+	DeletedBy     *string
+}
+
+// IsPublished reports whether the report is visible to everyone
+func (r *Report) IsPublished() bool {
+	return r.Status == ReportStatusPublished
+}
+
+// PhotoIndex returns the index of the photo with the ID in Photos, or -1 if the report has no such photo
+func (r *Report) PhotoIndex(id string) int {
+	for i, photo := range r.Photos {
+		if photo.ID == id {
+			return i
+		}
+	}
+	return -1
+}
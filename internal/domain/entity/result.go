@@ -0,0 +1,15 @@
+package entity
+
+import "time"
+
+// TourResult represents the catch of one registration (angler or team) in one tour
+type TourResult struct {
+	ID             string
+	CompetitionID  string
+	RegistrationID string
+	Tour           int // 1-based tour number
+	Weight         int // Total catch weight in grams
+	FishCount      int
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
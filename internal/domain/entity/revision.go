@@ -0,0 +1,31 @@
+package entity
+
+import "time"
+
+// RevisionEntityType is the kind of entity with revision history
+type RevisionEntityType string
+
+const (
+	RevisionEntityReport      RevisionEntityType = "report"
+	RevisionEntityCompetition RevisionEntityType = "competition"
+)
+
+// FieldChange is a field changed by an update; values are rendered as text, nil means empty
+type FieldChange struct {
+	Field    string
+	OldValue *string
+	NewValue *string
+}
+
+// Revision is a prior version of a report or a competition, stored when it is updated
+type Revision struct {
+	ID          string
+	EntityType  RevisionEntityType
+	EntityID    string
+	Version     int           // Sequential per entity, starting at 1
+	EditorID    string        // User whose update replaced this version
+	Report      *Report       // Report before the update; photos are not versioned
+	Competition *Competition  // Competition before the update
+	Changes     []FieldChange // Fields changed by the update
+	CreatedAt   time.Time
+}
@@ -0,0 +1,23 @@
+package entity
+
+// Roles with their own storage limits
+const (
+	RoleUser  = "user"
+	RoleAdmin = "admin"
+)
+
+// StorageLimits are the upload limits of a role
+type StorageLimits struct {
+	MaxReportPhotos int   // Photos in one report
+	MaxPhotoSize    int64 // Bytes of one photo
+	MaxAvatarSize   int64 // Bytes of an avatar
+	Quota           int64 // Bytes of report photos, avatar and unattached uploads one user may store
+}
+
+// Role returns the role whose storage limits apply to the user
+func (u *User) Role() string {
+	if u.IsAdmin {
+		return RoleAdmin
+	}
+	return RoleUser
+}
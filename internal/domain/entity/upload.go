@@ -0,0 +1,21 @@
+package entity
+
+import "time"
+
+// Upload is a file sent in chunks through the resumable upload protocol (tus), kept until it is
+// attached to a report or expires
+type Upload struct {
+	ID          string
+	UserID      string
+	ContentType string
+	FileName    string
+	Length      int64 // Total size declared when the upload was created
+	Offset      int64 // Bytes received so far
+	CreatedAt   time.Time
+	ExpiresAt   time.Time // Extended by every received chunk
+}
+
+// IsComplete reports whether all declared bytes have been received
+func (u *Upload) IsComplete() bool {
+	return u.Offset >= u.Length
+}
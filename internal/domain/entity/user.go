@@ -5,12 +5,18 @@ import "time"
 // User represents a user domain entity
 // This is the core domain entity - it doesn't depend on anything
 type User struct {
-	ID           string
-	Email        string
-	Username     string
-	PasswordHash string
-	IsAdmin      bool
-	HasAvatar    bool
-	Avatar       map[string]interface{} // Avatar data (can be nil)
-	CreatedAt    time.Time
+	ID                   string
+	Email                string
+	Username             string
+	PasswordHash         string
+	IsAdmin              bool
+	HasAvatar            bool
+	Avatar               map[string]interface{} // Avatar data (can be nil)
+	AvatarSize           int64                  // Bytes of the avatar image
+	StorageUsed          int64                  // Bytes the user stores, counted against the quota of the role
+	CalendarTokenVersion int                    // Embedded in calendar feed URLs; increasing it revokes the URLs issued before
+	CreatedAt            time.Time
 }
+
+// DeletedUserID replaces the author or owner of records kept after their user was deleted
+const DeletedUserID = "000000000000000000000000"
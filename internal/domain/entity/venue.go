@@ -0,0 +1,30 @@
+package entity
+
+import "time"
+
+// GeoPoint represents geographic coordinates (WGS84)
+type GeoPoint struct {
+	Lat float64
+	Lon float64
+}
+
+// VenueSector represents a sector of the venue with its peg range
+type VenueSector struct {
+	Name    string
+	PegFrom int
+	PegTo   int
+}
+
+// Venue represents a competition venue domain entity
+type Venue struct {
+	ID         string
+	NameRu     string
+	NameRo     string
+	Location   GeoPoint
+	WaterBody  *string // Lake, river, reservoir name
+	Sectors    []VenueSector
+	Directions *string
+	Photos     []interface{} // Photo data
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
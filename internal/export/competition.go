@@ -0,0 +1,182 @@
+package export
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	"github.com/cnpf/feeder-backend/internal/standings"
+)
+
+// StartList builds the start list of a competition: registrations ordered by sector and peg
+func (t *Template) StartList(competition *entity.Competition, registrations []*entity.Registration) (*Document, error) {
+	doc, err := t.newDocument(t.StartListTitle, competition)
+	if err != nil {
+		return nil, err
+	}
+
+	sorted := SortBySectorAndPeg(registrations)
+
+	doc.Columns = []string{"№", "Сектор", "Номер", "Участник / команда", "Состав", "Тренер"}
+	doc.Rows = make([][]string, 0, len(sorted))
+	for i, reg := range sorted {
+		doc.Rows = append(doc.Rows, []string{
+			strconv.Itoa(i + 1),
+			sectorName(reg),
+			pegNumber(reg),
+			RegistrationName(reg),
+			teamMembers(reg),
+			coachName(reg),
+		})
+	}
+
+	return doc, nil
+}
+
+// Protocol builds the result protocol of a competition from computed standings
+func (t *Template) Protocol(competition *entity.Competition, rows []*standings.Row) (*Document, error) {
+	doc, err := t.newDocument(t.ProtocolTitle, competition)
+	if err != nil {
+		return nil, err
+	}
+
+	tours := 0
+	if len(rows) > 0 {
+		tours = len(rows[0].TourWeights)
+	}
+
+	doc.Columns = []string{"Место", "Сектор", "Номер", "Участник / команда"}
+	for i := 1; i <= tours; i++ {
+		doc.Columns = append(doc.Columns, fmt.Sprintf("Тур %d, кг", i))
+	}
+	doc.Columns = append(doc.Columns, "Итого, кг", "Рыб", "Штрафы")
+
+	doc.Rows = make([][]string, 0, len(rows))
+	for _, row := range rows {
+		place := strconv.Itoa(row.Place)
+		if row.Disqualified {
+			place = "дискв."
+		}
+		values := []string{
+			place,
+			sectorName(row.Registration),
+			pegNumber(row.Registration),
+			RegistrationName(row.Registration),
+		}
+		for _, weight := range row.TourWeights {
+			values = append(values, FormatWeight(weight))
+		}
+		values = append(values, FormatWeight(row.TotalWeight), strconv.Itoa(row.TotalFish), penaltySummary(row.Penalties))
+		doc.Rows = append(doc.Rows, values)
+	}
+
+	return doc, nil
+}
+
+// RegistrationName returns the team name or the full name of the single participant
+func RegistrationName(reg *entity.Registration) string {
+	if reg.TeamName != nil && strings.TrimSpace(*reg.TeamName) != "" {
+		return *reg.TeamName
+	}
+	if len(reg.Participants) > 0 {
+		p := reg.Participants[0]
+		return p.LastName + " " + p.FirstName
+	}
+	return ""
+}
+
+// SortBySectorAndPeg returns a copy of registrations ordered by sector and peg;
+// registrations without a sector go last, ordered by name
+func SortBySectorAndPeg(registrations []*entity.Registration) []*entity.Registration {
+	sorted := make([]*entity.Registration, len(registrations))
+	copy(sorted, registrations)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return lessBySectorAndPeg(sorted[i], sorted[j])
+	})
+	return sorted
+}
+
+// FormatWeight formats grams as kilograms with three decimals ("12.345")
+func FormatWeight(grams int) string {
+	return strconv.FormatFloat(float64(grams)/1000, 'f', 3, 64)
+}
+
+// penaltyNames are short protocol labels of penalty types
+var penaltyNames = map[entity.PenaltyType]string{
+	entity.PenaltyTypeWarning:          "предупреждение",
+	entity.PenaltyTypeYellowCard:       "желтая карточка",
+	entity.PenaltyTypeDisqualification: "дисквалификация",
+}
+
+// penaltySummary lists penalties as "Т2: желтая карточка (причина)"
+func penaltySummary(penalties []*entity.Penalty) string {
+	items := make([]string, len(penalties))
+	for i, p := range penalties {
+		item := penaltyNames[p.Type]
+		if item == "" {
+			item = string(p.Type)
+		}
+		if p.Tour != nil {
+			item = fmt.Sprintf("Т%d: %s", *p.Tour, item)
+		}
+		items[i] = fmt.Sprintf("%s (%s)", item, p.Reason)
+	}
+	return strings.Join(items, "; ")
+}
+
+func lessBySectorAndPeg(a, b *entity.Registration) bool {
+	if (a.Sector == nil) != (b.Sector == nil) {
+		return a.Sector != nil
+	}
+	if a.Sector != nil && *a.Sector != *b.Sector {
+		return *a.Sector < *b.Sector
+	}
+	if (a.Peg == nil) != (b.Peg == nil) {
+		return a.Peg != nil
+	}
+	if a.Peg != nil && *a.Peg != *b.Peg {
+		return *a.Peg < *b.Peg
+	}
+	return RegistrationName(a) < RegistrationName(b)
+}
+
+func sectorName(reg *entity.Registration) string {
+	if reg.Sector == nil {
+		return ""
+	}
+	return *reg.Sector
+}
+
+func pegNumber(reg *entity.Registration) string {
+	if reg.Peg == nil {
+		return ""
+	}
+	return strconv.Itoa(*reg.Peg)
+}
+
+func teamMembers(reg *entity.Registration) string {
+	if reg.Type != entity.RegistrationTypeTeam {
+		return ""
+	}
+	names := make([]string, len(reg.Participants))
+	for i, p := range reg.Participants {
+		names[i] = p.LastName + " " + p.FirstName
+		if p.Captain {
+			names[i] += " (к)"
+		}
+		if p.Reserve {
+			names[i] += " (зап.)"
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+func coachName(reg *entity.Registration) string {
+	names := make([]string, len(reg.Coaches))
+	for i, c := range reg.Coaches {
+		names[i] = c.LastName + " " + c.FirstName
+	}
+	return strings.Join(names, ", ")
+}
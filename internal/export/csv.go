@@ -0,0 +1,26 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+)
+
+// utf8BOM makes spreadsheet applications detect UTF-8 (Cyrillic names) in CSV files
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// renderCSV renders the table only: CSV is meant for further processing, not for printing
+func renderCSV(d *Document) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.Write(utf8BOM)
+
+	w := csv.NewWriter(&buf)
+	if err := w.Write(d.Columns); err != nil {
+		return nil, fmt.Errorf("failed to write CSV: %w", err)
+	}
+	if err := w.WriteAll(d.Rows); err != nil {
+		return nil, fmt.Errorf("failed to write CSV: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,62 @@
+package export
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Format is an export file format
+type Format string
+
+const (
+	FormatPDF  Format = "pdf"
+	FormatCSV  Format = "csv"
+	FormatXLSX Format = "xlsx"
+)
+
+// ParseFormat parses a format name (case-insensitive)
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(strings.TrimSpace(s))); f {
+	case FormatPDF, FormatCSV, FormatXLSX:
+		return f, nil
+	}
+	return "", fmt.Errorf("unsupported export format: %s", s)
+}
+
+// ContentType returns the MIME type of the format
+func (f Format) ContentType() string {
+	switch f {
+	case FormatPDF:
+		return "application/pdf"
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	case FormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "application/octet-stream"
+}
+
+// Document is a branded table ready to be rendered in any format
+type Document struct {
+	Organization string
+	Title        string
+	HeaderLines  []string
+	Columns      []string
+	Rows         [][]string
+	Footer       string
+	LogoPath     string
+	FontPath     string
+}
+
+// Render renders the document in the given format
+func (d *Document) Render(format Format) ([]byte, error) {
+	switch format {
+	case FormatPDF:
+		return renderPDF(d)
+	case FormatCSV:
+		return renderCSV(d)
+	case FormatXLSX:
+		return renderXLSX(d)
+	}
+	return nil, fmt.Errorf("unsupported export format: %s", format)
+}
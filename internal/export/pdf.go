@@ -0,0 +1,130 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/go-pdf/fpdf"
+)
+
+const (
+	pdfFontFamily = "main"
+	pdfMargin     = 10.0
+	pdfLogoHeight = 18.0
+	pdfRowHeight  = 6.0
+)
+
+// renderPDF renders the document on landscape A4 pages, repeating the table header on each page
+// Core PDF fonts have no Cyrillic glyphs, so a UTF-8 TTF font (Template.FontPath) is required
+func renderPDF(d *Document) ([]byte, error) {
+	font, err := os.ReadFile(d.FontPath)
+	if err != nil {
+		return nil, fmt.Errorf("PDF font not found (set EXPORT_FONT_PATH): %w", err)
+	}
+
+	pdf := fpdf.New("L", "mm", "A4", "")
+	pdf.SetMargins(pdfMargin, pdfMargin, pdfMargin)
+	pdf.SetAutoPageBreak(true, pdfMargin+pdfRowHeight)
+	pdf.AddUTF8FontFromBytes(pdfFontFamily, "", font)
+	pdf.AliasNbPages("")
+
+	pageWidth, _ := pdf.GetPageSize()
+	tableWidth := pageWidth - 2*pdfMargin
+	widths := pdfColumnWidths(d, tableWidth)
+
+	tableHeader := func() {
+		pdf.SetFont(pdfFontFamily, "", 9)
+		pdf.SetFillColor(221, 235, 247)
+		for i, column := range d.Columns {
+			pdf.CellFormat(widths[i], pdfRowHeight, column, "1", 0, "C", true, 0, "")
+		}
+		pdf.Ln(-1)
+	}
+
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-pdfMargin - 2)
+		pdf.SetFont(pdfFontFamily, "", 8)
+		pdf.CellFormat(tableWidth/2, 4, d.Footer, "", 0, "L", false, 0, "")
+		pdf.CellFormat(tableWidth/2, 4, fmt.Sprintf("%d / {nb}", pdf.PageNo()), "", 0, "R", false, 0, "")
+	})
+
+	firstPage := true
+	pdf.SetHeaderFunc(func() {
+		if !firstPage {
+			tableHeader()
+		}
+	})
+
+	pdf.AddPage()
+
+	// Branding block
+	textX := pdfMargin
+	if d.LogoPath != "" {
+		if _, err := os.Stat(d.LogoPath); err != nil {
+			return nil, fmt.Errorf("export logo not found: %w", err)
+		}
+		pdf.ImageOptions(d.LogoPath, pdfMargin, pdfMargin, 0, pdfLogoHeight, false, fpdf.ImageOptions{ReadDpi: true}, 0, "")
+		textX += pdfLogoHeight + 4
+	}
+	pdf.SetX(textX)
+	if d.Organization != "" {
+		pdf.SetFont(pdfFontFamily, "", 12)
+		pdf.CellFormat(0, 6, d.Organization, "", 1, "L", false, 0, "")
+	}
+	pdf.SetFont(pdfFontFamily, "", 10)
+	for _, line := range d.HeaderLines {
+		pdf.SetX(textX)
+		pdf.CellFormat(0, 5, line, "", 1, "L", false, 0, "")
+	}
+	if d.LogoPath != "" && pdf.GetY() < pdfMargin+pdfLogoHeight {
+		pdf.SetY(pdfMargin + pdfLogoHeight)
+	}
+
+	if d.Title != "" {
+		pdf.Ln(3)
+		pdf.SetFont(pdfFontFamily, "", 14)
+		pdf.CellFormat(0, 8, d.Title, "", 1, "C", false, 0, "")
+		pdf.Ln(2)
+	}
+
+	tableHeader()
+	firstPage = false
+
+	pdf.SetFont(pdfFontFamily, "", 9)
+	for _, values := range d.Rows {
+		for i := range d.Columns {
+			value := ""
+			if i < len(values) {
+				value = values[i]
+			}
+			pdf.CellFormat(widths[i], pdfRowHeight, value, "1", 0, "L", false, 0, "")
+		}
+		pdf.Ln(-1)
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("failed to render PDF: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// pdfColumnWidths distributes the table width proportionally to the content width of each column
+func pdfColumnWidths(d *Document, tableWidth float64) []float64 {
+	chars := columnWidths(d)
+	total := 0
+	for _, c := range chars {
+		total += c + 2 // Padding so narrow columns stay readable
+	}
+
+	widths := make([]float64, len(chars))
+	for i, c := range chars {
+		if total == 0 {
+			widths[i] = tableWidth / float64(len(chars))
+			continue
+		}
+		widths[i] = tableWidth * float64(c+2) / float64(total)
+	}
+	return widths
+}
@@ -0,0 +1,146 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/template"
+	"time"
+
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+)
+
+// defaultFontPath is a TTF with Cyrillic glyphs present on most Linux images (fonts-dejavu-core)
+const defaultFontPath = "/usr/share/fonts/truetype/dejavu/DejaVuSans.ttf"
+
+// Template holds the federation branding applied to exported documents
+// Text fields are Go text/template strings rendered with TemplateData
+type Template struct {
+	Organization   string   `json:"organization"`
+	LogoPath       string   `json:"logoPath"`    // PNG or JPEG printed in the PDF header
+	HeaderLines    []string `json:"headerLines"` // Printed under the organization name
+	StartListTitle string   `json:"startListTitle"`
+	ProtocolTitle  string   `json:"protocolTitle"`
+	Footer         string   `json:"footer"`
+	FontPath       string   `json:"fontPath"` // UTF-8 TTF font used for PDF
+}
+
+// TemplateData is passed to every template string
+type TemplateData struct {
+	Organization string
+	Competition  *entity.Competition
+	GeneratedAt  time.Time
+}
+
+// DefaultTemplate returns the built-in template
+func DefaultTemplate() *Template {
+	return &Template{
+		Organization: "Национальная федерация фидерной ловли",
+		HeaderLines: []string{
+			"{{.Competition.Title}}",
+			"{{.Competition.Location}}, {{date .Competition.StartDate}} — {{date .Competition.EndDate}}",
+		},
+		StartListTitle: "Стартовый протокол",
+		ProtocolTitle:  "Итоговый протокол",
+		Footer:         "Сформировано {{datetime .GeneratedAt}}",
+		FontPath:       defaultFontPath,
+	}
+}
+
+// LoadTemplate returns the default template overridden by the JSON file at EXPORT_TEMPLATE_PATH
+// Fields missing from the file keep their default values; EXPORT_FONT_PATH overrides the font
+func LoadTemplate() (*Template, error) {
+	tmpl := DefaultTemplate()
+
+	if path := os.Getenv("EXPORT_TEMPLATE_PATH"); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read export template: %w", err)
+		}
+		if err := json.Unmarshal(data, tmpl); err != nil {
+			return nil, fmt.Errorf("failed to parse export template: %w", err)
+		}
+	}
+
+	if fontPath := os.Getenv("EXPORT_FONT_PATH"); fontPath != "" {
+		tmpl.FontPath = fontPath
+	}
+
+	return tmpl, nil
+}
+
+var templateFuncs = template.FuncMap{
+	"date": func(v interface{}) string {
+		return formatTime(v, "02.01.2006")
+	},
+	"datetime": func(v interface{}) string {
+		return formatTime(v, "02.01.2006 15:04")
+	},
+}
+
+// formatTime formats time.Time and *time.Time values (nil renders as empty string)
+func formatTime(v interface{}, layout string) string {
+	switch t := v.(type) {
+	case time.Time:
+		return t.Format(layout)
+	case *time.Time:
+		if t != nil {
+			return t.Format(layout)
+		}
+	}
+	return ""
+}
+
+// render executes a single template string
+func (t *Template) render(text string, data TemplateData) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+	parsed, err := template.New("export").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("invalid export template %q: %w", text, err)
+	}
+	var buf bytes.Buffer
+	if err := parsed.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render export template: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// newDocument creates a document with the branding of the template applied
+func (t *Template) newDocument(titleTemplate string, competition *entity.Competition) (*Document, error) {
+	data := TemplateData{
+		Organization: t.Organization,
+		Competition:  competition,
+		GeneratedAt:  time.Now(),
+	}
+
+	title, err := t.render(titleTemplate, data)
+	if err != nil {
+		return nil, err
+	}
+
+	headerLines := make([]string, 0, len(t.HeaderLines))
+	for _, line := range t.HeaderLines {
+		rendered, err := t.render(line, data)
+		if err != nil {
+			return nil, err
+		}
+		headerLines = append(headerLines, rendered)
+	}
+
+	footer, err := t.render(t.Footer, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Document{
+		Organization: t.Organization,
+		Title:        title,
+		HeaderLines:  headerLines,
+		Footer:       footer,
+		LogoPath:     t.LogoPath,
+		FontPath:     t.FontPath,
+	}, nil
+}
@@ -0,0 +1,115 @@
+package export
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/xuri/excelize/v2"
+)
+
+const xlsxSheet = "Sheet1"
+
+// renderXLSX renders the document as a single worksheet: branding lines, table, footer
+func renderXLSX(d *Document) ([]byte, error) {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	boldStyle, err := f.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create XLSX style: %w", err)
+	}
+	headerStyle, err := f.NewStyle(&excelize.Style{
+		Font:   &excelize.Font{Bold: true},
+		Fill:   excelize.Fill{Type: "pattern", Pattern: 1, Color: []string{"#DDEBF7"}},
+		Border: []excelize.Border{{Type: "bottom", Color: "#000000", Style: 1}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create XLSX style: %w", err)
+	}
+
+	row := 1
+	setRow := func(values []string, style int) error {
+		for i, value := range values {
+			cell, err := excelize.CoordinatesToCellName(i+1, row)
+			if err != nil {
+				return err
+			}
+			if err := f.SetCellStr(xlsxSheet, cell, value); err != nil {
+				return err
+			}
+			if style != 0 {
+				if err := f.SetCellStyle(xlsxSheet, cell, cell, style); err != nil {
+					return err
+				}
+			}
+		}
+		row++
+		return nil
+	}
+
+	var preamble []string
+	if d.Organization != "" {
+		preamble = append(preamble, d.Organization)
+	}
+	preamble = append(preamble, d.HeaderLines...)
+	if d.Title != "" {
+		preamble = append(preamble, d.Title)
+	}
+	for _, line := range preamble {
+		if err := setRow([]string{line}, boldStyle); err != nil {
+			return nil, fmt.Errorf("failed to write XLSX: %w", err)
+		}
+	}
+	if len(preamble) > 0 {
+		row++
+	}
+
+	if err := setRow(d.Columns, headerStyle); err != nil {
+		return nil, fmt.Errorf("failed to write XLSX: %w", err)
+	}
+	for _, values := range d.Rows {
+		if err := setRow(values, 0); err != nil {
+			return nil, fmt.Errorf("failed to write XLSX: %w", err)
+		}
+	}
+
+	if d.Footer != "" {
+		row++
+		if err := setRow([]string{d.Footer}, 0); err != nil {
+			return nil, fmt.Errorf("failed to write XLSX: %w", err)
+		}
+	}
+
+	// Fit column widths to the longest value
+	for i, width := range columnWidths(d) {
+		col, err := excelize.ColumnNumberToName(i + 1)
+		if err != nil {
+			return nil, fmt.Errorf("failed to write XLSX: %w", err)
+		}
+		if err := f.SetColWidth(xlsxSheet, col, col, float64(width)+2); err != nil {
+			return nil, fmt.Errorf("failed to write XLSX: %w", err)
+		}
+	}
+
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		return nil, fmt.Errorf("failed to write XLSX: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// columnWidths returns the widest value of each table column in characters
+func columnWidths(d *Document) []int {
+	widths := make([]int, len(d.Columns))
+	for i, column := range d.Columns {
+		widths[i] = utf8.RuneCountInString(column)
+	}
+	for _, values := range d.Rows {
+		for i, value := range values {
+			if i < len(widths) && utf8.RuneCountInString(value) > widths[i] {
+				widths[i] = utf8.RuneCountInString(value)
+			}
+		}
+	}
+	return widths
+}
@@ -0,0 +1,213 @@
+package feed
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+const (
+	atomNamespace = "http://www.w3.org/2005/Atom"
+	dcNamespace   = "http://purl.org/dc/elements/1.1/"
+	generator     = "CNPF Feeder"
+)
+
+// Feed is a syndication feed independent of its format
+type Feed struct {
+	ID      string // Permanent URI of the feed
+	Title   string
+	Link    string // Page the feed is about
+	Self    string // URL the feed is served at
+	Updated time.Time
+	Items   []Item
+}
+
+// Item is a feed entry
+type Item struct {
+	ID         string
+	Title      string
+	Link       string
+	Author     string
+	Content    string // HTML
+	Categories []string
+	Published  time.Time
+	Updated    time.Time
+	Enclosures []Enclosure
+}
+
+// Enclosure is a file attached to an item (report photos)
+type Enclosure struct {
+	URL    string
+	Type   string
+	Length int
+}
+
+// Atom renders the feed as Atom 1.0 (RFC 4287); all enclosures are listed
+func Atom(f Feed) []byte {
+	doc := atomFeed{
+		XMLNS:     atomNamespace,
+		ID:        f.ID,
+		Title:     f.Title,
+		Updated:   formatAtomTime(f.Updated),
+		Generator: generator,
+		Links: []atomLink{
+			{Rel: "alternate", Type: "text/html", Href: f.Link},
+			{Rel: "self", Type: "application/atom+xml", Href: f.Self},
+		},
+	}
+	for _, item := range f.Items {
+		entry := atomEntry{
+			ID:        item.ID,
+			Title:     item.Title,
+			Published: formatAtomTime(item.Published),
+			Updated:   formatAtomTime(item.Updated),
+			Content:   atomContent{Type: "html", Body: item.Content},
+			Links:     []atomLink{{Rel: "alternate", Type: "text/html", Href: item.Link}},
+		}
+		if item.Author != "" {
+			entry.Author = &atomAuthor{Name: item.Author}
+		}
+		for _, category := range item.Categories {
+			entry.Categories = append(entry.Categories, atomCategory{Term: category})
+		}
+		for _, enclosure := range item.Enclosures {
+			entry.Links = append(entry.Links, atomLink{
+				Rel:    "enclosure",
+				Type:   enclosure.Type,
+				Href:   enclosure.URL,
+				Length: enclosure.Length,
+			})
+		}
+		doc.Entries = append(doc.Entries, entry)
+	}
+	return marshal(doc)
+}
+
+// RSS renders the feed as RSS 2.0; RSS allows one enclosure per item, so only the first one is listed
+func RSS(f Feed) []byte {
+	channel := rssChannel{
+		Title:         f.Title,
+		Link:          f.Link,
+		Description:   f.Title,
+		LastBuildDate: f.Updated.UTC().Format(time.RFC1123Z),
+		Generator:     generator,
+		AtomLink:      rssAtomLink{Href: f.Self, Rel: "self", Type: "application/rss+xml"},
+	}
+	for _, item := range f.Items {
+		rssItem := rssItem{
+			Title:       item.Title,
+			Link:        item.Link,
+			GUID:        rssGUID{IsPermaLink: "false", Value: item.ID},
+			PubDate:     item.Published.UTC().Format(time.RFC1123Z),
+			Author:      item.Author,
+			Categories:  item.Categories,
+			Description: item.Content,
+		}
+		if len(item.Enclosures) > 0 {
+			enclosure := item.Enclosures[0]
+			rssItem.Enclosure = &rssEnclosure{URL: enclosure.URL, Length: enclosure.Length, Type: enclosure.Type}
+		}
+		channel.Items = append(channel.Items, rssItem)
+	}
+	return marshal(rssDocument{Version: "2.0", XMLNSAtom: atomNamespace, XMLNSDC: dcNamespace, Channel: channel})
+}
+
+func marshal(v any) []byte {
+	data, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		// The documents only contain strings and numbers, which always marshal
+		panic(err)
+	}
+	return append([]byte(xml.Header), data...)
+}
+
+func formatAtomTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+type atomFeed struct {
+	XMLName   xml.Name    `xml:"feed"`
+	XMLNS     string      `xml:"xmlns,attr"`
+	ID        string      `xml:"id"`
+	Title     string      `xml:"title"`
+	Updated   string      `xml:"updated"`
+	Generator string      `xml:"generator"`
+	Links     []atomLink  `xml:"link"`
+	Entries   []atomEntry `xml:"entry"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Author     *atomAuthor    `xml:"author,omitempty"`
+	Categories []atomCategory `xml:"category"`
+	Content    atomContent    `xml:"content"`
+	Links      []atomLink     `xml:"link"`
+}
+
+type atomLink struct {
+	Rel    string `xml:"rel,attr"`
+	Type   string `xml:"type,attr,omitempty"`
+	Href   string `xml:"href,attr"`
+	Length int    `xml:"length,attr,omitempty"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomContent struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",chardata"`
+}
+
+type rssDocument struct {
+	XMLName   xml.Name   `xml:"rss"`
+	Version   string     `xml:"version,attr"`
+	XMLNSAtom string     `xml:"xmlns:atom,attr"`
+	XMLNSDC   string     `xml:"xmlns:dc,attr"`
+	Channel   rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string      `xml:"title"`
+	Link          string      `xml:"link"`
+	Description   string      `xml:"description"`
+	LastBuildDate string      `xml:"lastBuildDate"`
+	Generator     string      `xml:"generator"`
+	AtomLink      rssAtomLink `xml:"atom:link"`
+	Items         []rssItem   `xml:"item"`
+}
+
+type rssAtomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type rssItem struct {
+	Title       string        `xml:"title"`
+	Link        string        `xml:"link"`
+	GUID        rssGUID       `xml:"guid"`
+	PubDate     string        `xml:"pubDate"`
+	Author      string        `xml:"dc:creator,omitempty"`
+	Categories  []string      `xml:"category"`
+	Description string        `xml:"description"`
+	Enclosure   *rssEnclosure `xml:"enclosure,omitempty"`
+}
+
+type rssGUID struct {
+	IsPermaLink string `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type rssEnclosure struct {
+	URL    string `xml:"url,attr"`
+	Length int    `xml:"length,attr"`
+	Type   string `xml:"type,attr"`
+}
@@ -0,0 +1,48 @@
+package feed
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+const (
+	defaultSiteURL = "http://localhost:3000"
+	defaultAPIURL  = "http://localhost:4000"
+)
+
+// Site builds the absolute URLs feeds and sitemaps link to
+type Site struct {
+	URL    string // Public website with report and competition pages
+	APIURL string // Backend serving photos and feeds
+}
+
+// DefaultSite returns the site with URLs from SITE_URL and API_URL
+func DefaultSite() Site {
+	return Site{
+		URL:    baseURL(os.Getenv("SITE_URL"), defaultSiteURL),
+		APIURL: baseURL(os.Getenv("API_URL"), defaultAPIURL),
+	}
+}
+
+func baseURL(value, defaultValue string) string {
+	if value == "" {
+		value = defaultValue
+	}
+	return strings.TrimRight(value, "/")
+}
+
+// ReportURL returns the page of a report
+func (s Site) ReportURL(id string) string {
+	return fmt.Sprintf("%s/reports/%s", s.URL, id)
+}
+
+// CompetitionURL returns the page of a competition
+func (s Site) CompetitionURL(id string) string {
+	return fmt.Sprintf("%s/competitions/%s", s.URL, id)
+}
+
+// API returns the absolute URL of an API path such as /api/reports/<id>/photos/0
+func (s Site) API(path string) string {
+	return s.APIURL + path
+}
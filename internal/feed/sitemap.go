@@ -0,0 +1,41 @@
+package feed
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+const sitemapNamespace = "http://www.sitemaps.org/schemas/sitemap/0.9"
+
+// MaxSitemapURLs is the number of URLs a single sitemap may contain
+const MaxSitemapURLs = 50000
+
+// SitemapURL is a page listed in the sitemap
+type SitemapURL struct {
+	Loc     string
+	LastMod time.Time // Zero when unknown
+}
+
+// Sitemap renders pages as a sitemap.xml (sitemaps.org protocol 0.9)
+func Sitemap(urls []SitemapURL) []byte {
+	doc := sitemapURLSet{XMLNS: sitemapNamespace}
+	for _, u := range urls {
+		entry := sitemapEntry{Loc: u.Loc}
+		if !u.LastMod.IsZero() {
+			entry.LastMod = u.LastMod.UTC().Format(time.RFC3339)
+		}
+		doc.URLs = append(doc.URLs, entry)
+	}
+	return marshal(doc)
+}
+
+type sitemapURLSet struct {
+	XMLName xml.Name       `xml:"urlset"`
+	XMLNS   string         `xml:"xmlns,attr"`
+	URLs    []sitemapEntry `xml:"url"`
+}
+
+type sitemapEntry struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
@@ -256,7 +256,11 @@ func (c *Client) GenerateChatResponse(userQuery string, results []SearchResult)
 			if len(textPreview) > 200 {
 				textPreview = textPreview[:200] + "..."
 			}
-			resultsText += fmt.Sprintf("%d. 📄 Отчет: \"%s\"\n   Текст: %s\n\n", i+1, r.Title, textPreview)
+			competitionInfo := ""
+			if r.Competition != "" {
+				competitionInfo = fmt.Sprintf("\n   Соревнование: %s", r.Competition)
+			}
+			resultsText += fmt.Sprintf("%d. 📄 Отчет: \"%s\"%s\n   Текст: %s\n\n", i+1, r.Title, competitionInfo, textPreview)
 		} else if r.Type == "competition" {
 			locationInfo := ""
 			if r.Location != "" {
@@ -288,4 +292,5 @@ type SearchResult struct {
 	Location   string
 	HasPhotos  bool
 	PhotosCount int
+	Competition string // Title of the competition a report is about
 }
@@ -0,0 +1,114 @@
+package importer
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// columnAliases maps a canonical column name to accepted header names (normalized)
+type columnAliases map[string][]string
+
+// Mapping links canonical column names to the header names found in a file
+type Mapping map[string]string
+
+// resolve matches the table header against the aliases
+// Unknown columns are reported so that misspelled headers do not silently drop data
+func (aliases columnAliases) resolve(header []string, required ...string) (Mapping, error) {
+	lookup := make(map[string]string)
+	for canonical, names := range aliases {
+		lookup[normalizeColumn(canonical)] = canonical
+		for _, name := range names {
+			lookup[normalizeColumn(name)] = canonical
+		}
+	}
+
+	mapping := make(Mapping)
+	var unknown []string
+	for _, name := range header {
+		if name == "" {
+			continue
+		}
+		canonical, ok := lookup[name]
+		if !ok {
+			unknown = append(unknown, name)
+			continue
+		}
+		mapping[canonical] = name
+	}
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("Неизвестные столбцы: %s", strings.Join(unknown, ", "))
+	}
+
+	for _, canonical := range required {
+		if _, ok := mapping[canonical]; !ok {
+			return nil, fmt.Errorf("Отсутствует обязательный столбец: %s", canonical)
+		}
+	}
+	return mapping, nil
+}
+
+// get returns the value of a canonical column in the row ("" if the column is absent)
+func (m Mapping) get(row Row, canonical string) string {
+	name, ok := m[canonical]
+	if !ok {
+		return ""
+	}
+	return row.Values[name]
+}
+
+// optional returns nil for empty values
+func (m Mapping) optional(row Row, canonical string) *string {
+	value := m.get(row, canonical)
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// dateLayouts are accepted date formats besides RFC 3339 and Excel serial numbers
+var dateLayouts = []string{"02.01.2006", "2006-01-02", "02/01/2006"}
+
+// parseDate parses a date cell and returns it as RFC 3339 (midnight UTC), as the GraphQL inputs expect
+func parseDate(value string) (string, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.Format(time.RFC3339), nil
+	}
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.Format(time.RFC3339), nil
+		}
+	}
+	// XLSX raw values keep dates as serial numbers (days since 1899-12-30)
+	if serial, err := strconv.ParseFloat(value, 64); err == nil && serial > 0 {
+		t, err := excelize.ExcelDateToTime(math.Floor(serial), false)
+		if err == nil {
+			return t.Format(time.RFC3339), nil
+		}
+	}
+	return "", fmt.Errorf("Неверная дата: %s", value)
+}
+
+// parseBool accepts да/нет, da/nu, yes/no, true/false, 1/0, +/-, x
+func parseBool(value string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "да", "da", "yes", "true", "1", "+", "x":
+		return true, nil
+	case "", "нет", "nu", "no", "false", "0", "-":
+		return false, nil
+	}
+	return false, fmt.Errorf("Неверное значение (ожидается да/нет): %s", value)
+}
+
+// splitName splits "Фамилия Имя" into last and first name
+func splitName(value string) (lastName, firstName string) {
+	parts := strings.Fields(value)
+	if len(parts) == 0 {
+		return "", ""
+	}
+	return parts[0], strings.Join(parts[1:], " ")
+}
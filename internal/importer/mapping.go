@@ -0,0 +1,248 @@
+package importer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cnpf/feeder-backend/graph/model"
+)
+
+var competitionColumns = columnAliases{
+	"title":            {"название"},
+	"startDate":        {"дата начала", "начало"},
+	"endDate":          {"дата окончания", "окончание"},
+	"location":         {"место"},
+	"venueId":          {"id места проведения"},
+	"tours":            {"туры"},
+	"openingDate":      {"дата открытия регистрации", "открытие регистрации"},
+	"openingTime":      {"время открытия регистрации"},
+	"individualFormat": {"личный зачет", "личный"},
+	"teamFormat":       {"командный зачет", "командный"},
+	"fee":              {"взнос"},
+	"teamLimit":        {"лимит команд"},
+	"regulations":      {"регламент"},
+}
+
+var registrationColumns = columnAliases{
+	"type":         {"тип"},
+	"teamName":     {"команда", "название команды"},
+	"participant1": {"участник 1", "участник"},
+	"participant2": {"участник 2"},
+	"participant3": {"участник 3"},
+	"participant4": {"участник 4"},
+	"participant5": {"участник 5"},
+	"participant6": {"участник 6"},
+	"reserve1":     {"запасной 1", "запасной"},
+	"reserve2":     {"запасной 2"},
+	"captain":      {"капитан"},
+	"coach":        {"тренер", "тренер 1"},
+	"coach2":       {"тренер 2"},
+}
+
+// maxParticipantColumns, maxReserveColumns and maxCoachColumns are the numbers of numbered columns recognized
+const (
+	maxParticipantColumns = 6
+	maxReserveColumns     = 2
+	maxCoachColumns       = 2
+)
+
+// CompetitionRow is a file row mapped to CompetitionInput; Err is set when mapping failed
+type CompetitionRow struct {
+	Number int
+	Input  *model.CompetitionInput
+	Err    error
+}
+
+// RegistrationRow is a file row mapped to CreateRegistrationInput; Err is set when mapping failed
+type RegistrationRow struct {
+	Number int
+	Input  *model.CreateRegistrationInput
+	Err    error
+}
+
+// Competitions maps table rows to competition inputs
+// Tours are listed in one cell separated by ";" or new lines: "10.05.2025 07:00; 11.05.2025 07:00"
+func Competitions(table *Table) ([]CompetitionRow, error) {
+	mapping, err := competitionColumns.resolve(table.Header, "title", "startDate", "endDate")
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]CompetitionRow, 0, len(table.Rows))
+	for _, row := range table.Rows {
+		input, err := competitionInput(mapping, row)
+		rows = append(rows, CompetitionRow{Number: row.Number, Input: input, Err: err})
+	}
+	return rows, nil
+}
+
+func competitionInput(m Mapping, row Row) (*model.CompetitionInput, error) {
+	startDate, err := parseDate(m.get(row, "startDate"))
+	if err != nil {
+		return nil, fmt.Errorf("Дата начала: %w", err)
+	}
+	endDate, err := parseDate(m.get(row, "endDate"))
+	if err != nil {
+		return nil, fmt.Errorf("Дата окончания: %w", err)
+	}
+
+	var openingDate *string
+	if value := m.get(row, "openingDate"); value != "" {
+		d, err := parseDate(value)
+		if err != nil {
+			return nil, fmt.Errorf("Дата открытия регистрации: %w", err)
+		}
+		openingDate = &d
+	}
+
+	tours, err := parseTours(m.get(row, "tours"))
+	if err != nil {
+		return nil, err
+	}
+
+	individualFormat, err := parseBool(m.get(row, "individualFormat"))
+	if err != nil {
+		return nil, fmt.Errorf("Личный зачет: %w", err)
+	}
+	teamFormat, err := parseBool(m.get(row, "teamFormat"))
+	if err != nil {
+		return nil, fmt.Errorf("Командный зачет: %w", err)
+	}
+
+	return &model.CompetitionInput{
+		Title:            m.get(row, "title"),
+		StartDate:        startDate,
+		EndDate:          endDate,
+		Location:         m.get(row, "location"),
+		VenueID:          m.optional(row, "venueId"),
+		Tours:            tours,
+		OpeningDate:      openingDate,
+		OpeningTime:      m.optional(row, "openingTime"),
+		IndividualFormat: individualFormat,
+		TeamFormat:       teamFormat,
+		Fee:              m.optional(row, "fee"),
+		TeamLimit:        m.optional(row, "teamLimit"),
+		Regulations:      m.optional(row, "regulations"),
+	}, nil
+}
+
+// parseTours parses "date [time]" entries separated by ";" or new lines
+func parseTours(value string) ([]*model.TourInput, error) {
+	entries := strings.FieldsFunc(value, func(r rune) bool {
+		return r == ';' || r == '\n'
+	})
+
+	tours := make([]*model.TourInput, 0, len(entries))
+	for i, entry := range entries {
+		fields := strings.Fields(entry)
+		if len(fields) == 0 {
+			continue
+		}
+		date, err := parseDate(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("Тур %d: %w", i+1, err)
+		}
+		tourTime := ""
+		if len(fields) > 1 {
+			tourTime = fields[1]
+		}
+		tours = append(tours, &model.TourInput{Date: date, Time: tourTime})
+	}
+	return tours, nil
+}
+
+// Registrations maps table rows to registration inputs for the given competition
+// Participants and coach are written as "Фамилия Имя"; type is individual/team (личный/командный)
+// The captain column repeats the name of one of the participants or reserves
+func Registrations(table *Table, competitionID string) ([]RegistrationRow, error) {
+	mapping, err := registrationColumns.resolve(table.Header, "participant1")
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]RegistrationRow, 0, len(table.Rows))
+	for _, row := range table.Rows {
+		input, err := registrationInput(mapping, row, competitionID)
+		rows = append(rows, RegistrationRow{Number: row.Number, Input: input, Err: err})
+	}
+	return rows, nil
+}
+
+func registrationInput(m Mapping, row Row, competitionID string) (*model.CreateRegistrationInput, error) {
+	var participants []*model.ParticipantInput
+	addParticipants := func(column string, count int, reserve bool) {
+		for i := 1; i <= count; i++ {
+			value := m.get(row, fmt.Sprintf("%s%d", column, i))
+			if value == "" {
+				continue
+			}
+			lastName, firstName := splitName(value)
+			p := &model.ParticipantInput{FirstName: firstName, LastName: lastName}
+			if reserve {
+				p.Reserve = &reserve
+			}
+			participants = append(participants, p)
+		}
+	}
+	addParticipants("participant", maxParticipantColumns, false)
+	addParticipants("reserve", maxReserveColumns, true)
+
+	if value := m.get(row, "captain"); value != "" {
+		lastName, firstName := splitName(value)
+		found := false
+		for _, p := range participants {
+			if strings.EqualFold(p.LastName, lastName) && strings.EqualFold(p.FirstName, firstName) {
+				captain := true
+				p.Captain = &captain
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("Капитан %s не найден среди участников", value)
+		}
+	}
+
+	var coaches []*model.CoachInput
+	for i := 1; i <= maxCoachColumns; i++ {
+		column := "coach"
+		if i > 1 {
+			column = fmt.Sprintf("coach%d", i)
+		}
+		if value := m.get(row, column); value != "" {
+			lastName, firstName := splitName(value)
+			coaches = append(coaches, &model.CoachInput{FirstName: firstName, LastName: lastName})
+		}
+	}
+
+	teamName := m.optional(row, "teamName")
+
+	registrationType, err := parseRegistrationType(m.get(row, "type"), teamName != nil || len(participants) > 1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.CreateRegistrationInput{
+		CompetitionID: competitionID,
+		Type:          registrationType,
+		TeamName:      teamName,
+		Participants:  participants,
+		Coaches:       coaches,
+	}, nil
+}
+
+// parseRegistrationType accepts individual/team in RU, RO or EN; an empty value is inferred
+func parseRegistrationType(value string, looksLikeTeam bool) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "individual", "личный", "личная", "individuală", "individuala":
+		return "individual", nil
+	case "team", "командный", "командная", "echipă", "echipa":
+		return "team", nil
+	case "":
+		if looksLikeTeam {
+			return "team", nil
+		}
+		return "individual", nil
+	}
+	return "", fmt.Errorf("Неверный тип регистрации: %s", value)
+}
@@ -0,0 +1,135 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// MaxRows limits the number of data rows in one import file
+const MaxRows = 1000
+
+// Table is a parsed import file: normalized header and data rows
+type Table struct {
+	Header []string
+	Rows   []Row
+}
+
+// Row is a data row; Number is the spreadsheet row number (header is row 1)
+type Row struct {
+	Number int
+	Values map[string]string // Normalized column name -> trimmed cell value
+}
+
+// Read parses a CSV or XLSX file, choosing the format by file extension
+// Empty rows are skipped; the first non-empty row is the header
+func Read(data []byte, fileName string) (*Table, error) {
+	var records [][]string
+	var err error
+
+	switch strings.ToLower(path.Ext(fileName)) {
+	case ".csv":
+		records, err = readCSV(data)
+	case ".xlsx":
+		records, err = readXLSX(data)
+	default:
+		return nil, fmt.Errorf("unsupported file type: %s", fileName)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	table := &Table{}
+	for i, record := range records {
+		if isEmptyRecord(record) {
+			continue
+		}
+		if table.Header == nil {
+			table.Header = make([]string, len(record))
+			for j, name := range record {
+				table.Header[j] = normalizeColumn(name)
+			}
+			continue
+		}
+
+		if len(table.Rows) == MaxRows {
+			return nil, fmt.Errorf("too many rows (max %d)", MaxRows)
+		}
+		row := Row{Number: i + 1, Values: make(map[string]string, len(table.Header))}
+		for j, name := range table.Header {
+			if j < len(record) && name != "" {
+				row.Values[name] = strings.TrimSpace(record[j])
+			}
+		}
+		table.Rows = append(table.Rows, row)
+	}
+
+	if table.Header == nil {
+		return nil, fmt.Errorf("file is empty")
+	}
+	return table, nil
+}
+
+func readCSV(data []byte) ([][]string, error) {
+	data = bytes.TrimPrefix(data, []byte{0xEF, 0xBB, 0xBF})
+
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.Comma = detectDelimiter(data)
+
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV: %w", err)
+	}
+	return records, nil
+}
+
+// detectDelimiter picks ';' (spreadsheets in RU/RO locales) or ',' by the header line
+func detectDelimiter(data []byte) rune {
+	firstLine := data
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		firstLine = data[:i]
+	}
+	if bytes.Count(firstLine, []byte(";")) > bytes.Count(firstLine, []byte(",")) {
+		return ';'
+	}
+	return ','
+}
+
+// readXLSX reads the first worksheet with raw values (dates as serial numbers)
+func readXLSX(data []byte) ([][]string, error) {
+	f, err := excelize.OpenReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("invalid XLSX: %w", err)
+	}
+	defer f.Close()
+
+	sheets := f.GetSheetList()
+	if len(sheets) == 0 {
+		return nil, fmt.Errorf("XLSX has no sheets")
+	}
+
+	records, err := f.GetRows(sheets[0], excelize.Options{RawCellValue: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read XLSX: %w", err)
+	}
+	return records, nil
+}
+
+func isEmptyRecord(record []string) bool {
+	for _, value := range record {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeColumn lowercases a header and collapses whitespace
+func normalizeColumn(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(name)), " ")
+}
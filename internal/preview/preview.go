@@ -0,0 +1,95 @@
+package preview
+
+import (
+	"bytes"
+	"html/template"
+	"strings"
+	"time"
+)
+
+// SiteName is shown by messengers and social networks next to the preview
+const SiteName = "CNPF Feeder"
+
+// Page describes the social preview of a shared link
+type Page struct {
+	Type        string // Open Graph type: "article" or "website"
+	Title       string
+	Description string
+	URL         string // Canonical page of the SPA
+	Image       string // Absolute image URL; empty if there is none
+	ImageAlt    string
+	Published   *time.Time // Articles only
+	Modified    *time.Time
+}
+
+var pageTemplate = template.Must(template.New("preview").Funcs(template.FuncMap{
+	"iso": func(t time.Time) string { return t.UTC().Format(time.RFC3339) },
+}).Parse(`<!DOCTYPE html>
+<html lang="ru" prefix="og: https://ogp.me/ns#">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}} — {{.SiteName}}</title>
+<meta name="description" content="{{.Description}}">
+<link rel="canonical" href="{{.URL}}">
+<meta property="og:site_name" content="{{.SiteName}}">
+<meta property="og:locale" content="ru_RU">
+<meta property="og:type" content="{{.Type}}">
+<meta property="og:title" content="{{.Title}}">
+<meta property="og:description" content="{{.Description}}">
+<meta property="og:url" content="{{.URL}}">
+{{- if .Image}}
+<meta property="og:image" content="{{.Image}}">
+<meta property="og:image:alt" content="{{.ImageAlt}}">
+{{- end}}
+{{- if .Published}}
+<meta property="article:published_time" content="{{iso .Published}}">
+{{- end}}
+{{- if .Modified}}
+<meta property="article:modified_time" content="{{iso .Modified}}">
+{{- end}}
+<meta name="twitter:card" content="{{if .Image}}summary_large_image{{else}}summary{{end}}">
+<meta name="twitter:title" content="{{.Title}}">
+<meta name="twitter:description" content="{{.Description}}">
+{{- if .Image}}
+<meta name="twitter:image" content="{{.Image}}">
+{{- end}}
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<p>{{.Description}}</p>
+{{- if .Image}}
+<img src="{{.Image}}" alt="{{.ImageAlt}}">
+{{- end}}
+<p><a href="{{.URL}}">Открыть на сайте {{.SiteName}}</a></p>
+</body>
+</html>
+`))
+
+// Render renders the preview page with Open Graph and Twitter Card metadata
+// The page has no redirect of its own: crawlers would follow it and take the metadata of the SPA
+func Render(p Page) []byte {
+	var buf bytes.Buffer
+	_ = pageTemplate.Execute(&buf, struct {
+		Page
+		SiteName string
+	}{p, SiteName})
+	return buf.Bytes()
+}
+
+// crawlerAgents are User-Agent fragments of link preview and search engine bots
+var crawlerAgents = []string{
+	"facebookexternalhit", "facebot", "twitterbot", "viber", "telegrambot", "whatsapp",
+	"vkshare", "slackbot", "linkedinbot", "discordbot", "skypeuripreview", "pinterest",
+	"redditbot", "applebot", "googlebot", "bingbot", "yandex", "embedly",
+}
+
+// IsCrawler reports whether the User-Agent belongs to a bot reading link previews
+func IsCrawler(userAgent string) bool {
+	userAgent = strings.ToLower(userAgent)
+	for _, agent := range crawlerAgents {
+		if strings.Contains(userAgent, agent) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+)
+
+// CheckInRepository defines the interface for check-in data operations
+type CheckInRepository interface {
+	// Create records a check-in; returns the existing check-in (and false) if the registration
+	// was already checked in for the tour
+	Create(ctx context.Context, checkIn *entity.CheckIn) (*entity.CheckIn, bool, error)
+
+	// FindByCompetitionAndTour finds all check-ins of a competition tour
+	FindByCompetitionAndTour(ctx context.Context, competitionID string, tour int) ([]*entity.CheckIn, error)
+
+	// Delete removes the check-in of a registration for a tour
+	Delete(ctx context.Context, registrationID string, tour int) error
+
+	// CountByCompetitionID counts check-ins of a competition over all tours
+	CountByCompetitionID(ctx context.Context, competitionID string) (int64, error)
+
+	// DeleteByCompetitionID deletes all check-ins of a competition
+	DeleteByCompetitionID(ctx context.Context, competitionID string) error
+
+	// CountByRegistrationIDs counts check-ins of the registrations
+	CountByRegistrationIDs(ctx context.Context, registrationIDs []string) (int64, error)
+
+	// DeleteByRegistrationID deletes all check-ins of a registration
+	DeleteByRegistrationID(ctx context.Context, registrationID string) error
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+)
+
+// CommentRepository defines the interface for report comment data operations
+type CommentRepository interface {
+	// Create creates a new comment
+	Create(ctx context.Context, comment *entity.Comment) (string, error)
+
+	// FindByID finds a comment by ID
+	FindByID(ctx context.Context, id string) (*entity.Comment, error)
+
+	// FindByReportID finds the comments of a report in the order they were written
+	FindByReportID(ctx context.Context, reportID string) ([]*entity.Comment, error)
+
+	// FindByStatus finds comments with the status, oldest first (the moderation queue)
+	FindByStatus(ctx context.Context, status entity.CommentStatus, limit int) ([]*entity.Comment, error)
+
+	// UpdateText updates the text of a comment and marks it as edited;
+	// with requeue an approved comment goes back to the moderation queue (hidden comments stay hidden)
+	UpdateText(ctx context.Context, id string, text string, requeue bool) error
+
+	// SetStatus sets the moderation status of a comment
+	SetStatus(ctx context.Context, id string, status entity.CommentStatus, moderatorID string) error
+
+	// MarkDeleted replaces a comment with replies by a placeholder without text
+	MarkDeleted(ctx context.Context, id string) error
+
+	// Delete deletes a comment
+	Delete(ctx context.Context, id string) error
+
+	// HasReplies checks if a comment has replies
+	HasReplies(ctx context.Context, id string) (bool, error)
+
+	// CountVisibleByReportIDs counts comments that are not hidden or deleted, by report ID
+	CountVisibleByReportIDs(ctx context.Context, reportIDs []string) (map[string]int64, error)
+
+	// DeleteByReportID deletes all comments of a report
+	DeleteByReportID(ctx context.Context, reportID string) error
+
+	// CountByAuthorID counts comments of an author
+	CountByAuthorID(ctx context.Context, authorID string) (int64, error)
+
+	// AnonymizeAuthor replaces the author of all comments of a deleted user with entity.DeletedUserID
+	AnonymizeAuthor(ctx context.Context, authorID string) error
+}
@@ -11,15 +11,36 @@ type CompetitionRepository interface {
 	// Create creates a new competition
 	Create(ctx context.Context, competition *entity.Competition) (string, error)
 	
-	// FindByID finds a competition by ID
+	// FindByID finds a competition by ID (competitions in the trash are not found)
 	FindByID(ctx context.Context, id string) (*entity.Competition, error)
 	
-	// FindAll finds all competitions
+	// FindAll finds all competitions except those in the trash
 	FindAll(ctx context.Context) ([]*entity.Competition, error)
 	
+	// FindDeleted finds competitions in the trash, most recently deleted first
+	FindDeleted(ctx context.Context) ([]*entity.Competition, error)
+	
+	// FindByJudgeID finds competitions (including those in the trash) where the user is on the judge panel
+	FindByJudgeID(ctx context.Context, userID string) ([]*entity.Competition, error)
+	
+	// FindByVenueID finds all competitions held at a venue
+	FindByVenueID(ctx context.Context, venueID string) ([]*entity.Competition, error)
+	
 	// Update updates a competition
 	Update(ctx context.Context, id string, competition *entity.Competition) error
 	
-	// Delete deletes a competition
+	// SetJudges replaces the judge panel of a competition
+	SetJudges(ctx context.Context, id string, judgeIDs []string) error
+	
+	// RemoveJudge removes the user from the judge panels of all competitions
+	RemoveJudge(ctx context.Context, userID string) error
+	
+	// SoftDelete moves a competition to the trash
+	SoftDelete(ctx context.Context, id string, deletedBy string) error
+	
+	// Restore takes a competition out of the trash
+	Restore(ctx context.Context, id string) error
+	
+	// Delete deletes a competition permanently
 	Delete(ctx context.Context, id string) error
 }
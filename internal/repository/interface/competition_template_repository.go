@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+)
+
+// CompetitionTemplateRepository defines the interface for competition template data operations
+type CompetitionTemplateRepository interface {
+	// Create creates a new template
+	Create(ctx context.Context, template *entity.CompetitionTemplate) (string, error)
+
+	// FindByID finds a template by ID
+	FindByID(ctx context.Context, id string) (*entity.CompetitionTemplate, error)
+
+	// FindAll finds all templates sorted by name
+	FindAll(ctx context.Context) ([]*entity.CompetitionTemplate, error)
+
+	// Delete deletes a template
+	Delete(ctx context.Context, id string) error
+}
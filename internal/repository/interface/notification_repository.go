@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+)
+
+// NotificationRepository defines the interface for notification data operations
+type NotificationRepository interface {
+	// CreateMany creates notifications (one per recipient)
+	CreateMany(ctx context.Context, notifications []*entity.Notification) error
+
+	// FindByUserID finds the newest notifications of a user
+	FindByUserID(ctx context.Context, userID string, unreadOnly bool, limit int) ([]*entity.Notification, error)
+
+	// CountUnread counts unread notifications of a user
+	CountUnread(ctx context.Context, userID string) (int64, error)
+
+	// MarkRead marks notifications of a user as read; empty ids marks all of them
+	MarkRead(ctx context.Context, userID string, ids []string) error
+
+	// CountByUserID counts all notifications of a user
+	CountByUserID(ctx context.Context, userID string) (int64, error)
+
+	// DeleteByUserID deletes all notifications of a user
+	DeleteByUserID(ctx context.Context, userID string) error
+}
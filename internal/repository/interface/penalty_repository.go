@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+)
+
+// PenaltyRepository defines the interface for penalty data operations
+// Every change appends an entry to the penalty history
+type PenaltyRepository interface {
+	// Create creates a new penalty with the given history entry
+	Create(ctx context.Context, penalty *entity.Penalty, change entity.PenaltyChange) (string, error)
+
+	// FindByID finds a penalty by ID, including deleted ones
+	FindByID(ctx context.Context, id string) (*entity.Penalty, error)
+
+	// FindByCompetitionID finds active (not deleted) penalties of a competition
+	FindByCompetitionID(ctx context.Context, competitionID string) ([]*entity.Penalty, error)
+
+	// Update updates tour, type and reason of an active penalty and appends the history entry
+	Update(ctx context.Context, penalty *entity.Penalty, change entity.PenaltyChange) error
+
+	// Delete marks an active penalty as deleted and appends the history entry
+	Delete(ctx context.Context, id string, change entity.PenaltyChange) error
+
+	// DeleteByCompetitionID permanently deletes all penalties of a competition, including the history
+	DeleteByCompetitionID(ctx context.Context, competitionID string) error
+
+	// CountByRegistrationIDs counts penalties of the registrations, including deleted ones
+	CountByRegistrationIDs(ctx context.Context, registrationIDs []string) (int64, error)
+
+	// DeleteByRegistrationID permanently deletes all penalties of a registration, including the history
+	DeleteByRegistrationID(ctx context.Context, registrationID string) error
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+)
+
+// ProtestRepository defines the interface for protest data operations
+type ProtestRepository interface {
+	// Create creates a new protest
+	Create(ctx context.Context, protest *entity.Protest) (string, error)
+
+	// FindByID finds a protest by ID
+	FindByID(ctx context.Context, id string) (*entity.Protest, error)
+
+	// FindByCompetitionID finds protests of a competition, optionally filtered by status
+	FindByCompetitionID(ctx context.Context, competitionID string, status *entity.ProtestStatus) ([]*entity.Protest, error)
+
+	// UpdateStatus moves an open protest to a final status with the decision
+	UpdateStatus(ctx context.Context, protest *entity.Protest) error
+
+	// DeleteByCompetitionID deletes all protests of a competition
+	DeleteByCompetitionID(ctx context.Context, competitionID string) error
+
+	// CountByRegistrationIDs counts protests filed by or against the registrations
+	CountByRegistrationIDs(ctx context.Context, registrationIDs []string) (int64, error)
+
+	// DeleteByRegistrationID deletes all protests filed by or against a registration
+	DeleteByRegistrationID(ctx context.Context, registrationID string) error
+}
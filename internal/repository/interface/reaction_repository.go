@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+)
+
+// ReactionRepository defines the interface for report reaction data operations
+// A user has at most one reaction of each type on a report
+type ReactionRepository interface {
+	// Toggle adds the reaction of the user, or removes it if it exists; returns true if the reaction was added
+	Toggle(ctx context.Context, reportID, userID string, reactionType entity.ReactionType) (bool, error)
+
+	// CountByReportIDs counts reactions by report ID and type
+	CountByReportIDs(ctx context.Context, reportIDs []string) (map[string]map[entity.ReactionType]int64, error)
+
+	// FindUserTypesByReportIDs finds the reaction types the user has left, by report ID
+	FindUserTypesByReportIDs(ctx context.Context, reportIDs []string, userID string) (map[string][]entity.ReactionType, error)
+
+	// CountSince counts reactions left since the given time, by report ID
+	CountSince(ctx context.Context, since time.Time) (map[string]int64, error)
+
+	// DeleteByReportID deletes all reactions of a report
+	DeleteByReportID(ctx context.Context, reportID string) error
+
+	// CountByUserID counts reactions of a user
+	CountByUserID(ctx context.Context, userID string) (int64, error)
+
+	// DeleteByUserID deletes all reactions of a user
+	DeleteByUserID(ctx context.Context, userID string) error
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/cnpf/feeder-backend/internal/domain/entity"
 )
@@ -11,7 +12,7 @@ type RegistrationRepository interface {
 	// Create creates a new registration
 	Create(ctx context.Context, registration *entity.Registration) (string, error)
 	
-	// FindByID finds a registration by ID
+	// FindByID finds a registration by ID (registrations in the trash are not found)
 	FindByID(ctx context.Context, id string) (*entity.Registration, error)
 	
 	// FindByCompetitionID finds all registrations for a competition
@@ -23,9 +24,30 @@ type RegistrationRepository interface {
 	// FindByCompetitionAndUser finds registration for specific competition and user
 	FindByCompetitionAndUser(ctx context.Context, competitionID, userID string) (*entity.Registration, error)
 	
+	// FindDeleted finds registrations in the trash, most recently deleted first
+	FindDeleted(ctx context.Context) ([]*entity.Registration, error)
+	
 	// Update updates a registration
 	Update(ctx context.Context, id string, registration *entity.Registration) error
 	
-	// Delete deletes a registration
+	// AssignSector sets (or clears with nil) the sector and peg of a registration
+	AssignSector(ctx context.Context, id string, sector *string, peg *int) error
+	
+	// SetPaid sets (or clears with nil) the fee payment time of a registration
+	SetPaid(ctx context.Context, id string, paidAt *time.Time) error
+	
+	// SoftDelete moves a registration to the trash
+	SoftDelete(ctx context.Context, id string, deletedBy string) error
+	
+	// Restore takes a registration out of the trash
+	Restore(ctx context.Context, id string) error
+	
+	// Delete deletes a registration permanently
 	Delete(ctx context.Context, id string) error
+	
+	// DeleteByCompetitionID deletes all registrations of a competition, including those in the trash
+	DeleteByCompetitionID(ctx context.Context, competitionID string) error
+	
+	// Anonymize detaches registrations from their deleted user (results and standings are kept)
+	Anonymize(ctx context.Context, ids []string) error
 }
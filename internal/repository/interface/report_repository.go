@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/cnpf/feeder-backend/internal/domain/entity"
 )
@@ -11,18 +12,73 @@ type ReportRepository interface {
 	// Create creates a new report
 	Create(ctx context.Context, report *entity.Report) (string, error)
 	
-	// FindByID finds a report by ID
+	// FindByID finds a report by ID (reports in the trash are not found)
 	FindByID(ctx context.Context, id string) (*entity.Report, error)
 	
-	// FindAll finds all reports with limit
+	// FindByIDs finds reports by IDs (reports in the trash and unknown IDs are skipped)
+	FindByIDs(ctx context.Context, ids []string) ([]*entity.Report, error)
+	
+	// FindAll finds published reports with limit (without photo data)
 	FindAll(ctx context.Context, limit int) ([]*entity.Report, error)
 	
-	// Update updates a report
+	// FindByAuthorID finds the newest published reports of an author with limit (without photo data)
+	FindByAuthorID(ctx context.Context, authorID string, limit int) ([]*entity.Report, error)
+	
+	// FindPublished finds the newest published reports matching the filter with limit (without photo data)
+	FindPublished(ctx context.Context, filter entity.ReportFilter, limit int) ([]*entity.Report, error)
+	
+	// FindPublishedModifications finds the update times of published reports, newest first
+	FindPublishedModifications(ctx context.Context, limit int) ([]entity.Modification, error)
+	
+	// CountTags counts published reports per tag, most used first; prefix limits the tags (may be empty)
+	CountTags(ctx context.Context, prefix string, limit int) ([]entity.TagCount, error)
+	
+	// FindUnpublished finds drafts and scheduled reports, newest first; nil authorID means all authors (without photo data)
+	FindUnpublished(ctx context.Context, authorID *string, limit int) ([]*entity.Report, error)
+	
+	// Update updates the title, text, competition link, tags and category of a report; photos are kept
 	Update(ctx context.Context, id string, report *entity.Report) error
 	
-	// Delete deletes a report
+	// AddPhotos appends photos unless the report would have more than maxPhotos; false if it would
+	AddPhotos(ctx context.Context, id string, photos []entity.ReportPhoto, maxPhotos int) (bool, error)
+	
+	// RemovePhotos removes the photos with the IDs from a report; unknown IDs are ignored
+	// Returns the removed photos without data
+	RemovePhotos(ctx context.Context, id string, photoIDs []string) ([]entity.ReportPhoto, error)
+	
+	// UpdatePhoto updates the caption, photographer and position of a photo of a report, found by photo ID
+	UpdatePhoto(ctx context.Context, id string, photo entity.ReportPhoto) error
+	
+	// FindCompetitionPhotos finds published reports linked to a competition that have photos, oldest first (without photo data)
+	FindCompetitionPhotos(ctx context.Context, competitionID string) ([]*entity.Report, error)
+	
+	// SetPublication updates the status and publication times of a report
+	SetPublication(ctx context.Context, id string, status entity.ReportStatus, publishAt, publishedAt *time.Time) error
+	
+	// PublishDue publishes scheduled reports whose publication time has come
+	PublishDue(ctx context.Context, now time.Time) (int64, error)
+	
+	// FindDeleted finds reports in the trash, most recently deleted first
+	FindDeleted(ctx context.Context) ([]*entity.Report, error)
+	
+	// SoftDelete moves a report to the trash
+	SoftDelete(ctx context.Context, id string, deletedBy string) error
+	
+	// Restore takes a report out of the trash
+	Restore(ctx context.Context, id string) error
+	
+	// Delete deletes a report permanently
 	Delete(ctx context.Context, id string) error
 	
-	// GetAuthorID gets author ID of a report
+	// GetAuthorID gets author ID of a report (reports in the trash are not found)
 	GetAuthorID(ctx context.Context, id string) (string, error)
+	
+	// CountByAuthorID counts reports of an author, including those in the trash
+	CountByAuthorID(ctx context.Context, authorID string) (int64, error)
+	
+	// UnlinkCompetition removes the competition and tour reference from all reports about a competition
+	UnlinkCompetition(ctx context.Context, competitionID string) error
+	
+	// AnonymizeAuthor replaces the author of all reports of a deleted user with entity.DeletedUserID
+	AnonymizeAuthor(ctx context.Context, authorID string) error
 }
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// ReportViewRepository defines the interface for report view data operations
+// A viewer is counted once per report per day
+type ReportViewRepository interface {
+	// Record records a view of a report by the viewer; returns false if the viewer has already been counted that day
+	Record(ctx context.Context, reportID, viewerKey string, at time.Time) (bool, error)
+
+	// CountByReportIDs counts views by report ID
+	CountByReportIDs(ctx context.Context, reportIDs []string) (map[string]int64, error)
+
+	// CountSince counts views since the given time, by report ID
+	CountSince(ctx context.Context, since time.Time) (map[string]int64, error)
+
+	// DeleteByReportID deletes all views of a report
+	DeleteByReportID(ctx context.Context, reportID string) error
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+)
+
+// ResultRepository defines the interface for tour result data operations
+type ResultRepository interface {
+	// Upsert creates or replaces the result of a registration in a tour
+	Upsert(ctx context.Context, result *entity.TourResult) (string, error)
+
+	// FindByID finds a result by ID
+	FindByID(ctx context.Context, id string) (*entity.TourResult, error)
+
+	// FindByCompetitionID finds all results of a competition
+	FindByCompetitionID(ctx context.Context, competitionID string) ([]*entity.TourResult, error)
+
+	// Delete deletes a result
+	Delete(ctx context.Context, id string) error
+
+	// DeleteByCompetitionID deletes all results of a competition
+	DeleteByCompetitionID(ctx context.Context, competitionID string) error
+
+	// CountByRegistrationIDs counts results of the registrations
+	CountByRegistrationIDs(ctx context.Context, registrationIDs []string) (int64, error)
+
+	// DeleteByRegistrationID deletes all results of a registration
+	DeleteByRegistrationID(ctx context.Context, registrationID string) error
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+)
+
+// RevisionRepository defines the interface for revision history data operations
+type RevisionRepository interface {
+	// Create stores a revision; the version is assigned by the caller
+	Create(ctx context.Context, revision *entity.Revision) (string, error)
+
+	// FindByID finds a revision by ID
+	FindByID(ctx context.Context, id string) (*entity.Revision, error)
+
+	// FindByEntityID finds the revisions of an entity, newest first
+	FindByEntityID(ctx context.Context, entityID string) ([]*entity.Revision, error)
+
+	// CountByEntityID counts the revisions of an entity
+	CountByEntityID(ctx context.Context, entityID string) (int64, error)
+
+	// DeleteByEntityID deletes the revisions of an entity
+	DeleteByEntityID(ctx context.Context, entityID string) error
+}
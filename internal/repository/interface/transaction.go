@@ -0,0 +1,14 @@
+package repository
+
+import "context"
+
+// TxManager runs repository operations atomically
+// Repositories called with the ctx passed to fn take part in the transaction
+type TxManager interface {
+	// WithTransaction runs fn in a transaction; any error returned by fn aborts it
+	// Without transaction support (a standalone MongoDB server) fn runs without one
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+
+	// Supported reports whether WithTransaction runs fn in a real transaction
+	Supported() bool
+}
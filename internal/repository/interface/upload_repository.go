@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+)
+
+// UploadRepository defines the interface for resumable upload data operations
+type UploadRepository interface {
+	// Create creates a new upload
+	Create(ctx context.Context, upload *entity.Upload) (string, error)
+
+	// FindByID finds an upload by ID
+	FindByID(ctx context.Context, id string) (*entity.Upload, error)
+
+	// AppendChunk stores a chunk at the offset and advances the upload past it; false if the upload
+	// is no longer at that offset (another request got there first) or the chunk exceeds the declared length
+	AppendChunk(ctx context.Context, id string, offset int64, data []byte, expiresAt time.Time) (bool, error)
+
+	// ReadData reads the received bytes of an upload in order
+	ReadData(ctx context.Context, id string) ([]byte, error)
+
+	// CountByUserID counts the uploads of a user
+	CountByUserID(ctx context.Context, userID string) (int64, error)
+
+	// Delete deletes uploads with their chunks
+	Delete(ctx context.Context, ids []string) error
+
+	// DeleteExpired deletes uploads that expired before now with their chunks and returns them
+	DeleteExpired(ctx context.Context, now time.Time) ([]*entity.Upload, error)
+
+	// DeleteByUserID deletes all uploads of a user with their chunks
+	DeleteByUserID(ctx context.Context, userID string) error
+}
@@ -12,6 +12,9 @@ type UserRepository interface {
 	// FindByID finds a user by ID
 	FindByID(ctx context.Context, id string) (*entity.User, error)
 	
+	// FindByIDs finds users by IDs, without avatar data; unknown IDs are skipped
+	FindByIDs(ctx context.Context, ids []string) ([]*entity.User, error)
+	
 	// FindByEmailOrUsername finds a user by email or username
 	FindByEmailOrUsername(ctx context.Context, email, username string) (*entity.User, error)
 	
@@ -32,4 +35,11 @@ type UserRepository interface {
 	
 	// CountAdmins counts number of admin users
 	CountAdmins(ctx context.Context) (int64, error)
+	
+	// AddStorageUsed changes the bytes a user stores by delta; an increase is applied only while the total
+	// stays within quota, false otherwise. Decreases of users that no longer exist are ignored
+	AddStorageUsed(ctx context.Context, id string, delta, quota int64) (bool, error)
+	
+	// IncrementCalendarTokenVersion increases the calendar feed token version of a user and returns the new version
+	IncrementCalendarTokenVersion(ctx context.Context, id string) (int, error)
 }
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+)
+
+// VenueRepository defines the interface for venue data operations
+type VenueRepository interface {
+	// Create creates a new venue
+	Create(ctx context.Context, venue *entity.Venue) (string, error)
+
+	// FindByID finds a venue by ID
+	FindByID(ctx context.Context, id string) (*entity.Venue, error)
+
+	// FindAll finds all venues
+	FindAll(ctx context.Context) ([]*entity.Venue, error)
+
+	// FindNear finds venues within radiusKm of the point, nearest first
+	FindNear(ctx context.Context, point entity.GeoPoint, radiusKm float64) ([]*entity.Venue, error)
+
+	// Update updates a venue
+	Update(ctx context.Context, id string, venue *entity.Venue) error
+
+	// Delete deletes a venue
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,154 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	"github.com/cnpf/feeder-backend/internal/repository/interface"
+)
+
+// CheckInRepository handles check-in database operations
+// Implements repository.CheckInRepository interface
+type CheckInRepository struct {
+	db *mongo.Database
+}
+
+// NewCheckInRepository creates a new check-in repository
+func NewCheckInRepository(db *mongo.Database) repository.CheckInRepository {
+	return &CheckInRepository{db: db}
+}
+
+// Ensure CheckInRepository implements repository.CheckInRepository interface
+var _ repository.CheckInRepository = (*CheckInRepository)(nil)
+
+// CheckInDocument represents a check-in document in MongoDB
+type CheckInDocument struct {
+	ID             primitive.ObjectID `bson:"_id"`
+	CompetitionID  primitive.ObjectID `bson:"competitionId"`
+	RegistrationID primitive.ObjectID `bson:"registrationId"`
+	Tour           int                `bson:"tour"`
+	JudgeID        primitive.ObjectID `bson:"judgeId"`
+	CreatedAt      primitive.DateTime `bson:"createdAt"`
+}
+
+// toEntity converts MongoDB document to domain entity
+func (doc *CheckInDocument) toEntity() *entity.CheckIn {
+	return &entity.CheckIn{
+		ID:             doc.ID.Hex(),
+		CompetitionID:  doc.CompetitionID.Hex(),
+		RegistrationID: doc.RegistrationID.Hex(),
+		Tour:           doc.Tour,
+		JudgeID:        doc.JudgeID.Hex(),
+		CreatedAt:      doc.CreatedAt.Time(),
+	}
+}
+
+// Create records a check-in; returns the existing check-in (and false) if the registration
+// was already checked in for the tour. (registrationId, tour) is unique, see EnsureIndexes
+func (r *CheckInRepository) Create(ctx context.Context, checkIn *entity.CheckIn) (*entity.CheckIn, bool, error) {
+	competitionID, err := primitive.ObjectIDFromHex(checkIn.CompetitionID)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid competition ID: %w", err)
+	}
+	registrationID, err := primitive.ObjectIDFromHex(checkIn.RegistrationID)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid registration ID: %w", err)
+	}
+	judgeID, err := primitive.ObjectIDFromHex(checkIn.JudgeID)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid judge ID: %w", err)
+	}
+
+	filter := bson.M{"registrationId": registrationID, "tour": checkIn.Tour}
+	update := bson.M{"$setOnInsert": bson.M{
+		"_id":           primitive.NewObjectID(),
+		"competitionId": competitionID,
+		"judgeId":       judgeID,
+		"createdAt":     primitive.NewDateTimeFromTime(time.Now()),
+	}}
+
+	result, err := r.db.Collection("checkins").UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to save check-in: %w", err)
+	}
+
+	var doc CheckInDocument
+	if err := r.db.Collection("checkins").FindOne(ctx, filter).Decode(&doc); err != nil {
+		return nil, false, fmt.Errorf("failed to find check-in: %w", err)
+	}
+	return doc.toEntity(), result.UpsertedCount == 1, nil
+}
+
+// FindByCompetitionAndTour finds all check-ins of a competition tour
+func (r *CheckInRepository) FindByCompetitionAndTour(ctx context.Context, competitionID string, tour int) ([]*entity.CheckIn, error) {
+	objID, err := primitive.ObjectIDFromHex(competitionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid competition ID: %w", err)
+	}
+
+	filter := bson.M{"competitionId": objID, "tour": tour}
+	cursor, err := r.db.Collection("checkins").Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "createdAt", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []CheckInDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	checkIns := make([]*entity.CheckIn, len(docs))
+	for i, doc := range docs {
+		checkIns[i] = doc.toEntity()
+	}
+	return checkIns, nil
+}
+
+// Delete removes the check-in of a registration for a tour
+func (r *CheckInRepository) Delete(ctx context.Context, registrationID string, tour int) error {
+	objID, err := primitive.ObjectIDFromHex(registrationID)
+	if err != nil {
+		return fmt.Errorf("invalid registration ID: %w", err)
+	}
+
+	result, err := r.db.Collection("checkins").DeleteOne(ctx, bson.M{"registrationId": objID, "tour": tour})
+	if err != nil {
+		return fmt.Errorf("failed to delete check-in: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("check-in not found")
+	}
+	return nil
+}
+
+// CountByCompetitionID counts check-ins of a competition over all tours
+func (r *CheckInRepository) CountByCompetitionID(ctx context.Context, competitionID string) (int64, error) {
+	objID, err := primitive.ObjectIDFromHex(competitionID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid competition ID: %w", err)
+	}
+	return r.db.Collection("checkins").CountDocuments(ctx, bson.M{"competitionId": objID})
+}
+
+// DeleteByCompetitionID deletes all check-ins of a competition
+func (r *CheckInRepository) DeleteByCompetitionID(ctx context.Context, competitionID string) error {
+	return deleteByCompetitionID(ctx, r.db.Collection("checkins"), competitionID)
+}
+
+// DeleteByRegistrationID deletes all check-ins of a registration
+func (r *CheckInRepository) DeleteByRegistrationID(ctx context.Context, registrationID string) error {
+	return deleteByRegistrationID(ctx, r.db.Collection("checkins"), registrationID)
+}
+
+// CountByRegistrationIDs counts check-ins of the registrations
+func (r *CheckInRepository) CountByRegistrationIDs(ctx context.Context, registrationIDs []string) (int64, error) {
+	return countByRegistrationIDs(ctx, r.db.Collection("checkins"), registrationIDs)
+}
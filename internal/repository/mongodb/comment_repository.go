@@ -0,0 +1,306 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	"github.com/cnpf/feeder-backend/internal/repository/interface"
+)
+
+// CommentRepository handles report comment database operations
+// Implements repository.CommentRepository interface
+type CommentRepository struct {
+	db *mongo.Database
+}
+
+// NewCommentRepository creates a new comment repository
+func NewCommentRepository(db *mongo.Database) repository.CommentRepository {
+	return &CommentRepository{db: db}
+}
+
+// Ensure CommentRepository implements repository.CommentRepository interface
+var _ repository.CommentRepository = (*CommentRepository)(nil)
+
+// CommentDocument represents a comment document in MongoDB
+type CommentDocument struct {
+	ID          primitive.ObjectID  `bson:"_id"`
+	ReportID    primitive.ObjectID  `bson:"reportId"`
+	ParentID    *primitive.ObjectID `bson:"parentId,omitempty"`
+	AuthorID    primitive.ObjectID  `bson:"authorId"`
+	Text        string              `bson:"text"`
+	Status      string              `bson:"status"`
+	ModeratedBy *primitive.ObjectID `bson:"moderatedBy,omitempty"`
+	ModeratedAt *primitive.DateTime `bson:"moderatedAt,omitempty"`
+	Deleted     bool                `bson:"deleted,omitempty"`
+	EditedAt    *primitive.DateTime `bson:"editedAt,omitempty"`
+	CreatedAt   primitive.DateTime  `bson:"createdAt"`
+	UpdatedAt   primitive.DateTime  `bson:"updatedAt"`
+}
+
+// visibleComments matches comments shown to everyone
+var visibleComments = bson.M{
+	"status":  bson.M{"$ne": string(entity.CommentStatusHidden)},
+	"deleted": bson.M{"$ne": true},
+}
+
+// toEntity converts MongoDB document to domain entity
+func (doc *CommentDocument) toEntity() *entity.Comment {
+	var parentID, moderatedBy *string
+	if doc.ParentID != nil {
+		id := doc.ParentID.Hex()
+		parentID = &id
+	}
+	if doc.ModeratedBy != nil {
+		id := doc.ModeratedBy.Hex()
+		moderatedBy = &id
+	}
+
+	var moderatedAt, editedAt *time.Time
+	if doc.ModeratedAt != nil {
+		t := doc.ModeratedAt.Time()
+		moderatedAt = &t
+	}
+	if doc.EditedAt != nil {
+		t := doc.EditedAt.Time()
+		editedAt = &t
+	}
+
+	return &entity.Comment{
+		ID:          doc.ID.Hex(),
+		ReportID:    doc.ReportID.Hex(),
+		ParentID:    parentID,
+		AuthorID:    doc.AuthorID.Hex(),
+		Text:        doc.Text,
+		Status:      entity.CommentStatus(doc.Status),
+		ModeratedBy: moderatedBy,
+		ModeratedAt: moderatedAt,
+		Deleted:     doc.Deleted,
+		EditedAt:    editedAt,
+		CreatedAt:   doc.CreatedAt.Time(),
+		UpdatedAt:   doc.UpdatedAt.Time(),
+	}
+}
+
+// Create creates a new comment
+func (r *CommentRepository) Create(ctx context.Context, comment *entity.Comment) (string, error) {
+	reportID, err := primitive.ObjectIDFromHex(comment.ReportID)
+	if err != nil {
+		return "", fmt.Errorf("invalid report ID: %w", err)
+	}
+	authorID, err := primitive.ObjectIDFromHex(comment.AuthorID)
+	if err != nil {
+		return "", fmt.Errorf("invalid author ID: %w", err)
+	}
+
+	var parentID *primitive.ObjectID
+	if comment.ParentID != nil {
+		objID, err := primitive.ObjectIDFromHex(*comment.ParentID)
+		if err != nil {
+			return "", fmt.Errorf("invalid parent comment ID: %w", err)
+		}
+		parentID = &objID
+	}
+
+	now := primitive.NewDateTimeFromTime(time.Now())
+	doc := CommentDocument{
+		ID:        primitive.NewObjectID(),
+		ReportID:  reportID,
+		ParentID:  parentID,
+		AuthorID:  authorID,
+		Text:      comment.Text,
+		Status:    string(comment.Status),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if _, err := r.db.Collection("comments").InsertOne(ctx, doc); err != nil {
+		return "", fmt.Errorf("failed to create comment: %w", err)
+	}
+	return doc.ID.Hex(), nil
+}
+
+// FindByID finds a comment by ID
+func (r *CommentRepository) FindByID(ctx context.Context, id string) (*entity.Comment, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID: %w", err)
+	}
+
+	var doc CommentDocument
+	if err := r.db.Collection("comments").FindOne(ctx, bson.M{"_id": objID}).Decode(&doc); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("comment not found")
+		}
+		return nil, fmt.Errorf("failed to find comment: %w", err)
+	}
+	return doc.toEntity(), nil
+}
+
+// FindByReportID finds the comments of a report in the order they were written
+func (r *CommentRepository) FindByReportID(ctx context.Context, reportID string) ([]*entity.Comment, error) {
+	objID, err := primitive.ObjectIDFromHex(reportID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid report ID: %w", err)
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: 1}, {Key: "_id", Value: 1}})
+	return r.find(ctx, bson.M{"reportId": objID}, opts)
+}
+
+// FindByStatus finds comments with the status, oldest first (the moderation queue)
+func (r *CommentRepository) FindByStatus(ctx context.Context, status entity.CommentStatus, limit int) ([]*entity.Comment, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: 1}}).SetLimit(int64(limit))
+	return r.find(ctx, bson.M{"status": string(status), "deleted": bson.M{"$ne": true}}, opts)
+}
+
+func (r *CommentRepository) find(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*entity.Comment, error) {
+	cursor, err := r.db.Collection("comments").Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []CommentDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	comments := make([]*entity.Comment, len(docs))
+	for i, doc := range docs {
+		comments[i] = doc.toEntity()
+	}
+	return comments, nil
+}
+
+// UpdateText updates the text of a comment and marks it as edited;
+// with requeue an approved comment goes back to the moderation queue (hidden comments stay hidden)
+func (r *CommentRepository) UpdateText(ctx context.Context, id string, text string, requeue bool) error {
+	now := primitive.NewDateTimeFromTime(time.Now())
+	if !requeue {
+		return r.update(ctx, id, bson.M{"$set": bson.M{
+			"text":      text,
+			"editedAt":  now,
+			"updatedAt": now,
+		}})
+	}
+
+	// A pipeline update checks the current status in the same write as the text;
+	// the text goes through $literal so that a leading "$" is not read as a field path
+	return r.update(ctx, id, bson.A{bson.M{"$set": bson.M{
+		"text":      bson.M{"$literal": text},
+		"editedAt":  now,
+		"updatedAt": now,
+		"status": bson.M{"$cond": bson.A{
+			bson.M{"$eq": bson.A{"$status", string(entity.CommentStatusApproved)}},
+			string(entity.CommentStatusNew),
+			"$status",
+		}},
+	}}})
+}
+
+// SetStatus sets the moderation status of a comment
+func (r *CommentRepository) SetStatus(ctx context.Context, id string, status entity.CommentStatus, moderatorID string) error {
+	moderator, err := primitive.ObjectIDFromHex(moderatorID)
+	if err != nil {
+		return fmt.Errorf("invalid moderator ID: %w", err)
+	}
+
+	now := primitive.NewDateTimeFromTime(time.Now())
+	return r.update(ctx, id, bson.M{"$set": bson.M{
+		"status":      string(status),
+		"moderatedBy": moderator,
+		"moderatedAt": now,
+		"updatedAt":   now,
+	}})
+}
+
+// MarkDeleted replaces a comment with replies by a placeholder without text
+func (r *CommentRepository) MarkDeleted(ctx context.Context, id string) error {
+	return r.update(ctx, id, bson.M{"$set": bson.M{
+		"text":      "",
+		"deleted":   true,
+		"updatedAt": primitive.NewDateTimeFromTime(time.Now()),
+	}})
+}
+
+func (r *CommentRepository) update(ctx context.Context, id string, update interface{}) error {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid ID: %w", err)
+	}
+
+	result, err := r.db.Collection("comments").UpdateOne(ctx, bson.M{"_id": objID}, update)
+	if err != nil {
+		return fmt.Errorf("failed to update comment: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("comment not found")
+	}
+	return nil
+}
+
+// Delete deletes a comment
+func (r *CommentRepository) Delete(ctx context.Context, id string) error {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid ID: %w", err)
+	}
+	_, err = r.db.Collection("comments").DeleteOne(ctx, bson.M{"_id": objID})
+	return err
+}
+
+// HasReplies checks if a comment has replies
+func (r *CommentRepository) HasReplies(ctx context.Context, id string) (bool, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return false, fmt.Errorf("invalid ID: %w", err)
+	}
+	count, err := r.db.Collection("comments").CountDocuments(ctx, bson.M{"parentId": objID}, options.Count().SetLimit(1))
+	return count > 0, err
+}
+
+// CountVisibleByReportIDs counts comments that are not hidden or deleted, by report ID
+func (r *CommentRepository) CountVisibleByReportIDs(ctx context.Context, reportIDs []string) (map[string]int64, error) {
+	objIDs, err := objectIDsFromHex(reportIDs, "report")
+	if err != nil {
+		return nil, err
+	}
+	return countPerReport(ctx, r.db.Collection("comments"), withFilter(visibleComments, bson.M{"reportId": bson.M{"$in": objIDs}}))
+}
+
+// DeleteByReportID deletes all comments of a report
+func (r *CommentRepository) DeleteByReportID(ctx context.Context, reportID string) error {
+	objID, err := primitive.ObjectIDFromHex(reportID)
+	if err != nil {
+		return fmt.Errorf("invalid report ID: %w", err)
+	}
+	_, err = r.db.Collection("comments").DeleteMany(ctx, bson.M{"reportId": objID})
+	return err
+}
+
+// CountByAuthorID counts comments of an author
+func (r *CommentRepository) CountByAuthorID(ctx context.Context, authorID string) (int64, error) {
+	objID, err := primitive.ObjectIDFromHex(authorID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid author ID: %w", err)
+	}
+	return r.db.Collection("comments").CountDocuments(ctx, bson.M{"authorId": objID})
+}
+
+// AnonymizeAuthor replaces the author of all comments of a deleted user with entity.DeletedUserID
+func (r *CommentRepository) AnonymizeAuthor(ctx context.Context, authorID string) error {
+	objID, err := primitive.ObjectIDFromHex(authorID)
+	if err != nil {
+		return fmt.Errorf("invalid author ID: %w", err)
+	}
+
+	_, err = r.db.Collection("comments").UpdateMany(ctx, bson.M{"authorId": objID}, bson.M{"$set": bson.M{"authorId": primitive.NilObjectID}})
+	return err
+}
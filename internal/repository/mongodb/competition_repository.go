@@ -35,6 +35,7 @@ type CompetitionDocument struct {
 	StartDate        primitive.DateTime   `bson:"startDate"`
 	EndDate          primitive.DateTime   `bson:"endDate"`
 	Location         string               `bson:"location"`
+	VenueID          *primitive.ObjectID  `bson:"venueId,omitempty"`
 	Tours            bson.A               `bson:"tours"`
 	OpeningDate      *primitive.DateTime  `bson:"openingDate,omitempty"`
 	OpeningTime      *string              `bson:"openingTime,omitempty"`
@@ -43,8 +44,94 @@ type CompetitionDocument struct {
 	Fee              *float64             `bson:"fee,omitempty"`
 	TeamLimit        *int32               `bson:"teamLimit,omitempty"`
 	Regulations      *string              `bson:"regulations,omitempty"`
+	JudgeIDs         []primitive.ObjectID `bson:"judgeIds,omitempty"`
+	ProtestWindow    *int                 `bson:"protestWindowMinutes,omitempty"`
+	Eligibility      *EligibilityDoc      `bson:"eligibility,omitempty"`
+	TeamRules        *TeamRulesDoc        `bson:"teamRules,omitempty"`
 	CreatedAt        primitive.DateTime   `bson:"createdAt"`
 	UpdatedAt        primitive.DateTime   `bson:"updatedAt"`
+	DeletedAt        *primitive.DateTime  `bson:"deletedAt,omitempty"`
+	DeletedBy        *primitive.ObjectID  `bson:"deletedBy,omitempty"`
+}
+
+// EligibilityDoc represents competition eligibility rules (also embedded in templates)
+type EligibilityDoc struct {
+	Category        *string `bson:"category,omitempty"`
+	MinAge          *int    `bson:"minAge,omitempty"`
+	MaxAge          *int    `bson:"maxAge,omitempty"`
+	Gender          *string `bson:"gender,omitempty"`
+	LicenseRequired bool    `bson:"licenseRequired"`
+}
+
+// TeamRulesDoc represents competition team composition rules (also embedded in templates)
+type TeamRulesDoc struct {
+	MinSize         int  `bson:"minSize"`
+	MaxSize         int  `bson:"maxSize"`
+	MaxReserves     int  `bson:"maxReserves"`
+	MaxCoaches      int  `bson:"maxCoaches"`
+	CaptainRequired bool `bson:"captainRequired"`
+}
+
+func (doc *TeamRulesDoc) toEntity() *entity.TeamRules {
+	if doc == nil {
+		return nil
+	}
+	return &entity.TeamRules{
+		MinSize:         doc.MinSize,
+		MaxSize:         doc.MaxSize,
+		MaxReserves:     doc.MaxReserves,
+		MaxCoaches:      doc.MaxCoaches,
+		CaptainRequired: doc.CaptainRequired,
+	}
+}
+
+func teamRulesFromEntity(rules *entity.TeamRules) *TeamRulesDoc {
+	if rules == nil {
+		return nil
+	}
+	return &TeamRulesDoc{
+		MinSize:         rules.MinSize,
+		MaxSize:         rules.MaxSize,
+		MaxReserves:     rules.MaxReserves,
+		MaxCoaches:      rules.MaxCoaches,
+		CaptainRequired: rules.CaptainRequired,
+	}
+}
+
+func (doc *EligibilityDoc) toEntity() *entity.EligibilityRules {
+	if doc == nil {
+		return nil
+	}
+	var gender *entity.Gender
+	if doc.Gender != nil {
+		g := entity.Gender(*doc.Gender)
+		gender = &g
+	}
+	return &entity.EligibilityRules{
+		Category:        doc.Category,
+		MinAge:          doc.MinAge,
+		MaxAge:          doc.MaxAge,
+		Gender:          gender,
+		LicenseRequired: doc.LicenseRequired,
+	}
+}
+
+func eligibilityFromEntity(rules *entity.EligibilityRules) *EligibilityDoc {
+	if rules == nil {
+		return nil
+	}
+	var gender *string
+	if rules.Gender != nil {
+		g := string(*rules.Gender)
+		gender = &g
+	}
+	return &EligibilityDoc{
+		Category:        rules.Category,
+		MinAge:          rules.MinAge,
+		MaxAge:          rules.MaxAge,
+		Gender:          gender,
+		LicenseRequired: rules.LicenseRequired,
+	}
 }
 
 // toEntity converts MongoDB document to domain entity
@@ -72,12 +159,26 @@ func (doc *CompetitionDocument) toEntity() *entity.Competition {
 		openingDate = &t
 	}
 	
+	var venueID *string
+	if doc.VenueID != nil {
+		v := doc.VenueID.Hex()
+		venueID = &v
+	}
+	
+	judgeIDs := make([]string, len(doc.JudgeIDs))
+	for i, id := range doc.JudgeIDs {
+		judgeIDs[i] = id.Hex()
+	}
+	
+	deletedAt, deletedBy := deletionFromDoc(doc.DeletedAt, doc.DeletedBy)
+	
 	return &entity.Competition{
 		ID:               doc.ID.Hex(),
 		Title:            doc.Title,
 		StartDate:        &startDate,
 		EndDate:          &endDate,
 		Location:         doc.Location,
+		VenueID:          venueID,
 		Tours:            tours,
 		OpeningDate:      openingDate,
 		OpeningTime:      doc.OpeningTime,
@@ -86,8 +187,14 @@ func (doc *CompetitionDocument) toEntity() *entity.Competition {
 		Fee:              doc.Fee,
 		TeamLimit:        func() *int { if doc.TeamLimit != nil { v := int(*doc.TeamLimit); return &v }; return nil }(),
 		Regulations:      doc.Regulations,
+		JudgeIDs:         judgeIDs,
+		ProtestWindow:    doc.ProtestWindow,
+		Eligibility:      doc.Eligibility.toEntity(),
+		TeamRules:        doc.TeamRules.toEntity(),
 		CreatedAt:        doc.CreatedAt.Time(),
 		UpdatedAt:        doc.UpdatedAt.Time(),
+		DeletedAt:        deletedAt,
+		DeletedBy:        deletedBy,
 	}
 }
 
@@ -132,6 +239,15 @@ func competitionFromEntity(competition *entity.Competition) (*CompetitionDocumen
 		teamLimit = &t
 	}
 	
+	var venueID *primitive.ObjectID
+	if competition.VenueID != nil {
+		v, err := primitive.ObjectIDFromHex(*competition.VenueID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid venue ID: %w", err)
+		}
+		venueID = &v
+	}
+	
 	now := primitive.NewDateTimeFromTime(time.Now())
 	createdAt := now
 	if !competition.CreatedAt.IsZero() {
@@ -148,6 +264,7 @@ func competitionFromEntity(competition *entity.Competition) (*CompetitionDocumen
 		StartDate:        startDate,
 		EndDate:          endDate,
 		Location:         competition.Location,
+		VenueID:          venueID,
 		Tours:            tours,
 		OpeningDate:      openingDate,
 		OpeningTime:      competition.OpeningTime,
@@ -156,6 +273,9 @@ func competitionFromEntity(competition *entity.Competition) (*CompetitionDocumen
 		Fee:              competition.Fee,
 		TeamLimit:        teamLimit,
 		Regulations:      competition.Regulations,
+		ProtestWindow:    competition.ProtestWindow,
+		Eligibility:      eligibilityFromEntity(competition.Eligibility),
+		TeamRules:        teamRulesFromEntity(competition.TeamRules),
 		CreatedAt:        createdAt,
 		UpdatedAt:        updatedAt,
 	}, nil
@@ -180,7 +300,7 @@ func (r *CompetitionRepository) Create(ctx context.Context, competition *entity.
 	return oid.Hex(), nil
 }
 
-// FindByID finds a competition by ID
+// FindByID finds a competition by ID (competitions in the trash are not found)
 func (r *CompetitionRepository) FindByID(ctx context.Context, id string) (*entity.Competition, error) {
 	competitionID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
@@ -188,16 +308,34 @@ func (r *CompetitionRepository) FindByID(ctx context.Context, id string) (*entit
 	}
 	
 	var doc CompetitionDocument
-	err = r.db.Collection("competitions").FindOne(ctx, bson.M{"_id": competitionID}).Decode(&doc)
+	err = r.db.Collection("competitions").FindOne(ctx, bson.M{"_id": competitionID, "deletedAt": nil}).Decode(&doc)
 	if err != nil {
 		return nil, err
 	}
 	return doc.toEntity(), nil
 }
 
-// FindAll finds all competitions
+// FindAll finds all competitions except those in the trash
 func (r *CompetitionRepository) FindAll(ctx context.Context) ([]*entity.Competition, error) {
-	cursor, err := r.db.Collection("competitions").Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "createdAt", Value: -1}}))
+	return r.find(ctx, notDeleted, options.Find().SetSort(bson.D{{Key: "createdAt", Value: -1}}))
+}
+
+// FindDeleted finds competitions in the trash, most recently deleted first
+func (r *CompetitionRepository) FindDeleted(ctx context.Context) ([]*entity.Competition, error) {
+	return r.find(ctx, inTrash, trashSort)
+}
+
+// FindByJudgeID finds competitions (including those in the trash) where the user is on the judge panel
+func (r *CompetitionRepository) FindByJudgeID(ctx context.Context, userID string) ([]*entity.Competition, error) {
+	objID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+	return r.find(ctx, bson.M{"judgeIds": objID}, options.Find())
+}
+
+func (r *CompetitionRepository) find(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*entity.Competition, error) {
+	cursor, err := r.db.Collection("competitions").Find(ctx, filter, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -215,6 +353,16 @@ func (r *CompetitionRepository) FindAll(ctx context.Context) ([]*entity.Competit
 	return competitions, nil
 }
 
+// FindByVenueID finds all competitions held at a venue
+func (r *CompetitionRepository) FindByVenueID(ctx context.Context, venueID string) ([]*entity.Competition, error) {
+	objID, err := primitive.ObjectIDFromHex(venueID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid venue ID: %w", err)
+	}
+	
+	return r.find(ctx, bson.M{"venueId": objID, "deletedAt": nil}, options.Find().SetSort(bson.D{{Key: "startDate", Value: -1}}))
+}
+
 // Update updates a competition
 func (r *CompetitionRepository) Update(ctx context.Context, id string, competition *entity.Competition) error {
 	competitionID, err := primitive.ObjectIDFromHex(id)
@@ -263,11 +411,52 @@ func (r *CompetitionRepository) Update(ctx context.Context, id string, competiti
 	} else {
 		update["regulations"] = nil
 	}
+	if doc.VenueID != nil {
+		update["venueId"] = doc.VenueID
+	} else {
+		update["venueId"] = nil
+	}
+	if doc.ProtestWindow != nil {
+		update["protestWindowMinutes"] = doc.ProtestWindow
+	} else {
+		update["protestWindowMinutes"] = nil
+	}
+	if doc.Eligibility != nil {
+		update["eligibility"] = doc.Eligibility
+	} else {
+		update["eligibility"] = nil
+	}
+	if doc.TeamRules != nil {
+		update["teamRules"] = doc.TeamRules
+	} else {
+		update["teamRules"] = nil
+	}
 	
 	_, err = r.db.Collection("competitions").UpdateOne(ctx, bson.M{"_id": competitionID}, bson.M{"$set": update})
 	return err
 }
 
+// SoftDelete moves a competition to the trash
+func (r *CompetitionRepository) SoftDelete(ctx context.Context, id string, deletedBy string) error {
+	return softDelete(ctx, r.db.Collection("competitions"), id, deletedBy)
+}
+
+// Restore takes a competition out of the trash
+func (r *CompetitionRepository) Restore(ctx context.Context, id string) error {
+	return restoreDeleted(ctx, r.db.Collection("competitions"), id)
+}
+
+// RemoveJudge removes the user from the judge panels of all competitions
+func (r *CompetitionRepository) RemoveJudge(ctx context.Context, userID string) error {
+	objID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID: %w", err)
+	}
+	
+	_, err = r.db.Collection("competitions").UpdateMany(ctx, bson.M{"judgeIds": objID}, bson.M{"$pull": bson.M{"judgeIds": objID}})
+	return err
+}
+
 // Delete deletes a competition
 func (r *CompetitionRepository) Delete(ctx context.Context, id string) error {
 	competitionID, err := primitive.ObjectIDFromHex(id)
@@ -284,3 +473,32 @@ func (r *CompetitionRepository) Delete(ctx context.Context, id string) error {
 	}
 	return nil
 }
+
+// SetJudges replaces the judge panel of a competition
+func (r *CompetitionRepository) SetJudges(ctx context.Context, id string, judgeIDs []string) error {
+	competitionID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid competition ID: %w", err)
+	}
+	
+	ids := make([]primitive.ObjectID, len(judgeIDs))
+	for i, judgeID := range judgeIDs {
+		ids[i], err = primitive.ObjectIDFromHex(judgeID)
+		if err != nil {
+			return fmt.Errorf("invalid judge ID: %w", err)
+		}
+	}
+	
+	update := bson.M{"$set": bson.M{
+		"judgeIds":  ids,
+		"updatedAt": primitive.NewDateTimeFromTime(time.Now()),
+	}}
+	result, err := r.db.Collection("competitions").UpdateOne(ctx, bson.M{"_id": competitionID}, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("competition not found")
+	}
+	return nil
+}
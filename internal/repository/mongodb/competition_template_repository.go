@@ -0,0 +1,205 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	"github.com/cnpf/feeder-backend/internal/repository/interface"
+)
+
+// CompetitionTemplateRepository handles competition template database operations
+// Implements repository.CompetitionTemplateRepository interface
+type CompetitionTemplateRepository struct {
+	db *mongo.Database
+}
+
+// NewCompetitionTemplateRepository creates a new competition template repository
+func NewCompetitionTemplateRepository(db *mongo.Database) repository.CompetitionTemplateRepository {
+	return &CompetitionTemplateRepository{db: db}
+}
+
+// Ensure CompetitionTemplateRepository implements repository.CompetitionTemplateRepository interface
+var _ repository.CompetitionTemplateRepository = (*CompetitionTemplateRepository)(nil)
+
+// CompetitionTemplateDocument represents a competition template document in MongoDB
+type CompetitionTemplateDocument struct {
+	ID                primitive.ObjectID  `bson:"_id"`
+	Name              string              `bson:"name"`
+	Title             string              `bson:"title"`
+	Location          string              `bson:"location"`
+	VenueID           *primitive.ObjectID `bson:"venueId,omitempty"`
+	DurationDays      int                 `bson:"durationDays"`
+	Tours             []TemplateTourDoc   `bson:"tours"`
+	OpeningOffsetDays *int                `bson:"openingOffsetDays,omitempty"`
+	OpeningTime       *string             `bson:"openingTime,omitempty"`
+	IndividualFormat  bool                `bson:"individualFormat"`
+	TeamFormat        bool                `bson:"teamFormat"`
+	Fee               *float64            `bson:"fee,omitempty"`
+	TeamLimit         *int                `bson:"teamLimit,omitempty"`
+	Regulations       *string             `bson:"regulations,omitempty"`
+	ProtestWindow     *int                `bson:"protestWindowMinutes,omitempty"`
+	Eligibility       *EligibilityDoc     `bson:"eligibility,omitempty"`
+	TeamRules         *TeamRulesDoc       `bson:"teamRules,omitempty"`
+	CreatedAt         primitive.DateTime  `bson:"createdAt"`
+	UpdatedAt         primitive.DateTime  `bson:"updatedAt"`
+}
+
+type TemplateTourDoc struct {
+	DayOffset int    `bson:"dayOffset"`
+	Time      string `bson:"time"`
+}
+
+// toEntity converts MongoDB document to domain entity
+func (doc *CompetitionTemplateDocument) toEntity() *entity.CompetitionTemplate {
+	tours := make([]entity.TemplateTour, len(doc.Tours))
+	for i, t := range doc.Tours {
+		tours[i] = entity.TemplateTour{
+			DayOffset: t.DayOffset,
+			Time:      t.Time,
+		}
+	}
+
+	var venueID *string
+	if doc.VenueID != nil {
+		v := doc.VenueID.Hex()
+		venueID = &v
+	}
+
+	return &entity.CompetitionTemplate{
+		ID:                doc.ID.Hex(),
+		Name:              doc.Name,
+		Title:             doc.Title,
+		Location:          doc.Location,
+		VenueID:           venueID,
+		DurationDays:      doc.DurationDays,
+		Tours:             tours,
+		OpeningOffsetDays: doc.OpeningOffsetDays,
+		OpeningTime:       doc.OpeningTime,
+		IndividualFormat:  doc.IndividualFormat,
+		TeamFormat:        doc.TeamFormat,
+		Fee:               doc.Fee,
+		TeamLimit:         doc.TeamLimit,
+		Regulations:       doc.Regulations,
+		ProtestWindow:     doc.ProtestWindow,
+		Eligibility:       doc.Eligibility.toEntity(),
+		TeamRules:         doc.TeamRules.toEntity(),
+		CreatedAt:         doc.CreatedAt.Time(),
+		UpdatedAt:         doc.UpdatedAt.Time(),
+	}
+}
+
+// Create creates a new template
+func (r *CompetitionTemplateRepository) Create(ctx context.Context, template *entity.CompetitionTemplate) (string, error) {
+	var venueID *primitive.ObjectID
+	if template.VenueID != nil {
+		v, err := primitive.ObjectIDFromHex(*template.VenueID)
+		if err != nil {
+			return "", fmt.Errorf("invalid venue ID: %w", err)
+		}
+		venueID = &v
+	}
+
+	tours := make([]TemplateTourDoc, len(template.Tours))
+	for i, t := range template.Tours {
+		tours[i] = TemplateTourDoc{
+			DayOffset: t.DayOffset,
+			Time:      t.Time,
+		}
+	}
+
+	now := primitive.NewDateTimeFromTime(time.Now())
+	doc := CompetitionTemplateDocument{
+		ID:                primitive.NewObjectID(),
+		Name:              template.Name,
+		Title:             template.Title,
+		Location:          template.Location,
+		VenueID:           venueID,
+		DurationDays:      template.DurationDays,
+		Tours:             tours,
+		OpeningOffsetDays: template.OpeningOffsetDays,
+		OpeningTime:       template.OpeningTime,
+		IndividualFormat:  template.IndividualFormat,
+		TeamFormat:        template.TeamFormat,
+		Fee:               template.Fee,
+		TeamLimit:         template.TeamLimit,
+		Regulations:       template.Regulations,
+		ProtestWindow:     template.ProtestWindow,
+		Eligibility:       eligibilityFromEntity(template.Eligibility),
+		TeamRules:         teamRulesFromEntity(template.TeamRules),
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+
+	result, err := r.db.Collection("competitionTemplates").InsertOne(ctx, doc)
+	if err != nil {
+		return "", fmt.Errorf("failed to create competition template: %w", err)
+	}
+
+	oid, ok := result.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return "", fmt.Errorf("unexpected InsertedID type: %T", result.InsertedID)
+	}
+	return oid.Hex(), nil
+}
+
+// FindByID finds a template by ID
+func (r *CompetitionTemplateRepository) FindByID(ctx context.Context, id string) (*entity.CompetitionTemplate, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID: %w", err)
+	}
+
+	var doc CompetitionTemplateDocument
+	err = r.db.Collection("competitionTemplates").FindOne(ctx, bson.M{"_id": objID}).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("competition template not found")
+		}
+		return nil, fmt.Errorf("failed to find competition template: %w", err)
+	}
+	return doc.toEntity(), nil
+}
+
+// FindAll finds all templates sorted by name
+func (r *CompetitionTemplateRepository) FindAll(ctx context.Context) ([]*entity.CompetitionTemplate, error) {
+	cursor, err := r.db.Collection("competitionTemplates").Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "name", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []CompetitionTemplateDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	templates := make([]*entity.CompetitionTemplate, len(docs))
+	for i, doc := range docs {
+		templates[i] = doc.toEntity()
+	}
+	return templates, nil
+}
+
+// Delete deletes a template
+func (r *CompetitionTemplateRepository) Delete(ctx context.Context, id string) error {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid ID: %w", err)
+	}
+
+	result, err := r.db.Collection("competitionTemplates").DeleteOne(ctx, bson.M{"_id": objID})
+	if err != nil {
+		return fmt.Errorf("failed to delete competition template: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("competition template not found")
+	}
+	return nil
+}
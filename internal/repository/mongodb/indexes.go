@@ -0,0 +1,104 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// collectionIndexes lists indexes required by the repositories, per collection
+var collectionIndexes = map[string][]mongo.IndexModel{
+	"venues": {
+		{Keys: bson.D{{Key: "location", Value: "2dsphere"}}},
+	},
+	"competitions": {
+		{Keys: bson.D{{Key: "venueId", Value: 1}}},
+		{Keys: bson.D{{Key: "judgeIds", Value: 1}}},
+		{Keys: bson.D{{Key: "deletedAt", Value: 1}}},
+	},
+	"results": {
+		{
+			Keys:    bson.D{{Key: "registrationId", Value: 1}, {Key: "tour", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{Keys: bson.D{{Key: "competitionId", Value: 1}}},
+	},
+	"penalties": {
+		{Keys: bson.D{{Key: "competitionId", Value: 1}, {Key: "createdAt", Value: 1}}},
+	},
+	"protests": {
+		{Keys: bson.D{{Key: "competitionId", Value: 1}, {Key: "status", Value: 1}}},
+	},
+	"checkins": {
+		{
+			Keys:    bson.D{{Key: "registrationId", Value: 1}, {Key: "tour", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{Keys: bson.D{{Key: "competitionId", Value: 1}, {Key: "tour", Value: 1}}},
+	},
+	"notifications": {
+		{Keys: bson.D{{Key: "userId", Value: 1}, {Key: "createdAt", Value: -1}}},
+	},
+	"registrations": {
+		{Keys: bson.D{{Key: "userId", Value: 1}}},
+		{Keys: bson.D{{Key: "deletedAt", Value: 1}}},
+	},
+	"revisions": {
+		{
+			Keys:    bson.D{{Key: "entityId", Value: 1}, {Key: "version", Value: -1}},
+			Options: options.Index().SetUnique(true),
+		},
+	},
+	"comments": {
+		{Keys: bson.D{{Key: "reportId", Value: 1}, {Key: "createdAt", Value: 1}}},
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "createdAt", Value: 1}}},
+		{Keys: bson.D{{Key: "parentId", Value: 1}}},
+		{Keys: bson.D{{Key: "authorId", Value: 1}}},
+	},
+	"reactions": {
+		{
+			Keys:    bson.D{{Key: "reportId", Value: 1}, {Key: "userId", Value: 1}, {Key: "type", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{Keys: bson.D{{Key: "createdAt", Value: 1}}},
+		{Keys: bson.D{{Key: "userId", Value: 1}}},
+	},
+	"reportViews": {
+		{
+			Keys:    bson.D{{Key: "reportId", Value: 1}, {Key: "viewer", Value: 1}, {Key: "day", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{Keys: bson.D{{Key: "createdAt", Value: 1}}},
+	},
+	"uploads": {
+		{Keys: bson.D{{Key: "userId", Value: 1}}},
+		{Keys: bson.D{{Key: "expiresAt", Value: 1}}},
+	},
+	"uploadChunks": {
+		{
+			Keys:    bson.D{{Key: "uploadId", Value: 1}, {Key: "offset", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+	},
+	"reports": {
+		{Keys: bson.D{{Key: "tags", Value: 1}}},
+		{Keys: bson.D{{Key: "category", Value: 1}, {Key: "createdAt", Value: -1}}},
+		{Keys: bson.D{{Key: "authorId", Value: 1}, {Key: "createdAt", Value: -1}}},
+		{Keys: bson.D{{Key: "deletedAt", Value: 1}}},
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "publishAt", Value: 1}}},
+		{Keys: bson.D{{Key: "competitionId", Value: 1}, {Key: "createdAt", Value: -1}}},
+	},
+}
+
+// EnsureIndexes creates indexes required by the repositories (idempotent)
+func EnsureIndexes(ctx context.Context, db *mongo.Database) error {
+	for collection, indexes := range collectionIndexes {
+		if _, err := db.Collection(collection).Indexes().CreateMany(ctx, indexes); err != nil {
+			return fmt.Errorf("failed to create indexes for %s: %w", collection, err)
+		}
+	}
+	return nil
+}
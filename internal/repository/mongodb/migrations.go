@@ -0,0 +1,135 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// AssignReportPhotoIDs gives IDs and positions to report photos stored before photos had them (idempotent)
+// Positions follow the stored order, so reports look the same as before
+func AssignReportPhotoIDs(ctx context.Context, db *mongo.Database) error {
+	collection := db.Collection("reports")
+	filter := bson.M{"photos": bson.M{"$elemMatch": bson.M{"id": bson.M{"$exists": false}}}}
+	cursor, err := collection.Find(ctx, filter, options.Find().SetProjection(bson.M{"photos.id": 1}))
+	if err != nil {
+		return fmt.Errorf("failed to find report photos without IDs: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var doc struct {
+			ID     primitive.ObjectID `bson:"_id"`
+			Photos []bson.M           `bson:"photos"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			return fmt.Errorf("failed to decode report photos: %w", err)
+		}
+
+		set := bson.M{}
+		for i, photo := range doc.Photos {
+			if _, ok := photo["id"]; ok {
+				continue
+			}
+			set[fmt.Sprintf("photos.%d.id", i)] = primitive.NewObjectID()
+			set[fmt.Sprintf("photos.%d.position", i)] = i
+		}
+
+		// The size condition skips reports whose photos changed in the meantime; the next run handles them
+		_, err := collection.UpdateOne(ctx,
+			bson.M{"_id": doc.ID, "photos": bson.M{"$size": len(doc.Photos)}},
+			bson.M{"$set": set})
+		if err != nil {
+			return fmt.Errorf("failed to assign photo IDs of report %s: %w", doc.ID.Hex(), err)
+		}
+	}
+	return cursor.Err()
+}
+
+// InitStorageUsage counts the storage of users stored before quotas existed (idempotent)
+// Report photos get their size and are charged to the report author; then every user without a counter
+// gets the bytes of their photos, avatar and unattached uploads
+func InitStorageUsage(ctx context.Context, db *mongo.Database) error {
+	reports := db.Collection("reports")
+	_, err := reports.UpdateMany(ctx,
+		bson.M{"photos": bson.M{"$elemMatch": bson.M{"size": bson.M{"$exists": false}}}},
+		mongo.Pipeline{{{Key: "$set", Value: bson.M{"photos": bson.M{"$map": bson.M{
+			"input": "$photos",
+			"as":    "photo",
+			"in": bson.M{"$mergeObjects": bson.A{"$$photo", bson.M{
+				"size":       bson.M{"$ifNull": bson.A{"$$photo.size", bson.M{"$binarySize": bson.M{"$ifNull": bson.A{"$$photo.data", ""}}}}},
+				"uploadedBy": bson.M{"$ifNull": bson.A{"$$photo.uploadedBy", "$authorId"}},
+			}}},
+		}}}}}})
+	if err != nil {
+		return fmt.Errorf("failed to set report photo sizes: %w", err)
+	}
+
+	users := db.Collection("users")
+	cursor, err := users.Find(ctx, bson.M{"storageUsed": bson.M{"$exists": false}})
+	if err != nil {
+		return fmt.Errorf("failed to find users without storage usage: %w", err)
+	}
+	defer cursor.Close(ctx)
+	if !cursor.Next(ctx) {
+		return cursor.Err()
+	}
+
+	photoBytes, err := sumByUser(ctx, reports, mongo.Pipeline{
+		{{Key: "$project", Value: bson.M{"photos.size": 1, "photos.uploadedBy": 1}}},
+		{{Key: "$unwind", Value: "$photos"}},
+		{{Key: "$group", Value: bson.M{"_id": "$photos.uploadedBy", "total": bson.M{"$sum": "$photos.size"}}}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to sum report photo sizes: %w", err)
+	}
+	uploadBytes, err := sumByUser(ctx, db.Collection("uploads"), mongo.Pipeline{
+		{{Key: "$group", Value: bson.M{"_id": "$userId", "total": bson.M{"$sum": "$length"}}}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to sum upload sizes: %w", err)
+	}
+
+	for {
+		var doc UserDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return fmt.Errorf("failed to decode user: %w", err)
+		}
+		used := photoBytes[doc.ID] + uploadBytes[doc.ID] + doc.toEntity().AvatarSize
+		_, err := users.UpdateOne(ctx,
+			bson.M{"_id": doc.ID, "storageUsed": bson.M{"$exists": false}},
+			bson.M{"$set": bson.M{"storageUsed": used}})
+		if err != nil {
+			return fmt.Errorf("failed to set storage usage of user %s: %w", doc.ID.Hex(), err)
+		}
+		if !cursor.Next(ctx) {
+			return cursor.Err()
+		}
+	}
+}
+
+// sumByUser runs a pipeline that groups totals by user ID
+func sumByUser(ctx context.Context, collection *mongo.Collection, pipeline mongo.Pipeline) (map[primitive.ObjectID]int64, error) {
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var totals []struct {
+		UserID primitive.ObjectID `bson:"_id"`
+		Total  int64              `bson:"total"`
+	}
+	if err := cursor.All(ctx, &totals); err != nil {
+		return nil, err
+	}
+	sums := make(map[primitive.ObjectID]int64, len(totals))
+	for _, total := range totals {
+		sums[total.UserID] = total.Total
+	}
+	return sums, nil
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"sync"
 
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
@@ -89,3 +90,16 @@ func GetDB() (*mongo.Database, error) {
 	}
 	return client.Database(dbName), nil
 }
+
+// objectIDsFromHex converts IDs to ObjectIDs; name is the kind of ID in errors
+func objectIDsFromHex(ids []string, name string) ([]primitive.ObjectID, error) {
+	objIDs := make([]primitive.ObjectID, len(ids))
+	for i, id := range ids {
+		objID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s ID: %w", name, err)
+		}
+		objIDs[i] = objID
+	}
+	return objIDs, nil
+}
@@ -0,0 +1,179 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	"github.com/cnpf/feeder-backend/internal/repository/interface"
+)
+
+// NotificationRepository handles notification database operations
+// Implements repository.NotificationRepository interface
+type NotificationRepository struct {
+	db *mongo.Database
+}
+
+// NewNotificationRepository creates a new notification repository
+func NewNotificationRepository(db *mongo.Database) repository.NotificationRepository {
+	return &NotificationRepository{db: db}
+}
+
+// Ensure NotificationRepository implements repository.NotificationRepository interface
+var _ repository.NotificationRepository = (*NotificationRepository)(nil)
+
+// NotificationDocument represents a notification document in MongoDB
+type NotificationDocument struct {
+	ID         primitive.ObjectID  `bson:"_id"`
+	UserID     primitive.ObjectID  `bson:"userId"`
+	Type       string              `bson:"type"`
+	Title      string              `bson:"title"`
+	Message    string              `bson:"message"`
+	EntityType string              `bson:"entityType,omitempty"`
+	EntityID   string              `bson:"entityId,omitempty"`
+	ReadAt     *primitive.DateTime `bson:"readAt,omitempty"`
+	CreatedAt  primitive.DateTime  `bson:"createdAt"`
+}
+
+// toEntity converts MongoDB document to domain entity
+func (doc *NotificationDocument) toEntity() *entity.Notification {
+	var readAt *time.Time
+	if doc.ReadAt != nil {
+		t := doc.ReadAt.Time()
+		readAt = &t
+	}
+
+	return &entity.Notification{
+		ID:         doc.ID.Hex(),
+		UserID:     doc.UserID.Hex(),
+		Type:       doc.Type,
+		Title:      doc.Title,
+		Message:    doc.Message,
+		EntityType: doc.EntityType,
+		EntityID:   doc.EntityID,
+		ReadAt:     readAt,
+		CreatedAt:  doc.CreatedAt.Time(),
+	}
+}
+
+// CreateMany creates notifications (one per recipient)
+func (r *NotificationRepository) CreateMany(ctx context.Context, notifications []*entity.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	now := primitive.NewDateTimeFromTime(time.Now())
+	docs := make([]interface{}, len(notifications))
+	for i, n := range notifications {
+		userID, err := primitive.ObjectIDFromHex(n.UserID)
+		if err != nil {
+			return fmt.Errorf("invalid user ID: %w", err)
+		}
+		docs[i] = NotificationDocument{
+			ID:         primitive.NewObjectID(),
+			UserID:     userID,
+			Type:       n.Type,
+			Title:      n.Title,
+			Message:    n.Message,
+			EntityType: n.EntityType,
+			EntityID:   n.EntityID,
+			CreatedAt:  now,
+		}
+	}
+
+	if _, err := r.db.Collection("notifications").InsertMany(ctx, docs); err != nil {
+		return fmt.Errorf("failed to create notifications: %w", err)
+	}
+	return nil
+}
+
+// FindByUserID finds the newest notifications of a user
+func (r *NotificationRepository) FindByUserID(ctx context.Context, userID string, unreadOnly bool, limit int) ([]*entity.Notification, error) {
+	objID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	filter := bson.M{"userId": objID}
+	if unreadOnly {
+		filter["readAt"] = bson.M{"$exists": false}
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: -1}}).SetLimit(int64(limit))
+	cursor, err := r.db.Collection("notifications").Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []NotificationDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	notifications := make([]*entity.Notification, len(docs))
+	for i, doc := range docs {
+		notifications[i] = doc.toEntity()
+	}
+	return notifications, nil
+}
+
+// CountUnread counts unread notifications of a user
+func (r *NotificationRepository) CountUnread(ctx context.Context, userID string) (int64, error) {
+	objID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid user ID: %w", err)
+	}
+	return r.db.Collection("notifications").CountDocuments(ctx, bson.M{"userId": objID, "readAt": bson.M{"$exists": false}})
+}
+
+// MarkRead marks notifications of a user as read; empty ids marks all of them
+func (r *NotificationRepository) MarkRead(ctx context.Context, userID string, ids []string) error {
+	objID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	filter := bson.M{"userId": objID, "readAt": bson.M{"$exists": false}}
+	if len(ids) > 0 {
+		objIDs := make([]primitive.ObjectID, len(ids))
+		for i, id := range ids {
+			objIDs[i], err = primitive.ObjectIDFromHex(id)
+			if err != nil {
+				return fmt.Errorf("invalid notification ID: %w", err)
+			}
+		}
+		filter["_id"] = bson.M{"$in": objIDs}
+	}
+
+	update := bson.M{"$set": bson.M{"readAt": primitive.NewDateTimeFromTime(time.Now())}}
+	if _, err := r.db.Collection("notifications").UpdateMany(ctx, filter, update); err != nil {
+		return fmt.Errorf("failed to mark notifications as read: %w", err)
+	}
+	return nil
+}
+
+// CountByUserID counts all notifications of a user
+func (r *NotificationRepository) CountByUserID(ctx context.Context, userID string) (int64, error) {
+	objID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid user ID: %w", err)
+	}
+	return r.db.Collection("notifications").CountDocuments(ctx, bson.M{"userId": objID})
+}
+
+// DeleteByUserID deletes all notifications of a user
+func (r *NotificationRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	objID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID: %w", err)
+	}
+	_, err = r.db.Collection("notifications").DeleteMany(ctx, bson.M{"userId": objID})
+	return err
+}
@@ -0,0 +1,265 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	"github.com/cnpf/feeder-backend/internal/repository/interface"
+)
+
+// PenaltyRepository handles penalty database operations
+// Implements repository.PenaltyRepository interface
+type PenaltyRepository struct {
+	db *mongo.Database
+}
+
+// NewPenaltyRepository creates a new penalty repository
+func NewPenaltyRepository(db *mongo.Database) repository.PenaltyRepository {
+	return &PenaltyRepository{db: db}
+}
+
+// Ensure PenaltyRepository implements repository.PenaltyRepository interface
+var _ repository.PenaltyRepository = (*PenaltyRepository)(nil)
+
+// PenaltyDocument represents a penalty document in MongoDB
+type PenaltyDocument struct {
+	ID             primitive.ObjectID  `bson:"_id"`
+	CompetitionID  primitive.ObjectID  `bson:"competitionId"`
+	RegistrationID primitive.ObjectID  `bson:"registrationId"`
+	Tour           *int                `bson:"tour,omitempty"`
+	Type           string              `bson:"type"`
+	Reason         string              `bson:"reason"`
+	JudgeID        primitive.ObjectID  `bson:"judgeId"`
+	History        []PenaltyChangeDoc  `bson:"history"`
+	DeletedAt      *primitive.DateTime `bson:"deletedAt,omitempty"`
+	CreatedAt      primitive.DateTime  `bson:"createdAt"`
+	UpdatedAt      primitive.DateTime  `bson:"updatedAt"`
+}
+
+type PenaltyChangeDoc struct {
+	Action    string             `bson:"action"`
+	UserID    primitive.ObjectID `bson:"userId"`
+	Tour      *int               `bson:"tour,omitempty"`
+	Type      string             `bson:"type"`
+	Reason    string             `bson:"reason"`
+	ChangedAt primitive.DateTime `bson:"changedAt"`
+}
+
+// toEntity converts MongoDB document to domain entity
+func (doc *PenaltyDocument) toEntity() *entity.Penalty {
+	history := make([]entity.PenaltyChange, len(doc.History))
+	for i, h := range doc.History {
+		history[i] = entity.PenaltyChange{
+			Action:    entity.PenaltyAction(h.Action),
+			UserID:    h.UserID.Hex(),
+			Tour:      h.Tour,
+			Type:      entity.PenaltyType(h.Type),
+			Reason:    h.Reason,
+			ChangedAt: h.ChangedAt.Time(),
+		}
+	}
+
+	var deletedAt *time.Time
+	if doc.DeletedAt != nil {
+		t := doc.DeletedAt.Time()
+		deletedAt = &t
+	}
+
+	return &entity.Penalty{
+		ID:             doc.ID.Hex(),
+		CompetitionID:  doc.CompetitionID.Hex(),
+		RegistrationID: doc.RegistrationID.Hex(),
+		Tour:           doc.Tour,
+		Type:           entity.PenaltyType(doc.Type),
+		Reason:         doc.Reason,
+		JudgeID:        doc.JudgeID.Hex(),
+		History:        history,
+		DeletedAt:      deletedAt,
+		CreatedAt:      doc.CreatedAt.Time(),
+		UpdatedAt:      doc.UpdatedAt.Time(),
+	}
+}
+
+// penaltyChangeFromEntity converts a history entry to its document form
+func penaltyChangeFromEntity(change entity.PenaltyChange) (PenaltyChangeDoc, error) {
+	userID, err := primitive.ObjectIDFromHex(change.UserID)
+	if err != nil {
+		return PenaltyChangeDoc{}, fmt.Errorf("invalid user ID: %w", err)
+	}
+	return PenaltyChangeDoc{
+		Action:    string(change.Action),
+		UserID:    userID,
+		Tour:      change.Tour,
+		Type:      string(change.Type),
+		Reason:    change.Reason,
+		ChangedAt: primitive.NewDateTimeFromTime(change.ChangedAt),
+	}, nil
+}
+
+// Create creates a new penalty with the given history entry
+func (r *PenaltyRepository) Create(ctx context.Context, penalty *entity.Penalty, change entity.PenaltyChange) (string, error) {
+	competitionID, err := primitive.ObjectIDFromHex(penalty.CompetitionID)
+	if err != nil {
+		return "", fmt.Errorf("invalid competition ID: %w", err)
+	}
+	registrationID, err := primitive.ObjectIDFromHex(penalty.RegistrationID)
+	if err != nil {
+		return "", fmt.Errorf("invalid registration ID: %w", err)
+	}
+	judgeID, err := primitive.ObjectIDFromHex(penalty.JudgeID)
+	if err != nil {
+		return "", fmt.Errorf("invalid judge ID: %w", err)
+	}
+	changeDoc, err := penaltyChangeFromEntity(change)
+	if err != nil {
+		return "", err
+	}
+
+	now := primitive.NewDateTimeFromTime(time.Now())
+	doc := PenaltyDocument{
+		ID:             primitive.NewObjectID(),
+		CompetitionID:  competitionID,
+		RegistrationID: registrationID,
+		Tour:           penalty.Tour,
+		Type:           string(penalty.Type),
+		Reason:         penalty.Reason,
+		JudgeID:        judgeID,
+		History:        []PenaltyChangeDoc{changeDoc},
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	if _, err := r.db.Collection("penalties").InsertOne(ctx, doc); err != nil {
+		return "", fmt.Errorf("failed to create penalty: %w", err)
+	}
+	return doc.ID.Hex(), nil
+}
+
+// FindByID finds a penalty by ID, including deleted ones
+func (r *PenaltyRepository) FindByID(ctx context.Context, id string) (*entity.Penalty, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID: %w", err)
+	}
+
+	var doc PenaltyDocument
+	err = r.db.Collection("penalties").FindOne(ctx, bson.M{"_id": objID}).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("penalty not found")
+		}
+		return nil, fmt.Errorf("failed to find penalty: %w", err)
+	}
+	return doc.toEntity(), nil
+}
+
+// FindByCompetitionID finds active (not deleted) penalties of a competition
+func (r *PenaltyRepository) FindByCompetitionID(ctx context.Context, competitionID string) ([]*entity.Penalty, error) {
+	objID, err := primitive.ObjectIDFromHex(competitionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid competition ID: %w", err)
+	}
+
+	filter := bson.M{"competitionId": objID, "deletedAt": bson.M{"$exists": false}}
+	cursor, err := r.db.Collection("penalties").Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "createdAt", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []PenaltyDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	penalties := make([]*entity.Penalty, len(docs))
+	for i, doc := range docs {
+		penalties[i] = doc.toEntity()
+	}
+	return penalties, nil
+}
+
+// Update updates tour, type and reason of an active penalty and appends the history entry
+func (r *PenaltyRepository) Update(ctx context.Context, penalty *entity.Penalty, change entity.PenaltyChange) error {
+	objID, err := primitive.ObjectIDFromHex(penalty.ID)
+	if err != nil {
+		return fmt.Errorf("invalid ID: %w", err)
+	}
+	changeDoc, err := penaltyChangeFromEntity(change)
+	if err != nil {
+		return err
+	}
+
+	set := bson.M{
+		"type":      string(penalty.Type),
+		"reason":    penalty.Reason,
+		"updatedAt": changeDoc.ChangedAt,
+	}
+	update := bson.M{
+		"$set":  set,
+		"$push": bson.M{"history": changeDoc},
+	}
+	if penalty.Tour != nil {
+		set["tour"] = *penalty.Tour
+	} else {
+		update["$unset"] = bson.M{"tour": ""}
+	}
+
+	filter := bson.M{"_id": objID, "deletedAt": bson.M{"$exists": false}}
+	result, err := r.db.Collection("penalties").UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to update penalty: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("penalty not found")
+	}
+	return nil
+}
+
+// Delete marks an active penalty as deleted and appends the history entry
+func (r *PenaltyRepository) Delete(ctx context.Context, id string, change entity.PenaltyChange) error {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid ID: %w", err)
+	}
+	changeDoc, err := penaltyChangeFromEntity(change)
+	if err != nil {
+		return err
+	}
+
+	filter := bson.M{"_id": objID, "deletedAt": bson.M{"$exists": false}}
+	update := bson.M{
+		"$set":  bson.M{"deletedAt": changeDoc.ChangedAt, "updatedAt": changeDoc.ChangedAt},
+		"$push": bson.M{"history": changeDoc},
+	}
+	result, err := r.db.Collection("penalties").UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to delete penalty: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("penalty not found")
+	}
+	return nil
+}
+
+// DeleteByCompetitionID permanently deletes all penalties of a competition, including the history
+func (r *PenaltyRepository) DeleteByCompetitionID(ctx context.Context, competitionID string) error {
+	return deleteByCompetitionID(ctx, r.db.Collection("penalties"), competitionID)
+}
+
+// DeleteByRegistrationID permanently deletes all penalties of a registration, including the history
+func (r *PenaltyRepository) DeleteByRegistrationID(ctx context.Context, registrationID string) error {
+	return deleteByRegistrationID(ctx, r.db.Collection("penalties"), registrationID)
+}
+
+// CountByRegistrationIDs counts penalties of the registrations, including deleted ones
+func (r *PenaltyRepository) CountByRegistrationIDs(ctx context.Context, registrationIDs []string) (int64, error) {
+	return countByRegistrationIDs(ctx, r.db.Collection("penalties"), registrationIDs)
+}
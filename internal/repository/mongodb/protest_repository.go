@@ -0,0 +1,232 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	"github.com/cnpf/feeder-backend/internal/repository/interface"
+)
+
+// ProtestRepository handles protest database operations
+// Implements repository.ProtestRepository interface
+type ProtestRepository struct {
+	db *mongo.Database
+}
+
+// NewProtestRepository creates a new protest repository
+func NewProtestRepository(db *mongo.Database) repository.ProtestRepository {
+	return &ProtestRepository{db: db}
+}
+
+// Ensure ProtestRepository implements repository.ProtestRepository interface
+var _ repository.ProtestRepository = (*ProtestRepository)(nil)
+
+// ProtestDocument represents a protest document in MongoDB
+type ProtestDocument struct {
+	ID             primitive.ObjectID  `bson:"_id"`
+	CompetitionID  primitive.ObjectID  `bson:"competitionId"`
+	RegistrationID primitive.ObjectID  `bson:"registrationId"`
+	FiledBy        primitive.ObjectID  `bson:"filedBy"`
+	TargetType     string              `bson:"targetType"`
+	TargetID       primitive.ObjectID  `bson:"targetId"`
+	TargetRegID    primitive.ObjectID  `bson:"targetRegistrationId"`
+	Reason         string              `bson:"reason"`
+	Status         string              `bson:"status"`
+	Decision       *string             `bson:"decision,omitempty"`
+	DecidedBy      *primitive.ObjectID `bson:"decidedBy,omitempty"`
+	DecidedAt      *primitive.DateTime `bson:"decidedAt,omitempty"`
+	CreatedAt      primitive.DateTime  `bson:"createdAt"`
+	UpdatedAt      primitive.DateTime  `bson:"updatedAt"`
+}
+
+// toEntity converts MongoDB document to domain entity
+func (doc *ProtestDocument) toEntity() *entity.Protest {
+	var decidedBy *string
+	if doc.DecidedBy != nil {
+		d := doc.DecidedBy.Hex()
+		decidedBy = &d
+	}
+
+	var decidedAt *time.Time
+	if doc.DecidedAt != nil {
+		t := doc.DecidedAt.Time()
+		decidedAt = &t
+	}
+
+	return &entity.Protest{
+		ID:             doc.ID.Hex(),
+		CompetitionID:  doc.CompetitionID.Hex(),
+		RegistrationID: doc.RegistrationID.Hex(),
+		FiledBy:        doc.FiledBy.Hex(),
+		TargetType:     entity.ProtestTarget(doc.TargetType),
+		TargetID:       doc.TargetID.Hex(),
+		TargetRegID:    doc.TargetRegID.Hex(),
+		Reason:         doc.Reason,
+		Status:         entity.ProtestStatus(doc.Status),
+		Decision:       doc.Decision,
+		DecidedBy:      decidedBy,
+		DecidedAt:      decidedAt,
+		CreatedAt:      doc.CreatedAt.Time(),
+		UpdatedAt:      doc.UpdatedAt.Time(),
+	}
+}
+
+// Create creates a new protest
+func (r *ProtestRepository) Create(ctx context.Context, protest *entity.Protest) (string, error) {
+	ids := make(map[string]primitive.ObjectID, 5)
+	for name, hex := range map[string]string{
+		"competition":         protest.CompetitionID,
+		"registration":        protest.RegistrationID,
+		"user":                protest.FiledBy,
+		"target":              protest.TargetID,
+		"target registration": protest.TargetRegID,
+	} {
+		id, err := primitive.ObjectIDFromHex(hex)
+		if err != nil {
+			return "", fmt.Errorf("invalid %s ID: %w", name, err)
+		}
+		ids[name] = id
+	}
+
+	now := primitive.NewDateTimeFromTime(time.Now())
+	doc := ProtestDocument{
+		ID:             primitive.NewObjectID(),
+		CompetitionID:  ids["competition"],
+		RegistrationID: ids["registration"],
+		FiledBy:        ids["user"],
+		TargetType:     string(protest.TargetType),
+		TargetID:       ids["target"],
+		TargetRegID:    ids["target registration"],
+		Reason:         protest.Reason,
+		Status:         string(entity.ProtestStatusOpen),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	if _, err := r.db.Collection("protests").InsertOne(ctx, doc); err != nil {
+		return "", fmt.Errorf("failed to create protest: %w", err)
+	}
+	return doc.ID.Hex(), nil
+}
+
+// FindByID finds a protest by ID
+func (r *ProtestRepository) FindByID(ctx context.Context, id string) (*entity.Protest, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID: %w", err)
+	}
+
+	var doc ProtestDocument
+	err = r.db.Collection("protests").FindOne(ctx, bson.M{"_id": objID}).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("protest not found")
+		}
+		return nil, fmt.Errorf("failed to find protest: %w", err)
+	}
+	return doc.toEntity(), nil
+}
+
+// FindByCompetitionID finds protests of a competition, optionally filtered by status
+func (r *ProtestRepository) FindByCompetitionID(ctx context.Context, competitionID string, status *entity.ProtestStatus) ([]*entity.Protest, error) {
+	objID, err := primitive.ObjectIDFromHex(competitionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid competition ID: %w", err)
+	}
+
+	filter := bson.M{"competitionId": objID}
+	if status != nil {
+		filter["status"] = string(*status)
+	}
+
+	cursor, err := r.db.Collection("protests").Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "createdAt", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []ProtestDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	protests := make([]*entity.Protest, len(docs))
+	for i, doc := range docs {
+		protests[i] = doc.toEntity()
+	}
+	return protests, nil
+}
+
+// UpdateStatus moves an open protest to a final status with the decision
+// Fails if the protest was already closed (concurrent decision)
+func (r *ProtestRepository) UpdateStatus(ctx context.Context, protest *entity.Protest) error {
+	objID, err := primitive.ObjectIDFromHex(protest.ID)
+	if err != nil {
+		return fmt.Errorf("invalid ID: %w", err)
+	}
+
+	now := primitive.NewDateTimeFromTime(time.Now())
+	set := bson.M{
+		"status":    string(protest.Status),
+		"decision":  protest.Decision,
+		"updatedAt": now,
+	}
+	if protest.DecidedBy != nil {
+		decidedBy, err := primitive.ObjectIDFromHex(*protest.DecidedBy)
+		if err != nil {
+			return fmt.Errorf("invalid user ID: %w", err)
+		}
+		set["decidedBy"] = decidedBy
+		set["decidedAt"] = now
+	}
+
+	filter := bson.M{"_id": objID, "status": string(entity.ProtestStatusOpen)}
+	result, err := r.db.Collection("protests").UpdateOne(ctx, filter, bson.M{"$set": set})
+	if err != nil {
+		return fmt.Errorf("failed to update protest: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("protest not found or already closed")
+	}
+	return nil
+}
+
+// DeleteByCompetitionID deletes all protests of a competition
+func (r *ProtestRepository) DeleteByCompetitionID(ctx context.Context, competitionID string) error {
+	return deleteByCompetitionID(ctx, r.db.Collection("protests"), competitionID)
+}
+
+// CountByRegistrationIDs counts protests filed by or against the registrations
+func (r *ProtestRepository) CountByRegistrationIDs(ctx context.Context, registrationIDs []string) (int64, error) {
+	if len(registrationIDs) == 0 {
+		return 0, nil
+	}
+	objIDs, err := objectIDsFromHex(registrationIDs, "registration")
+	if err != nil {
+		return 0, err
+	}
+	return r.db.Collection("protests").CountDocuments(ctx, bson.M{"$or": []bson.M{
+		{"registrationId": bson.M{"$in": objIDs}},
+		{"targetRegistrationId": bson.M{"$in": objIDs}},
+	}})
+}
+
+// DeleteByRegistrationID deletes all protests filed by or against a registration
+func (r *ProtestRepository) DeleteByRegistrationID(ctx context.Context, registrationID string) error {
+	objID, err := primitive.ObjectIDFromHex(registrationID)
+	if err != nil {
+		return fmt.Errorf("invalid registration ID: %w", err)
+	}
+	_, err = r.db.Collection("protests").DeleteMany(ctx, bson.M{"$or": []bson.M{
+		{"registrationId": objID},
+		{"targetRegistrationId": objID},
+	}})
+	return err
+}
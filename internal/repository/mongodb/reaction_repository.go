@@ -0,0 +1,204 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	"github.com/cnpf/feeder-backend/internal/repository/interface"
+)
+
+// ReactionRepository handles report reaction database operations
+// Implements repository.ReactionRepository interface
+type ReactionRepository struct {
+	db *mongo.Database
+}
+
+// NewReactionRepository creates a new reaction repository
+func NewReactionRepository(db *mongo.Database) repository.ReactionRepository {
+	return &ReactionRepository{db: db}
+}
+
+// Ensure ReactionRepository implements repository.ReactionRepository interface
+var _ repository.ReactionRepository = (*ReactionRepository)(nil)
+
+// ReactionDocument represents a reaction document in MongoDB
+type ReactionDocument struct {
+	ID        primitive.ObjectID `bson:"_id"`
+	ReportID  primitive.ObjectID `bson:"reportId"`
+	UserID    primitive.ObjectID `bson:"userId"`
+	Type      string             `bson:"type"`
+	CreatedAt primitive.DateTime `bson:"createdAt"`
+}
+
+// Toggle adds the reaction of the user, or removes it if it exists; returns true if the reaction was added
+// The unique index on (reportId, userId, type) keeps concurrent toggles from creating duplicates
+func (r *ReactionRepository) Toggle(ctx context.Context, reportID, userID string, reactionType entity.ReactionType) (bool, error) {
+	reportObjID, err := primitive.ObjectIDFromHex(reportID)
+	if err != nil {
+		return false, fmt.Errorf("invalid report ID: %w", err)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return false, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	filter := bson.M{"reportId": reportObjID, "userId": userObjID, "type": string(reactionType)}
+	result, err := r.db.Collection("reactions").DeleteOne(ctx, filter)
+	if err != nil {
+		return false, fmt.Errorf("failed to remove reaction: %w", err)
+	}
+	if result.DeletedCount > 0 {
+		return false, nil
+	}
+
+	doc := ReactionDocument{
+		ID:        primitive.NewObjectID(),
+		ReportID:  reportObjID,
+		UserID:    userObjID,
+		Type:      string(reactionType),
+		CreatedAt: primitive.NewDateTimeFromTime(time.Now()),
+	}
+	if _, err := r.db.Collection("reactions").InsertOne(ctx, doc); err != nil && !mongo.IsDuplicateKeyError(err) {
+		return false, fmt.Errorf("failed to add reaction: %w", err)
+	}
+	return true, nil
+}
+
+// CountByReportIDs counts reactions by report ID and type
+func (r *ReactionRepository) CountByReportIDs(ctx context.Context, reportIDs []string) (map[string]map[entity.ReactionType]int64, error) {
+	objIDs, err := objectIDsFromHex(reportIDs, "report")
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"reportId": bson.M{"$in": objIDs}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   bson.M{"reportId": "$reportId", "type": "$type"},
+			"count": bson.M{"$sum": 1},
+		}}},
+	}
+	cursor, err := r.db.Collection("reactions").Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var groups []struct {
+		Key struct {
+			ReportID primitive.ObjectID `bson:"reportId"`
+			Type     string             `bson:"type"`
+		} `bson:"_id"`
+		Count int64 `bson:"count"`
+	}
+	if err := cursor.All(ctx, &groups); err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]map[entity.ReactionType]int64)
+	for _, group := range groups {
+		reportID := group.Key.ReportID.Hex()
+		if counts[reportID] == nil {
+			counts[reportID] = make(map[entity.ReactionType]int64)
+		}
+		counts[reportID][entity.ReactionType(group.Key.Type)] = group.Count
+	}
+	return counts, nil
+}
+
+// FindUserTypesByReportIDs finds the reaction types the user has left, by report ID
+func (r *ReactionRepository) FindUserTypesByReportIDs(ctx context.Context, reportIDs []string, userID string) (map[string][]entity.ReactionType, error) {
+	reportObjIDs, err := objectIDsFromHex(reportIDs, "report")
+	if err != nil {
+		return nil, err
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	cursor, err := r.db.Collection("reactions").Find(ctx, bson.M{"reportId": bson.M{"$in": reportObjIDs}, "userId": userObjID})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []ReactionDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	types := make(map[string][]entity.ReactionType)
+	for _, doc := range docs {
+		reportID := doc.ReportID.Hex()
+		types[reportID] = append(types[reportID], entity.ReactionType(doc.Type))
+	}
+	return types, nil
+}
+
+// CountSince counts reactions left since the given time, by report ID
+func (r *ReactionRepository) CountSince(ctx context.Context, since time.Time) (map[string]int64, error) {
+	return countPerReport(ctx, r.db.Collection("reactions"), bson.M{"createdAt": bson.M{"$gte": primitive.NewDateTimeFromTime(since)}})
+}
+
+// DeleteByReportID deletes all reactions of a report
+func (r *ReactionRepository) DeleteByReportID(ctx context.Context, reportID string) error {
+	objID, err := primitive.ObjectIDFromHex(reportID)
+	if err != nil {
+		return fmt.Errorf("invalid report ID: %w", err)
+	}
+	_, err = r.db.Collection("reactions").DeleteMany(ctx, bson.M{"reportId": objID})
+	return err
+}
+
+// CountByUserID counts reactions of a user
+func (r *ReactionRepository) CountByUserID(ctx context.Context, userID string) (int64, error) {
+	objID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid user ID: %w", err)
+	}
+	return r.db.Collection("reactions").CountDocuments(ctx, bson.M{"userId": objID})
+}
+
+// DeleteByUserID deletes all reactions of a user
+func (r *ReactionRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	objID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID: %w", err)
+	}
+	_, err = r.db.Collection("reactions").DeleteMany(ctx, bson.M{"userId": objID})
+	return err
+}
+
+// countPerReport counts documents matching the filter, grouped by reportId
+func countPerReport(ctx context.Context, collection *mongo.Collection, filter bson.M) (map[string]int64, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$group", Value: bson.M{"_id": "$reportId", "count": bson.M{"$sum": 1}}}},
+	}
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var groups []struct {
+		ReportID primitive.ObjectID `bson:"_id"`
+		Count    int64              `bson:"count"`
+	}
+	if err := cursor.All(ctx, &groups); err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(groups))
+	for _, group := range groups {
+		counts[group.ReportID.Hex()] = group.Count
+	}
+	return counts, nil
+}
@@ -3,10 +3,12 @@ package mongodb
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/cnpf/feeder-backend/internal/domain/entity"
 	"github.com/cnpf/feeder-backend/internal/repository/interface"
@@ -34,14 +36,33 @@ type RegistrationDocument struct {
 	Type          string             `bson:"type"`
 	TeamName      *string            `bson:"teamName,omitempty"`
 	Participants  []ParticipantDoc   `bson:"participants"`
-	Coach         *CoachDoc           `bson:"coach,omitempty"`
+	Coach         *CoachDoc           `bson:"coach,omitempty"` // Legacy single coach, read only
+	Coaches       []CoachDoc         `bson:"coaches,omitempty"`
+	Sector        *string            `bson:"sector,omitempty"`
+	Peg           *int               `bson:"peg,omitempty"`
+	EligibilityOverride *EligibilityOverrideDoc `bson:"eligibilityOverride,omitempty"`
+	PaidAt        *primitive.DateTime `bson:"paidAt,omitempty"`
 	CreatedAt     primitive.DateTime `bson:"createdAt"`
 	UpdatedAt     primitive.DateTime `bson:"updatedAt"`
+	DeletedAt     *primitive.DateTime `bson:"deletedAt,omitempty"`
+	DeletedBy     *primitive.ObjectID `bson:"deletedBy,omitempty"`
 }
 
 type ParticipantDoc struct {
-	FirstName string `bson:"firstName"`
-	LastName  string `bson:"lastName"`
+	FirstName     string              `bson:"firstName"`
+	LastName      string              `bson:"lastName"`
+	BirthDate     *primitive.DateTime `bson:"birthDate,omitempty"`
+	Gender        *string             `bson:"gender,omitempty"`
+	LicenseNumber *string             `bson:"licenseNumber,omitempty"`
+	Reserve       bool                `bson:"reserve,omitempty"`
+	Captain       bool                `bson:"captain,omitempty"`
+}
+
+type EligibilityOverrideDoc struct {
+	AdminID    primitive.ObjectID `bson:"adminId"`
+	Reason     string             `bson:"reason"`
+	Violations []string           `bson:"violations"`
+	CreatedAt  primitive.DateTime `bson:"createdAt"`
 }
 
 type CoachDoc struct {
@@ -49,24 +70,114 @@ type CoachDoc struct {
 	LastName  string `bson:"lastName"`
 }
 
+func (p ParticipantDoc) toEntity() entity.Participant {
+	var birthDate *time.Time
+	if p.BirthDate != nil {
+		t := p.BirthDate.Time()
+		birthDate = &t
+	}
+	var gender *entity.Gender
+	if p.Gender != nil {
+		g := entity.Gender(*p.Gender)
+		gender = &g
+	}
+	return entity.Participant{
+		FirstName:     p.FirstName,
+		LastName:      p.LastName,
+		BirthDate:     birthDate,
+		Gender:        gender,
+		LicenseNumber: p.LicenseNumber,
+		Reserve:       p.Reserve,
+		Captain:       p.Captain,
+	}
+}
+
+func participantsFromEntity(participants []entity.Participant) []ParticipantDoc {
+	docs := make([]ParticipantDoc, len(participants))
+	for i, p := range participants {
+		docs[i] = ParticipantDoc{
+			FirstName:     p.FirstName,
+			LastName:      p.LastName,
+			LicenseNumber: p.LicenseNumber,
+			Reserve:       p.Reserve,
+			Captain:       p.Captain,
+		}
+		if p.BirthDate != nil {
+			dt := primitive.NewDateTimeFromTime(*p.BirthDate)
+			docs[i].BirthDate = &dt
+		}
+		if p.Gender != nil {
+			g := string(*p.Gender)
+			docs[i].Gender = &g
+		}
+	}
+	return docs
+}
+
+func coachesFromEntity(coaches []entity.Coach) []CoachDoc {
+	docs := make([]CoachDoc, len(coaches))
+	for i, c := range coaches {
+		docs[i] = CoachDoc{
+			FirstName: c.FirstName,
+			LastName:  c.LastName,
+		}
+	}
+	return docs
+}
+
+func eligibilityOverrideFromEntity(override *entity.EligibilityOverride) (*EligibilityOverrideDoc, error) {
+	if override == nil {
+		return nil, nil
+	}
+	adminID, err := primitive.ObjectIDFromHex(override.AdminID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid admin ID: %w", err)
+	}
+	return &EligibilityOverrideDoc{
+		AdminID:    adminID,
+		Reason:     override.Reason,
+		Violations: override.Violations,
+		CreatedAt:  primitive.NewDateTimeFromTime(override.CreatedAt),
+	}, nil
+}
+
 // toEntity converts MongoDB document to domain entity
 func (doc *RegistrationDocument) toEntity() *entity.Registration {
 	participants := make([]entity.Participant, len(doc.Participants))
 	for i, p := range doc.Participants {
-		participants[i] = entity.Participant{
-			FirstName: p.FirstName,
-			LastName:  p.LastName,
+		participants[i] = p.toEntity()
+	}
+
+	var override *entity.EligibilityOverride
+	if doc.EligibilityOverride != nil {
+		override = &entity.EligibilityOverride{
+			AdminID:    doc.EligibilityOverride.AdminID.Hex(),
+			Reason:     doc.EligibilityOverride.Reason,
+			Violations: doc.EligibilityOverride.Violations,
+			CreatedAt:  doc.EligibilityOverride.CreatedAt.Time(),
 		}
 	}
 
-	var coach *entity.Coach
-	if doc.Coach != nil {
-		coach = &entity.Coach{
-			FirstName: doc.Coach.FirstName,
-			LastName:  doc.Coach.LastName,
+	var paidAt *time.Time
+	if doc.PaidAt != nil {
+		t := doc.PaidAt.Time()
+		paidAt = &t
+	}
+
+	coachDocs := doc.Coaches
+	if len(coachDocs) == 0 && doc.Coach != nil {
+		coachDocs = []CoachDoc{*doc.Coach}
+	}
+	coaches := make([]entity.Coach, len(coachDocs))
+	for i, c := range coachDocs {
+		coaches[i] = entity.Coach{
+			FirstName: c.FirstName,
+			LastName:  c.LastName,
 		}
 	}
 
+	deletedAt, deletedBy := deletionFromDoc(doc.DeletedAt, doc.DeletedBy)
+
 	return &entity.Registration{
 		ID:            doc.ID.Hex(),
 		CompetitionID: doc.CompetitionID.Hex(),
@@ -74,9 +185,15 @@ func (doc *RegistrationDocument) toEntity() *entity.Registration {
 		Type:          entity.RegistrationType(doc.Type),
 		TeamName:      doc.TeamName,
 		Participants:  participants,
-		Coach:         coach,
+		Coaches:       coaches,
+		Sector:        doc.Sector,
+		Peg:           doc.Peg,
+		EligibilityOverride: override,
+		PaidAt:        paidAt,
 		CreatedAt:     doc.CreatedAt.Time(),
 		UpdatedAt:     doc.UpdatedAt.Time(),
+		DeletedAt:     deletedAt,
+		DeletedBy:     deletedBy,
 	}
 }
 
@@ -92,22 +209,15 @@ func (r *RegistrationRepository) Create(ctx context.Context, reg *entity.Registr
 		return "", fmt.Errorf("invalid user ID: %w", err)
 	}
 
-	participants := make([]ParticipantDoc, len(reg.Participants))
-	for i, p := range reg.Participants {
-		participants[i] = ParticipantDoc{
-			FirstName: p.FirstName,
-			LastName:  p.LastName,
-		}
-	}
+	participants := participantsFromEntity(reg.Participants)
 
-	var coach *CoachDoc
-	if reg.Coach != nil {
-		coach = &CoachDoc{
-			FirstName: reg.Coach.FirstName,
-			LastName:  reg.Coach.LastName,
-		}
+	override, err := eligibilityOverrideFromEntity(reg.EligibilityOverride)
+	if err != nil {
+		return "", err
 	}
 
+	coaches := coachesFromEntity(reg.Coaches)
+
 	doc := RegistrationDocument{
 		ID:            primitive.NewObjectID(),
 		CompetitionID: competitionID,
@@ -115,7 +225,10 @@ func (r *RegistrationRepository) Create(ctx context.Context, reg *entity.Registr
 		Type:          string(reg.Type),
 		TeamName:      reg.TeamName,
 		Participants:  participants,
-		Coach:         coach,
+		Coaches:       coaches,
+		Sector:        reg.Sector,
+		Peg:           reg.Peg,
+		EligibilityOverride: override,
 		CreatedAt:     primitive.NewDateTimeFromTime(reg.CreatedAt),
 		UpdatedAt:     primitive.NewDateTimeFromTime(reg.UpdatedAt),
 	}
@@ -132,7 +245,7 @@ func (r *RegistrationRepository) Create(ctx context.Context, reg *entity.Registr
 	return oid.Hex(), nil
 }
 
-// FindByID finds a registration by ID
+// FindByID finds a registration by ID (registrations in the trash are not found)
 func (r *RegistrationRepository) FindByID(ctx context.Context, id string) (*entity.Registration, error) {
 	objID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
@@ -140,7 +253,7 @@ func (r *RegistrationRepository) FindByID(ctx context.Context, id string) (*enti
 	}
 
 	var doc RegistrationDocument
-	err = r.db.Collection("registrations").FindOne(ctx, bson.M{"_id": objID}).Decode(&doc)
+	err = r.db.Collection("registrations").FindOne(ctx, bson.M{"_id": objID, "deletedAt": nil}).Decode(&doc)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			return nil, fmt.Errorf("registration not found")
@@ -158,22 +271,7 @@ func (r *RegistrationRepository) FindByCompetitionID(ctx context.Context, compet
 		return nil, fmt.Errorf("invalid competition ID: %w", err)
 	}
 
-	cursor, err := r.db.Collection("registrations").Find(ctx, bson.M{"competitionId": objID})
-	if err != nil {
-		return nil, fmt.Errorf("failed to find registrations: %w", err)
-	}
-	defer cursor.Close(ctx)
-
-	var registrations []*entity.Registration
-	for cursor.Next(ctx) {
-		var doc RegistrationDocument
-		if err := cursor.Decode(&doc); err != nil {
-			continue
-		}
-		registrations = append(registrations, doc.toEntity())
-	}
-
-	return registrations, nil
+	return r.find(ctx, bson.M{"competitionId": objID, "deletedAt": nil})
 }
 
 // FindByUserID finds all registrations by a user
@@ -183,7 +281,16 @@ func (r *RegistrationRepository) FindByUserID(ctx context.Context, userID string
 		return nil, fmt.Errorf("invalid user ID: %w", err)
 	}
 
-	cursor, err := r.db.Collection("registrations").Find(ctx, bson.M{"userId": objID})
+	return r.find(ctx, bson.M{"userId": objID, "deletedAt": nil})
+}
+
+// FindDeleted finds registrations in the trash, most recently deleted first
+func (r *RegistrationRepository) FindDeleted(ctx context.Context) ([]*entity.Registration, error) {
+	return r.find(ctx, inTrash, trashSort)
+}
+
+func (r *RegistrationRepository) find(ctx context.Context, filter bson.M, opts ...*options.FindOptions) ([]*entity.Registration, error) {
+	cursor, err := r.db.Collection("registrations").Find(ctx, filter, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find registrations: %w", err)
 	}
@@ -217,6 +324,7 @@ func (r *RegistrationRepository) FindByCompetitionAndUser(ctx context.Context, c
 	err = r.db.Collection("registrations").FindOne(ctx, bson.M{
 		"competitionId": compID,
 		"userId":        usrID,
+		"deletedAt":     nil,
 	}).Decode(&doc)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
@@ -235,28 +343,23 @@ func (r *RegistrationRepository) Update(ctx context.Context, id string, reg *ent
 		return fmt.Errorf("invalid ID: %w", err)
 	}
 
-	participants := make([]ParticipantDoc, len(reg.Participants))
-	for i, p := range reg.Participants {
-		participants[i] = ParticipantDoc{
-			FirstName: p.FirstName,
-			LastName:  p.LastName,
-		}
-	}
+	participants := participantsFromEntity(reg.Participants)
 
-	var coach *CoachDoc
-	if reg.Coach != nil {
-		coach = &CoachDoc{
-			FirstName: reg.Coach.FirstName,
-			LastName:  reg.Coach.LastName,
-		}
+	override, err := eligibilityOverrideFromEntity(reg.EligibilityOverride)
+	if err != nil {
+		return err
 	}
 
+	coaches := coachesFromEntity(reg.Coaches)
+
 	update := bson.M{
 		"$set": bson.M{
 			"type":        string(reg.Type),
 			"teamName":    reg.TeamName,
 			"participants": participants,
-			"coach":       coach,
+			"coach":       nil,
+			"coaches":     coaches,
+			"eligibilityOverride": override,
 			"updatedAt":   primitive.NewDateTimeFromTime(reg.UpdatedAt),
 		},
 	}
@@ -273,7 +376,76 @@ func (r *RegistrationRepository) Update(ctx context.Context, id string, reg *ent
 	return nil
 }
 
-// Delete deletes a registration
+// AssignSector sets (or clears with nil) the sector and peg of a registration
+func (r *RegistrationRepository) AssignSector(ctx context.Context, id string, sector *string, peg *int) error {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid ID: %w", err)
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"sector":    sector,
+			"peg":       peg,
+			"updatedAt": primitive.NewDateTimeFromTime(time.Now()),
+		},
+	}
+
+	result, err := r.db.Collection("registrations").UpdateOne(ctx, bson.M{"_id": objID}, update)
+	if err != nil {
+		return fmt.Errorf("failed to assign sector: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("registration not found")
+	}
+
+	return nil
+}
+
+// SetPaid sets (or clears with nil) the fee payment time of a registration
+func (r *RegistrationRepository) SetPaid(ctx context.Context, id string, paidAt *time.Time) error {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid ID: %w", err)
+	}
+
+	var paid *primitive.DateTime
+	if paidAt != nil {
+		dt := primitive.NewDateTimeFromTime(*paidAt)
+		paid = &dt
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"paidAt":    paid,
+			"updatedAt": primitive.NewDateTimeFromTime(time.Now()),
+		},
+	}
+
+	result, err := r.db.Collection("registrations").UpdateOne(ctx, bson.M{"_id": objID}, update)
+	if err != nil {
+		return fmt.Errorf("failed to update payment: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("registration not found")
+	}
+
+	return nil
+}
+
+// SoftDelete moves a registration to the trash
+func (r *RegistrationRepository) SoftDelete(ctx context.Context, id string, deletedBy string) error {
+	return softDelete(ctx, r.db.Collection("registrations"), id, deletedBy)
+}
+
+// Restore takes a registration out of the trash
+func (r *RegistrationRepository) Restore(ctx context.Context, id string) error {
+	return restoreDeleted(ctx, r.db.Collection("registrations"), id)
+}
+
+// Delete deletes a registration permanently
 func (r *RegistrationRepository) Delete(ctx context.Context, id string) error {
 	objID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
@@ -291,3 +463,30 @@ func (r *RegistrationRepository) Delete(ctx context.Context, id string) error {
 
 	return nil
 }
+
+// DeleteByCompetitionID deletes all registrations of a competition, including those in the trash
+func (r *RegistrationRepository) DeleteByCompetitionID(ctx context.Context, competitionID string) error {
+	return deleteByCompetitionID(ctx, r.db.Collection("registrations"), competitionID)
+}
+
+// Anonymize detaches registrations from their deleted user (results and standings are kept)
+func (r *RegistrationRepository) Anonymize(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	objIDs := make([]primitive.ObjectID, len(ids))
+	for i, id := range ids {
+		objID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return fmt.Errorf("invalid ID: %w", err)
+		}
+		objIDs[i] = objID
+	}
+
+	update := bson.M{"$set": bson.M{"userId": primitive.NilObjectID, "updatedAt": primitive.NewDateTimeFromTime(time.Now())}}
+	if _, err := r.db.Collection("registrations").UpdateMany(ctx, bson.M{"_id": bson.M{"$in": objIDs}}, update); err != nil {
+		return fmt.Errorf("failed to anonymize registrations: %w", err)
+	}
+	return nil
+}
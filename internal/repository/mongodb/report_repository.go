@@ -3,6 +3,8 @@ package mongodb
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"sort"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -34,15 +36,82 @@ type ReportDocument struct {
 	AuthorID  primitive.ObjectID `bson:"authorId"`
 	Title     string             `bson:"title"`
 	Text      string             `bson:"text"`
-	Photos    bson.A             `bson:"photos"`
+	Photos    []ReportPhotoDocument `bson:"photos"`
+	CompetitionID *primitive.ObjectID `bson:"competitionId,omitempty"`
+	Tour          *int                `bson:"tour,omitempty"`
+	Tags          []string            `bson:"tags,omitempty"`
+	Category      string              `bson:"category,omitempty"`
+	Status      string              `bson:"status,omitempty"` // Missing in reports created before drafts existed: published
+	PublishAt   *primitive.DateTime `bson:"publishAt,omitempty"`
+	PublishedAt *primitive.DateTime `bson:"publishedAt,omitempty"`
 	CreatedAt primitive.DateTime `bson:"createdAt"`
 	UpdatedAt primitive.DateTime `bson:"updatedAt"`
+	DeletedAt *primitive.DateTime `bson:"deletedAt,omitempty"`
+	DeletedBy *primitive.ObjectID `bson:"deletedBy,omitempty"`
+}
+
+// ReportPhotoDocument is a photo embedded in a report document
+// Photos stored before they had IDs are given one by AssignReportPhotoIDs
+type ReportPhotoDocument struct {
+	ID           primitive.ObjectID  `bson:"id"`
+	ContentType  string              `bson:"contentType"`
+	Data         []byte              `bson:"data,omitempty"`
+	Caption      string              `bson:"caption,omitempty"`
+	Photographer string              `bson:"photographer,omitempty"`
+	Position     int                 `bson:"position"`
+	Size         int64               `bson:"size,omitempty"`       // Set by InitStorageUsage for photos stored before quotas
+	UploadedBy   *primitive.ObjectID `bson:"uploadedBy,omitempty"` // The report author for photos stored before quotas
+	CreatedAt    primitive.DateTime  `bson:"createdAt,omitempty"`
+}
+
+// published matches reports visible to everyone: not drafts, not scheduled, not in the trash
+var published = bson.M{
+	"deletedAt": nil,
+	"status":    bson.M{"$nin": bson.A{string(entity.ReportStatusDraft), string(entity.ReportStatusScheduled)}},
+}
+
+// unpublished matches drafts and scheduled reports that are not in the trash
+var unpublished = bson.M{
+	"deletedAt": nil,
+	"status":    bson.M{"$in": bson.A{string(entity.ReportStatusDraft), string(entity.ReportStatusScheduled)}},
 }
 
 // toEntity converts MongoDB document to domain entity
 func (doc *ReportDocument) toEntity() *entity.Report {
-	photos := make([]interface{}, len(doc.Photos))
-	copy(photos, doc.Photos)
+	photos := make([]entity.ReportPhoto, len(doc.Photos))
+	for i, photo := range doc.Photos {
+		photos[i] = photo.toEntity()
+	}
+	// Photos stored before positions existed all have position 0 and keep the array order
+	sort.SliceStable(photos, func(i, j int) bool { return photos[i].Position < photos[j].Position })
+	deletedAt, deletedBy := deletionFromDoc(doc.DeletedAt, doc.DeletedBy)
+	
+	status := entity.ReportStatus(doc.Status)
+	if status == "" {
+		status = entity.ReportStatusPublished
+	}
+	var publishAt, publishedAt *time.Time
+	if doc.PublishAt != nil {
+		t := doc.PublishAt.Time()
+		publishAt = &t
+	}
+	if doc.PublishedAt != nil {
+		t := doc.PublishedAt.Time()
+		publishedAt = &t
+	} else if status == entity.ReportStatusPublished {
+		t := doc.CreatedAt.Time()
+		publishedAt = &t
+	}
+	var competitionID *string
+	if doc.CompetitionID != nil {
+		id := doc.CompetitionID.Hex()
+		competitionID = &id
+	}
+	var category *entity.ReportCategory
+	if doc.Category != "" {
+		c := entity.ReportCategory(doc.Category)
+		category = &c
+	}
 	
 	return &entity.Report{
 		ID:        doc.ID.Hex(),
@@ -50,11 +119,85 @@ func (doc *ReportDocument) toEntity() *entity.Report {
 		Title:     doc.Title,
 		Text:      doc.Text,
 		Photos:    photos,
+		CompetitionID: competitionID,
+		Tour:          doc.Tour,
+		Tags:          doc.Tags,
+		Category:      category,
+		Status:      status,
+		PublishAt:   publishAt,
+		PublishedAt: publishedAt,
 		CreatedAt: doc.CreatedAt.Time(),
 		UpdatedAt: doc.UpdatedAt.Time(),
+		DeletedAt: deletedAt,
+		DeletedBy: deletedBy,
 	}
 }
 
+// toEntity converts an embedded photo document to the domain entity
+func (doc *ReportPhotoDocument) toEntity() entity.ReportPhoto {
+	photo := entity.ReportPhoto{
+		ContentType:  doc.ContentType,
+		Data:         doc.Data,
+		Caption:      doc.Caption,
+		Photographer: doc.Photographer,
+		Position:     doc.Position,
+		Size:         doc.Size,
+		CreatedAt:    doc.CreatedAt.Time(),
+	}
+	if !doc.ID.IsZero() {
+		photo.ID = doc.ID.Hex()
+	}
+	if photo.Size == 0 {
+		photo.Size = int64(len(doc.Data))
+	}
+	if doc.UploadedBy != nil {
+		photo.UploadedBy = doc.UploadedBy.Hex()
+	}
+	return photo
+}
+
+// reportPhotosFromEntity converts photos to embedded documents; photos without an ID get a new one
+func reportPhotosFromEntity(photos []entity.ReportPhoto) ([]ReportPhotoDocument, error) {
+	docs := make([]ReportPhotoDocument, 0, len(photos))
+	for _, photo := range photos {
+		photoID := primitive.NewObjectID()
+		if photo.ID != "" {
+			var err error
+			if photoID, err = primitive.ObjectIDFromHex(photo.ID); err != nil {
+				return nil, fmt.Errorf("invalid photo ID: %w", err)
+			}
+		}
+		createdAt := photo.CreatedAt
+		if createdAt.IsZero() {
+			createdAt = time.Now()
+		}
+		size := photo.Size
+		if size == 0 {
+			size = int64(len(photo.Data))
+		}
+		var uploadedBy *primitive.ObjectID
+		if photo.UploadedBy != "" {
+			userID, err := primitive.ObjectIDFromHex(photo.UploadedBy)
+			if err != nil {
+				return nil, fmt.Errorf("invalid uploader ID: %w", err)
+			}
+			uploadedBy = &userID
+		}
+		docs = append(docs, ReportPhotoDocument{
+			ID:           photoID,
+			ContentType:  photo.ContentType,
+			Data:         photo.Data,
+			Caption:      photo.Caption,
+			Photographer: photo.Photographer,
+			Position:     photo.Position,
+			Size:         size,
+			UploadedBy:   uploadedBy,
+			CreatedAt:    primitive.NewDateTimeFromTime(createdAt),
+		})
+	}
+	return docs, nil
+}
+
 // fromEntity converts domain entity to MongoDB document
 func reportFromEntity(report *entity.Report) (*ReportDocument, error) {
 	reportID := primitive.NilObjectID
@@ -71,9 +214,9 @@ func reportFromEntity(report *entity.Report) (*ReportDocument, error) {
 		return nil, fmt.Errorf("invalid author ID: %w", err)
 	}
 	
-	photos := bson.A{}
-	for _, photo := range report.Photos {
-		photos = append(photos, photo)
+	photos, err := reportPhotosFromEntity(report.Photos)
+	if err != nil {
+		return nil, err
 	}
 	
 	now := primitive.NewDateTimeFromTime(time.Now())
@@ -86,12 +229,38 @@ func reportFromEntity(report *entity.Report) (*ReportDocument, error) {
 		updatedAt = primitive.NewDateTimeFromTime(report.UpdatedAt)
 	}
 	
+	status := report.Status
+	if status == "" {
+		status = entity.ReportStatusPublished
+	}
+	
+	var competitionID *primitive.ObjectID
+	if report.CompetitionID != nil {
+		objID, err := primitive.ObjectIDFromHex(*report.CompetitionID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid competition ID: %w", err)
+		}
+		competitionID = &objID
+	}
+	
+	var category string
+	if report.Category != nil {
+		category = string(*report.Category)
+	}
+	
 	return &ReportDocument{
 		ID:        reportID,
 		AuthorID:  authorID,
 		Title:     report.Title,
 		Text:      report.Text,
 		Photos:    photos,
+		CompetitionID: competitionID,
+		Tour:          report.Tour,
+		Tags:          report.Tags,
+		Category:      category,
+		Status:      string(status),
+		PublishAt:   optionalDateTime(report.PublishAt),
+		PublishedAt: optionalDateTime(report.PublishedAt),
 		CreatedAt: createdAt,
 		UpdatedAt: updatedAt,
 	}, nil
@@ -116,7 +285,7 @@ func (r *ReportRepository) Create(ctx context.Context, report *entity.Report) (s
 	return oid.Hex(), nil
 }
 
-// FindByID finds a report by ID
+// FindByID finds a report by ID (reports in the trash are not found)
 func (r *ReportRepository) FindByID(ctx context.Context, id string) (*entity.Report, error) {
 	reportID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
@@ -124,26 +293,112 @@ func (r *ReportRepository) FindByID(ctx context.Context, id string) (*entity.Rep
 	}
 	
 	var doc ReportDocument
-	err = r.db.Collection("reports").FindOne(ctx, bson.M{"_id": reportID}).Decode(&doc)
+	err = r.db.Collection("reports").FindOne(ctx, bson.M{"_id": reportID, "deletedAt": nil}).Decode(&doc)
 	if err != nil {
 		return nil, err
 	}
 	return doc.toEntity(), nil
 }
 
-// FindAll finds all reports with limit
+// FindByIDs finds reports by IDs (reports in the trash and unknown IDs are skipped)
+func (r *ReportRepository) FindByIDs(ctx context.Context, ids []string) ([]*entity.Report, error) {
+	objIDs, err := objectIDsFromHex(ids, "report")
+	if err != nil {
+		return nil, err
+	}
+	
+	cursor, err := r.db.Collection("reports").Find(ctx, bson.M{"_id": bson.M{"$in": objIDs}, "deletedAt": nil})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	
+	var docs []ReportDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	reports := make([]*entity.Report, len(docs))
+	for i, doc := range docs {
+		reports[i] = doc.toEntity()
+	}
+	return reports, nil
+}
+
+// FindAll finds published reports with limit
 func (r *ReportRepository) FindAll(ctx context.Context, limit int) ([]*entity.Report, error) {
+	return r.findNewest(ctx, published, limit)
+}
+
+// FindByAuthorID finds the newest published reports of an author with limit
+func (r *ReportRepository) FindByAuthorID(ctx context.Context, authorID string, limit int) ([]*entity.Report, error) {
+	objID, err := primitive.ObjectIDFromHex(authorID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid author ID: %w", err)
+	}
+	return r.findNewest(ctx, withFilter(published, bson.M{"authorId": objID}), limit)
+}
+
+// FindPublished finds the newest published reports matching the filter with limit
+func (r *ReportRepository) FindPublished(ctx context.Context, filter entity.ReportFilter, limit int) ([]*entity.Report, error) {
+	extra := bson.M{}
+	if filter.CompetitionID != nil {
+		objID, err := primitive.ObjectIDFromHex(*filter.CompetitionID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid competition ID: %w", err)
+		}
+		extra["competitionId"] = objID
+	}
+	if len(filter.Tags) > 0 {
+		extra["tags"] = bson.M{"$all": filter.Tags}
+	}
+	if filter.Category != nil {
+		extra["category"] = string(*filter.Category)
+	}
+	return r.findNewest(ctx, withFilter(published, extra), limit)
+}
+
+// FindPublishedModifications finds the update times of published reports, newest first
+// Only IDs and dates are loaded, photos stay in the database
+func (r *ReportRepository) FindPublishedModifications(ctx context.Context, limit int) ([]entity.Modification, error) {
+	opts := options.Find().
+		SetProjection(bson.M{"_id": 1, "updatedAt": 1}).
+		SetSort(bson.D{{Key: "updatedAt", Value: -1}}).
+		SetLimit(int64(limit))
+	cursor, err := r.db.Collection("reports").Find(ctx, published, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	
+	var docs []struct {
+		ID        primitive.ObjectID `bson:"_id"`
+		UpdatedAt primitive.DateTime `bson:"updatedAt"`
+	}
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	
+	modifications := make([]entity.Modification, len(docs))
+	for i, doc := range docs {
+		modifications[i] = entity.Modification{ID: doc.ID.Hex(), UpdatedAt: doc.UpdatedAt.Time()}
+	}
+	return modifications, nil
+}
+
+// CountTags counts published reports per tag, most used first; prefix limits the tags (may be empty)
+func (r *ReportRepository) CountTags(ctx context.Context, prefix string, limit int) ([]entity.TagCount, error) {
 	pipeline := mongo.Pipeline{
-		{{Key: "$sort", Value: bson.D{{Key: "createdAt", Value: -1}, {Key: "_id", Value: -1}}}},
-		{{Key: "$limit", Value: limit}},
-		{{Key: "$lookup", Value: bson.D{
-			{Key: "from", Value: "users"},
-			{Key: "localField", Value: "authorId"},
-			{Key: "foreignField", Value: "_id"},
-			{Key: "as", Value: "author"},
-		}}},
-		{{Key: "$unwind", Value: bson.D{{Key: "path", Value: "$author"}, {Key: "preserveNullAndEmptyArrays", Value: true}}}},
+		{{Key: "$match", Value: withFilter(published, bson.M{"tags.0": bson.M{"$exists": true}})}},
+		{{Key: "$unwind", Value: "$tags"}},
 	}
+	if prefix != "" {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.M{"tags": bson.M{"$regex": "^" + regexp.QuoteMeta(prefix)}}}})
+	}
+	pipeline = append(pipeline,
+		bson.D{{Key: "$group", Value: bson.M{"_id": "$tags", "count": bson.M{"$sum": 1}}}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}}},
+		bson.D{{Key: "$limit", Value: limit}},
+	)
 	
 	cursor, err := r.db.Collection("reports").Aggregate(ctx, pipeline)
 	if err != nil {
@@ -151,6 +406,42 @@ func (r *ReportRepository) FindAll(ctx context.Context, limit int) ([]*entity.Re
 	}
 	defer cursor.Close(ctx)
 	
+	var groups []struct {
+		Tag   string `bson:"_id"`
+		Count int    `bson:"count"`
+	}
+	if err := cursor.All(ctx, &groups); err != nil {
+		return nil, err
+	}
+	
+	tags := make([]entity.TagCount, len(groups))
+	for i, group := range groups {
+		tags[i] = entity.TagCount{Tag: group.Tag, Count: group.Count}
+	}
+	return tags, nil
+}
+
+// FindUnpublished finds drafts and scheduled reports, newest first; nil authorID means all authors
+func (r *ReportRepository) FindUnpublished(ctx context.Context, authorID *string, limit int) ([]*entity.Report, error) {
+	filter := unpublished
+	if authorID != nil {
+		objID, err := primitive.ObjectIDFromHex(*authorID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid author ID: %w", err)
+		}
+		filter = withFilter(unpublished, bson.M{"authorId": objID})
+	}
+	return r.findNewest(ctx, filter, limit)
+}
+
+// FindDeleted finds reports in the trash, most recently deleted first
+func (r *ReportRepository) FindDeleted(ctx context.Context) ([]*entity.Report, error) {
+	cursor, err := r.db.Collection("reports").Find(ctx, inTrash, trashSort)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	
 	var docs []ReportDocument
 	if err := cursor.All(ctx, &docs); err != nil {
 		return nil, err
@@ -163,7 +454,33 @@ func (r *ReportRepository) FindAll(ctx context.Context, limit int) ([]*entity.Re
 	return reports, nil
 }
 
-// Update updates a report
+// findNewest finds the newest reports matching the filter, without photo data
+// Lists show photos by URL and read their size, so the image bytes are left in the database
+func (r *ReportRepository) findNewest(ctx context.Context, filter bson.M, limit int) ([]*entity.Report, error) {
+	opts := options.Find().
+		SetProjection(bson.M{"photos.data": 0}).
+		SetSort(bson.D{{Key: "createdAt", Value: -1}, {Key: "_id", Value: -1}}).
+		SetLimit(int64(limit))
+	cursor, err := r.db.Collection("reports").Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	
+	var docs []ReportDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	
+	reports := make([]*entity.Report, len(docs))
+	for i, doc := range docs {
+		reports[i] = doc.toEntity()
+	}
+	return reports, nil
+}
+
+// Update updates the title, text, competition link, tags and category of a report
+// Photos are changed by the photo methods only, so concurrent edits of photos and text do not overwrite each other
 func (r *ReportRepository) Update(ctx context.Context, id string, report *entity.Report) error {
 	reportID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
@@ -176,17 +493,209 @@ func (r *ReportRepository) Update(ctx context.Context, id string, report *entity
 	}
 	
 	update := bson.M{
-		"title":     doc.Title,
-		"text":      doc.Text,
-		"photos":    doc.Photos,
-		"updatedAt": primitive.NewDateTimeFromTime(time.Now()),
+		"title":         doc.Title,
+		"text":          doc.Text,
+		"competitionId": doc.CompetitionID,
+		"tour":          doc.Tour,
+		"tags":          doc.Tags,
+		"category":      doc.Category,
+		"updatedAt":     primitive.NewDateTimeFromTime(time.Now()),
 	}
 	
 	_, err = r.db.Collection("reports").UpdateOne(ctx, bson.M{"_id": reportID}, bson.M{"$set": update})
 	return err
 }
 
-// Delete deletes a report
+// AddPhotos appends photos unless the report would have more than maxPhotos; false if it would
+// The limit is checked in the update filter, so concurrent uploads cannot exceed it
+func (r *ReportRepository) AddPhotos(ctx context.Context, id string, photos []entity.ReportPhoto, maxPhotos int) (bool, error) {
+	reportID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return false, fmt.Errorf("invalid report ID: %w", err)
+	}
+	if len(photos) > maxPhotos {
+		return false, nil
+	}
+	
+	docs, err := reportPhotosFromEntity(photos)
+	if err != nil {
+		return false, err
+	}
+	
+	filter := bson.M{"_id": reportID, "deletedAt": nil}
+	if len(photos) > 0 {
+		// The element at this index exists only if the report already has too many photos
+		filter[fmt.Sprintf("photos.%d", maxPhotos-len(photos))] = bson.M{"$exists": false}
+	}
+	result, err := r.db.Collection("reports").UpdateOne(ctx, filter, bson.M{
+		"$push": bson.M{"photos": bson.M{"$each": docs}},
+		"$set":  bson.M{"updatedAt": primitive.NewDateTimeFromTime(time.Now())},
+	})
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount > 0, nil
+}
+
+// RemovePhotos removes the photos with the IDs from a report; unknown IDs are ignored
+// Returns the removed photos without data, so that their storage is released once even if editors remove them together
+func (r *ReportRepository) RemovePhotos(ctx context.Context, id string, photoIDs []string) ([]entity.ReportPhoto, error) {
+	reportID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid report ID: %w", err)
+	}
+	
+	objIDs, err := photoObjectIDs(photoIDs)
+	if err != nil {
+		return nil, err
+	}
+	
+	opts := options.FindOneAndUpdate().
+		SetReturnDocument(options.Before).
+		SetProjection(bson.M{"photos.id": 1, "photos.size": 1, "photos.uploadedBy": 1})
+	var doc ReportDocument
+	err = r.db.Collection("reports").FindOneAndUpdate(ctx, bson.M{"_id": reportID}, bson.M{
+		"$pull": bson.M{"photos": bson.M{"id": bson.M{"$in": objIDs}}},
+		"$set":  bson.M{"updatedAt": primitive.NewDateTimeFromTime(time.Now())},
+	}, opts).Decode(&doc)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	
+	removed := make(map[primitive.ObjectID]bool, len(objIDs))
+	for _, objID := range objIDs {
+		removed[objID] = true
+	}
+	var photos []entity.ReportPhoto
+	for _, photo := range doc.Photos {
+		if removed[photo.ID] {
+			photos = append(photos, photo.toEntity())
+		}
+	}
+	return photos, nil
+}
+
+// UpdatePhoto updates the caption, photographer and position of a photo of a report, found by photo ID
+func (r *ReportRepository) UpdatePhoto(ctx context.Context, id string, photo entity.ReportPhoto) error {
+	reportID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid report ID: %w", err)
+	}
+	photoID, err := primitive.ObjectIDFromHex(photo.ID)
+	if err != nil {
+		return fmt.Errorf("invalid photo ID: %w", err)
+	}
+	
+	_, err = r.db.Collection("reports").UpdateOne(ctx, bson.M{"_id": reportID, "photos.id": photoID}, bson.M{
+		"$set": bson.M{
+			"photos.$.caption":      photo.Caption,
+			"photos.$.photographer": photo.Photographer,
+			"photos.$.position":     photo.Position,
+			"updatedAt":             primitive.NewDateTimeFromTime(time.Now()),
+		},
+	})
+	return err
+}
+
+// FindCompetitionPhotos finds published reports linked to a competition that have photos, oldest first
+// Photo data is not loaded
+func (r *ReportRepository) FindCompetitionPhotos(ctx context.Context, competitionID string) ([]*entity.Report, error) {
+	objID, err := primitive.ObjectIDFromHex(competitionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid competition ID: %w", err)
+	}
+	
+	filter := withFilter(published, bson.M{"competitionId": objID, "photos.0": bson.M{"$exists": true}})
+	opts := options.Find().
+		SetProjection(bson.M{"photos.data": 0, "text": 0}).
+		SetSort(bson.D{{Key: "createdAt", Value: 1}, {Key: "_id", Value: 1}})
+	cursor, err := r.db.Collection("reports").Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	
+	var docs []ReportDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	
+	reports := make([]*entity.Report, len(docs))
+	for i, doc := range docs {
+		reports[i] = doc.toEntity()
+	}
+	return reports, nil
+}
+
+// photoObjectIDs converts photo IDs to ObjectIDs
+func photoObjectIDs(photoIDs []string) ([]primitive.ObjectID, error) {
+	objIDs := make([]primitive.ObjectID, len(photoIDs))
+	for i, photoID := range photoIDs {
+		objID, err := primitive.ObjectIDFromHex(photoID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid photo ID: %w", err)
+		}
+		objIDs[i] = objID
+	}
+	return objIDs, nil
+}
+
+// SetPublication updates the status and publication times of a report
+func (r *ReportRepository) SetPublication(ctx context.Context, id string, status entity.ReportStatus, publishAt, publishedAt *time.Time) error {
+	reportID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid report ID: %w", err)
+	}
+	
+	update := bson.M{"$set": bson.M{
+		"status":      string(status),
+		"publishAt":   optionalDateTime(publishAt),
+		"publishedAt": optionalDateTime(publishedAt),
+		"updatedAt":   primitive.NewDateTimeFromTime(time.Now()),
+	}}
+	result, err := r.db.Collection("reports").UpdateOne(ctx, bson.M{"_id": reportID, "deletedAt": nil}, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("report not found")
+	}
+	return nil
+}
+
+// PublishDue publishes scheduled reports whose publication time has come; publishedAt is set to the scheduled time
+func (r *ReportRepository) PublishDue(ctx context.Context, now time.Time) (int64, error) {
+	filter := bson.M{
+		"status":    string(entity.ReportStatusScheduled),
+		"publishAt": bson.M{"$lte": primitive.NewDateTimeFromTime(now)},
+		"deletedAt": nil,
+	}
+	update := bson.A{bson.M{"$set": bson.M{
+		"status":      string(entity.ReportStatusPublished),
+		"publishedAt": "$publishAt",
+	}}}
+	
+	result, err := r.db.Collection("reports").UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
+
+// SoftDelete moves a report to the trash
+func (r *ReportRepository) SoftDelete(ctx context.Context, id string, deletedBy string) error {
+	return softDelete(ctx, r.db.Collection("reports"), id, deletedBy)
+}
+
+// Restore takes a report out of the trash
+func (r *ReportRepository) Restore(ctx context.Context, id string) error {
+	return restoreDeleted(ctx, r.db.Collection("reports"), id)
+}
+
+// Delete deletes a report permanently
 func (r *ReportRepository) Delete(ctx context.Context, id string) error {
 	reportID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
@@ -197,7 +706,7 @@ func (r *ReportRepository) Delete(ctx context.Context, id string) error {
 	return err
 }
 
-// GetAuthorID gets author ID of a report
+// GetAuthorID gets author ID of a report (reports in the trash are not found)
 func (r *ReportRepository) GetAuthorID(ctx context.Context, id string) (string, error) {
 	reportID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
@@ -207,9 +716,60 @@ func (r *ReportRepository) GetAuthorID(ctx context.Context, id string) (string,
 	var result struct {
 		AuthorID primitive.ObjectID `bson:"authorId"`
 	}
-	err = r.db.Collection("reports").FindOne(ctx, bson.M{"_id": reportID}, options.FindOne().SetProjection(bson.M{"authorId": 1})).Decode(&result)
+	err = r.db.Collection("reports").FindOne(ctx, bson.M{"_id": reportID, "deletedAt": nil}, options.FindOne().SetProjection(bson.M{"authorId": 1})).Decode(&result)
 	if err != nil {
 		return "", err
 	}
 	return result.AuthorID.Hex(), nil
 }
+
+// CountByAuthorID counts reports of an author, including those in the trash
+func (r *ReportRepository) CountByAuthorID(ctx context.Context, authorID string) (int64, error) {
+	objID, err := primitive.ObjectIDFromHex(authorID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid author ID: %w", err)
+	}
+	return r.db.Collection("reports").CountDocuments(ctx, bson.M{"authorId": objID})
+}
+
+// UnlinkCompetition removes the competition and tour reference from all reports about a competition
+func (r *ReportRepository) UnlinkCompetition(ctx context.Context, competitionID string) error {
+	objID, err := primitive.ObjectIDFromHex(competitionID)
+	if err != nil {
+		return fmt.Errorf("invalid competition ID: %w", err)
+	}
+	
+	_, err = r.db.Collection("reports").UpdateMany(ctx, bson.M{"competitionId": objID}, bson.M{"$unset": bson.M{"competitionId": "", "tour": ""}})
+	return err
+}
+
+// AnonymizeAuthor replaces the author of all reports of a deleted user with entity.DeletedUserID
+func (r *ReportRepository) AnonymizeAuthor(ctx context.Context, authorID string) error {
+	objID, err := primitive.ObjectIDFromHex(authorID)
+	if err != nil {
+		return fmt.Errorf("invalid author ID: %w", err)
+	}
+	
+	_, err = r.db.Collection("reports").UpdateMany(ctx, bson.M{"authorId": objID}, bson.M{"$set": bson.M{"authorId": primitive.NilObjectID}})
+	return err
+}
+
+// withFilter returns a copy of the base filter extended with extra conditions
+func withFilter(base, extra bson.M) bson.M {
+	filter := bson.M{}
+	for k, v := range base {
+		filter[k] = v
+	}
+	for k, v := range extra {
+		filter[k] = v
+	}
+	return filter
+}
+
+func optionalDateTime(t *time.Time) *primitive.DateTime {
+	if t == nil {
+		return nil
+	}
+	dt := primitive.NewDateTimeFromTime(*t)
+	return &dt
+}
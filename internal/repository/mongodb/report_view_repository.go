@@ -0,0 +1,85 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/cnpf/feeder-backend/internal/repository/interface"
+)
+
+// ReportViewRepository handles report view database operations
+// Implements repository.ReportViewRepository interface
+type ReportViewRepository struct {
+	db *mongo.Database
+}
+
+// NewReportViewRepository creates a new report view repository
+func NewReportViewRepository(db *mongo.Database) repository.ReportViewRepository {
+	return &ReportViewRepository{db: db}
+}
+
+// Ensure ReportViewRepository implements repository.ReportViewRepository interface
+var _ repository.ReportViewRepository = (*ReportViewRepository)(nil)
+
+// ReportViewDocument represents a report view document in MongoDB
+// Viewer is an opaque key of the viewer, day is the UTC date of the view (YYYY-MM-DD)
+type ReportViewDocument struct {
+	ID        primitive.ObjectID `bson:"_id"`
+	ReportID  primitive.ObjectID `bson:"reportId"`
+	Viewer    string             `bson:"viewer"`
+	Day       string             `bson:"day"`
+	CreatedAt primitive.DateTime `bson:"createdAt"`
+}
+
+// Record records a view of a report by the viewer; returns false if the viewer has already been counted that day
+func (r *ReportViewRepository) Record(ctx context.Context, reportID, viewerKey string, at time.Time) (bool, error) {
+	objID, err := primitive.ObjectIDFromHex(reportID)
+	if err != nil {
+		return false, fmt.Errorf("invalid report ID: %w", err)
+	}
+
+	filter := bson.M{"reportId": objID, "viewer": viewerKey, "day": at.UTC().Format("2006-01-02")}
+	update := bson.M{"$setOnInsert": bson.M{
+		"_id":       primitive.NewObjectID(),
+		"createdAt": primitive.NewDateTimeFromTime(at),
+	}}
+	result, err := r.db.Collection("reportViews").UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		// A concurrent view of the same viewer won the unique index
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to record report view: %w", err)
+	}
+	return result.UpsertedCount > 0, nil
+}
+
+// CountByReportIDs counts views by report ID
+func (r *ReportViewRepository) CountByReportIDs(ctx context.Context, reportIDs []string) (map[string]int64, error) {
+	objIDs, err := objectIDsFromHex(reportIDs, "report")
+	if err != nil {
+		return nil, err
+	}
+	return countPerReport(ctx, r.db.Collection("reportViews"), bson.M{"reportId": bson.M{"$in": objIDs}})
+}
+
+// CountSince counts views since the given time, by report ID
+func (r *ReportViewRepository) CountSince(ctx context.Context, since time.Time) (map[string]int64, error) {
+	return countPerReport(ctx, r.db.Collection("reportViews"), bson.M{"createdAt": bson.M{"$gte": primitive.NewDateTimeFromTime(since)}})
+}
+
+// DeleteByReportID deletes all views of a report
+func (r *ReportViewRepository) DeleteByReportID(ctx context.Context, reportID string) error {
+	objID, err := primitive.ObjectIDFromHex(reportID)
+	if err != nil {
+		return fmt.Errorf("invalid report ID: %w", err)
+	}
+	_, err = r.db.Collection("reportViews").DeleteMany(ctx, bson.M{"reportId": objID})
+	return err
+}
@@ -0,0 +1,172 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	"github.com/cnpf/feeder-backend/internal/repository/interface"
+)
+
+// ResultRepository handles tour result database operations
+// Implements repository.ResultRepository interface
+type ResultRepository struct {
+	db *mongo.Database
+}
+
+// NewResultRepository creates a new result repository
+func NewResultRepository(db *mongo.Database) repository.ResultRepository {
+	return &ResultRepository{db: db}
+}
+
+// Ensure ResultRepository implements repository.ResultRepository interface
+var _ repository.ResultRepository = (*ResultRepository)(nil)
+
+// ResultDocument represents a tour result document in MongoDB
+type ResultDocument struct {
+	ID             primitive.ObjectID `bson:"_id"`
+	CompetitionID  primitive.ObjectID `bson:"competitionId"`
+	RegistrationID primitive.ObjectID `bson:"registrationId"`
+	Tour           int                `bson:"tour"`
+	Weight         int                `bson:"weight"`
+	FishCount      int                `bson:"fishCount"`
+	CreatedAt      primitive.DateTime `bson:"createdAt"`
+	UpdatedAt      primitive.DateTime `bson:"updatedAt"`
+}
+
+// toEntity converts MongoDB document to domain entity
+func (doc *ResultDocument) toEntity() *entity.TourResult {
+	return &entity.TourResult{
+		ID:             doc.ID.Hex(),
+		CompetitionID:  doc.CompetitionID.Hex(),
+		RegistrationID: doc.RegistrationID.Hex(),
+		Tour:           doc.Tour,
+		Weight:         doc.Weight,
+		FishCount:      doc.FishCount,
+		CreatedAt:      doc.CreatedAt.Time(),
+		UpdatedAt:      doc.UpdatedAt.Time(),
+	}
+}
+
+// Upsert creates or replaces the result of a registration in a tour
+// (registrationId, tour) is unique, see EnsureIndexes
+func (r *ResultRepository) Upsert(ctx context.Context, result *entity.TourResult) (string, error) {
+	competitionID, err := primitive.ObjectIDFromHex(result.CompetitionID)
+	if err != nil {
+		return "", fmt.Errorf("invalid competition ID: %w", err)
+	}
+
+	registrationID, err := primitive.ObjectIDFromHex(result.RegistrationID)
+	if err != nil {
+		return "", fmt.Errorf("invalid registration ID: %w", err)
+	}
+
+	now := primitive.NewDateTimeFromTime(time.Now())
+	filter := bson.M{"registrationId": registrationID, "tour": result.Tour}
+	update := bson.M{
+		"$set": bson.M{
+			"competitionId": competitionID,
+			"weight":        result.Weight,
+			"fishCount":     result.FishCount,
+			"updatedAt":     now,
+		},
+		"$setOnInsert": bson.M{
+			"_id":       primitive.NewObjectID(),
+			"createdAt": now,
+		},
+	}
+
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	var doc ResultDocument
+	err = r.db.Collection("results").FindOneAndUpdate(ctx, filter, update, opts).Decode(&doc)
+	if err != nil {
+		return "", fmt.Errorf("failed to save result: %w", err)
+	}
+
+	return doc.ID.Hex(), nil
+}
+
+// FindByID finds a result by ID
+func (r *ResultRepository) FindByID(ctx context.Context, id string) (*entity.TourResult, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID: %w", err)
+	}
+
+	var doc ResultDocument
+	err = r.db.Collection("results").FindOne(ctx, bson.M{"_id": objID}).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("result not found")
+		}
+		return nil, fmt.Errorf("failed to find result: %w", err)
+	}
+
+	return doc.toEntity(), nil
+}
+
+// FindByCompetitionID finds all results of a competition ordered by tour
+func (r *ResultRepository) FindByCompetitionID(ctx context.Context, competitionID string) ([]*entity.TourResult, error) {
+	objID, err := primitive.ObjectIDFromHex(competitionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid competition ID: %w", err)
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "tour", Value: 1}})
+	cursor, err := r.db.Collection("results").Find(ctx, bson.M{"competitionId": objID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find results: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []ResultDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	results := make([]*entity.TourResult, len(docs))
+	for i, doc := range docs {
+		results[i] = doc.toEntity()
+	}
+	return results, nil
+}
+
+// Delete deletes a result
+func (r *ResultRepository) Delete(ctx context.Context, id string) error {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid ID: %w", err)
+	}
+
+	result, err := r.db.Collection("results").DeleteOne(ctx, bson.M{"_id": objID})
+	if err != nil {
+		return fmt.Errorf("failed to delete result: %w", err)
+	}
+
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("result not found")
+	}
+
+	return nil
+}
+
+// DeleteByCompetitionID deletes all results of a competition
+func (r *ResultRepository) DeleteByCompetitionID(ctx context.Context, competitionID string) error {
+	return deleteByCompetitionID(ctx, r.db.Collection("results"), competitionID)
+}
+
+// DeleteByRegistrationID deletes all results of a registration
+func (r *ResultRepository) DeleteByRegistrationID(ctx context.Context, registrationID string) error {
+	return deleteByRegistrationID(ctx, r.db.Collection("results"), registrationID)
+}
+
+// CountByRegistrationIDs counts results of the registrations
+func (r *ResultRepository) CountByRegistrationIDs(ctx context.Context, registrationIDs []string) (int64, error) {
+	return countByRegistrationIDs(ctx, r.db.Collection("results"), registrationIDs)
+}
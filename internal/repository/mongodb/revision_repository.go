@@ -0,0 +1,185 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	"github.com/cnpf/feeder-backend/internal/repository/interface"
+)
+
+// RevisionRepository handles revision history database operations
+// Implements repository.RevisionRepository interface
+type RevisionRepository struct {
+	db *mongo.Database
+}
+
+// NewRevisionRepository creates a new revision repository
+func NewRevisionRepository(db *mongo.Database) repository.RevisionRepository {
+	return &RevisionRepository{db: db}
+}
+
+// Ensure RevisionRepository implements repository.RevisionRepository interface
+var _ repository.RevisionRepository = (*RevisionRepository)(nil)
+
+// RevisionDocument represents a revision document in MongoDB; the snapshot uses the entity document layout
+type RevisionDocument struct {
+	ID          primitive.ObjectID   `bson:"_id"`
+	EntityType  string               `bson:"entityType"`
+	EntityID    primitive.ObjectID   `bson:"entityId"`
+	Version     int                  `bson:"version"`
+	EditorID    primitive.ObjectID   `bson:"editorId"`
+	Report      *ReportDocument      `bson:"report,omitempty"`
+	Competition *CompetitionDocument `bson:"competition,omitempty"`
+	Changes     []FieldChangeDoc     `bson:"changes"`
+	CreatedAt   primitive.DateTime   `bson:"createdAt"`
+}
+
+// FieldChangeDoc represents a changed field of a revision
+type FieldChangeDoc struct {
+	Field    string  `bson:"field"`
+	OldValue *string `bson:"oldValue,omitempty"`
+	NewValue *string `bson:"newValue,omitempty"`
+}
+
+// toEntity converts MongoDB document to domain entity
+func (doc *RevisionDocument) toEntity() *entity.Revision {
+	changes := make([]entity.FieldChange, len(doc.Changes))
+	for i, c := range doc.Changes {
+		changes[i] = entity.FieldChange{
+			Field:    c.Field,
+			OldValue: c.OldValue,
+			NewValue: c.NewValue,
+		}
+	}
+
+	revision := &entity.Revision{
+		ID:         doc.ID.Hex(),
+		EntityType: entity.RevisionEntityType(doc.EntityType),
+		EntityID:   doc.EntityID.Hex(),
+		Version:    doc.Version,
+		EditorID:   doc.EditorID.Hex(),
+		Changes:    changes,
+		CreatedAt:  doc.CreatedAt.Time(),
+	}
+	if doc.Report != nil {
+		revision.Report = doc.Report.toEntity()
+	}
+	if doc.Competition != nil {
+		revision.Competition = doc.Competition.toEntity()
+	}
+	return revision
+}
+
+// Create stores a revision; the version is assigned by the caller
+func (r *RevisionRepository) Create(ctx context.Context, revision *entity.Revision) (string, error) {
+	entityID, err := primitive.ObjectIDFromHex(revision.EntityID)
+	if err != nil {
+		return "", fmt.Errorf("invalid entity ID: %w", err)
+	}
+	editorID, err := primitive.ObjectIDFromHex(revision.EditorID)
+	if err != nil {
+		return "", fmt.Errorf("invalid editor ID: %w", err)
+	}
+
+	changes := make([]FieldChangeDoc, len(revision.Changes))
+	for i, c := range revision.Changes {
+		changes[i] = FieldChangeDoc{
+			Field:    c.Field,
+			OldValue: c.OldValue,
+			NewValue: c.NewValue,
+		}
+	}
+
+	doc := RevisionDocument{
+		ID:         primitive.NewObjectID(),
+		EntityType: string(revision.EntityType),
+		EntityID:   entityID,
+		Version:    revision.Version,
+		EditorID:   editorID,
+		Changes:    changes,
+		CreatedAt:  primitive.NewDateTimeFromTime(revision.CreatedAt),
+	}
+	if revision.Report != nil {
+		if doc.Report, err = reportFromEntity(revision.Report); err != nil {
+			return "", err
+		}
+	}
+	if revision.Competition != nil {
+		if doc.Competition, err = competitionFromEntity(revision.Competition); err != nil {
+			return "", err
+		}
+	}
+
+	if _, err := r.db.Collection("revisions").InsertOne(ctx, doc); err != nil {
+		return "", fmt.Errorf("failed to create revision: %w", err)
+	}
+	return doc.ID.Hex(), nil
+}
+
+// FindByID finds a revision by ID
+func (r *RevisionRepository) FindByID(ctx context.Context, id string) (*entity.Revision, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID: %w", err)
+	}
+
+	var doc RevisionDocument
+	if err := r.db.Collection("revisions").FindOne(ctx, bson.M{"_id": objID}).Decode(&doc); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("revision not found")
+		}
+		return nil, fmt.Errorf("failed to find revision: %w", err)
+	}
+	return doc.toEntity(), nil
+}
+
+// FindByEntityID finds the revisions of an entity, newest first
+func (r *RevisionRepository) FindByEntityID(ctx context.Context, entityID string) ([]*entity.Revision, error) {
+	objID, err := primitive.ObjectIDFromHex(entityID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid entity ID: %w", err)
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "version", Value: -1}})
+	cursor, err := r.db.Collection("revisions").Find(ctx, bson.M{"entityId": objID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []RevisionDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	revisions := make([]*entity.Revision, len(docs))
+	for i, doc := range docs {
+		revisions[i] = doc.toEntity()
+	}
+	return revisions, nil
+}
+
+// CountByEntityID counts the revisions of an entity
+func (r *RevisionRepository) CountByEntityID(ctx context.Context, entityID string) (int64, error) {
+	objID, err := primitive.ObjectIDFromHex(entityID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid entity ID: %w", err)
+	}
+	return r.db.Collection("revisions").CountDocuments(ctx, bson.M{"entityId": objID})
+}
+
+// DeleteByEntityID deletes the revisions of an entity
+func (r *RevisionRepository) DeleteByEntityID(ctx context.Context, entityID string) error {
+	objID, err := primitive.ObjectIDFromHex(entityID)
+	if err != nil {
+		return fmt.Errorf("invalid entity ID: %w", err)
+	}
+	_, err = r.db.Collection("revisions").DeleteMany(ctx, bson.M{"entityId": objID})
+	return err
+}
@@ -0,0 +1,61 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/cnpf/feeder-backend/internal/repository/interface"
+)
+
+// TxManager runs repository operations in MongoDB multi-document transactions
+// Implements repository.TxManager interface
+type TxManager struct {
+	db        *mongo.Database
+	supported bool
+}
+
+// NewTxManager creates a new transaction manager, detecting once whether the server supports transactions
+// (a replica set member or mongos); fails if the topology cannot be detected
+func NewTxManager(ctx context.Context, db *mongo.Database) (repository.TxManager, error) {
+	var hello bson.M
+	if err := db.RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).Decode(&hello); err != nil {
+		return nil, fmt.Errorf("failed to detect MongoDB topology: %w", err)
+	}
+	_, isReplicaSet := hello["setName"]
+	m := &TxManager{db: db, supported: isReplicaSet || hello["msg"] == "isdbgrid"}
+	if !m.supported {
+		log.Println("MongoDB is a standalone server: transactions are disabled, multi-document writes are not atomic")
+	}
+	return m, nil
+}
+
+// Ensure TxManager implements repository.TxManager interface
+var _ repository.TxManager = (*TxManager)(nil)
+
+// Supported reports whether WithTransaction runs fn in a real transaction
+func (m *TxManager) Supported() bool {
+	return m.supported
+}
+
+// WithTransaction runs fn in a transaction
+// Transactions require a replica set or sharded cluster; on a standalone server fn runs without a transaction
+func (m *TxManager) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if !m.supported {
+		return fn(ctx)
+	}
+
+	session, err := m.db.Client().StartSession()
+	if err != nil {
+		return fmt.Errorf("failed to start session: %w", err)
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sc)
+	})
+	return err
+}
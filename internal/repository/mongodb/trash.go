@@ -0,0 +1,111 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// notDeleted matches documents that are not in the trash
+var notDeleted = bson.M{"deletedAt": nil}
+
+// inTrash matches documents in the trash
+var inTrash = bson.M{"deletedAt": bson.M{"$ne": nil}}
+
+// trashSort orders documents in the trash, most recently deleted first
+var trashSort = options.Find().SetSort(bson.D{{Key: "deletedAt", Value: -1}})
+
+// softDelete moves a document to the trash; it fails if the document is missing or already deleted
+func softDelete(ctx context.Context, collection *mongo.Collection, id string, deletedBy string) error {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid ID: %w", err)
+	}
+	userID, err := primitive.ObjectIDFromHex(deletedBy)
+	if err != nil {
+		return fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	update := bson.M{"$set": bson.M{
+		"deletedAt": primitive.NewDateTimeFromTime(time.Now()),
+		"deletedBy": userID,
+	}}
+	result, err := collection.UpdateOne(ctx, bson.M{"_id": objID, "deletedAt": nil}, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("document not found in %s", collection.Name())
+	}
+	return nil
+}
+
+// restoreDeleted takes a document out of the trash
+func restoreDeleted(ctx context.Context, collection *mongo.Collection, id string) error {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid ID: %w", err)
+	}
+
+	update := bson.M{"$unset": bson.M{"deletedAt": "", "deletedBy": ""}}
+	result, err := collection.UpdateOne(ctx, bson.M{"_id": objID, "deletedAt": bson.M{"$ne": nil}}, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("document not found in the trash of %s", collection.Name())
+	}
+	return nil
+}
+
+// deleteByCompetitionID deletes all documents of a competition from a collection (used by the purge)
+func deleteByCompetitionID(ctx context.Context, collection *mongo.Collection, competitionID string) error {
+	objID, err := primitive.ObjectIDFromHex(competitionID)
+	if err != nil {
+		return fmt.Errorf("invalid competition ID: %w", err)
+	}
+	_, err = collection.DeleteMany(ctx, bson.M{"competitionId": objID})
+	return err
+}
+
+// deleteByRegistrationID deletes all documents of a registration from a collection (used by the purge)
+func deleteByRegistrationID(ctx context.Context, collection *mongo.Collection, registrationID string) error {
+	objID, err := primitive.ObjectIDFromHex(registrationID)
+	if err != nil {
+		return fmt.Errorf("invalid registration ID: %w", err)
+	}
+	_, err = collection.DeleteMany(ctx, bson.M{"registrationId": objID})
+	return err
+}
+
+// countByRegistrationIDs counts documents of the registrations in a collection (used by deletion previews)
+func countByRegistrationIDs(ctx context.Context, collection *mongo.Collection, registrationIDs []string) (int64, error) {
+	if len(registrationIDs) == 0 {
+		return 0, nil
+	}
+	objIDs, err := objectIDsFromHex(registrationIDs, "registration")
+	if err != nil {
+		return 0, err
+	}
+	return collection.CountDocuments(ctx, bson.M{"registrationId": bson.M{"$in": objIDs}})
+}
+
+// deletionFromDoc converts the trash fields of a document
+func deletionFromDoc(deletedAt *primitive.DateTime, deletedBy *primitive.ObjectID) (*time.Time, *string) {
+	var at *time.Time
+	if deletedAt != nil {
+		t := deletedAt.Time()
+		at = &t
+	}
+	var by *string
+	if deletedBy != nil {
+		b := deletedBy.Hex()
+		by = &b
+	}
+	return at, by
+}
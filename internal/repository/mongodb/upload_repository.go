@@ -0,0 +1,257 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	"github.com/cnpf/feeder-backend/internal/repository/interface"
+)
+
+// UploadRepository handles resumable upload database operations
+// Uploads are stored in "uploads", the received bytes in "uploadChunks" (one document per chunk)
+// Implements repository.UploadRepository interface
+type UploadRepository struct {
+	db *mongo.Database
+}
+
+// NewUploadRepository creates a new upload repository
+func NewUploadRepository(db *mongo.Database) repository.UploadRepository {
+	return &UploadRepository{db: db}
+}
+
+// Ensure UploadRepository implements repository.UploadRepository interface
+var _ repository.UploadRepository = (*UploadRepository)(nil)
+
+// UploadDocument represents an upload document in MongoDB
+type UploadDocument struct {
+	ID          primitive.ObjectID `bson:"_id"`
+	UserID      primitive.ObjectID `bson:"userId"`
+	ContentType string             `bson:"contentType"`
+	FileName    string             `bson:"fileName,omitempty"`
+	Length      int64              `bson:"length"`
+	Offset      int64              `bson:"offset"`
+	CreatedAt   primitive.DateTime `bson:"createdAt"`
+	ExpiresAt   primitive.DateTime `bson:"expiresAt"`
+}
+
+// UploadChunkDocument represents a received part of an upload
+type UploadChunkDocument struct {
+	UploadID primitive.ObjectID `bson:"uploadId"`
+	Offset   int64              `bson:"offset"`
+	Data     []byte             `bson:"data"`
+}
+
+func (doc *UploadDocument) toEntity() *entity.Upload {
+	return &entity.Upload{
+		ID:          doc.ID.Hex(),
+		UserID:      doc.UserID.Hex(),
+		ContentType: doc.ContentType,
+		FileName:    doc.FileName,
+		Length:      doc.Length,
+		Offset:      doc.Offset,
+		CreatedAt:   doc.CreatedAt.Time(),
+		ExpiresAt:   doc.ExpiresAt.Time(),
+	}
+}
+
+// Create creates a new upload
+func (r *UploadRepository) Create(ctx context.Context, upload *entity.Upload) (string, error) {
+	userID, err := primitive.ObjectIDFromHex(upload.UserID)
+	if err != nil {
+		return "", fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	doc := UploadDocument{
+		ID:          primitive.NewObjectID(),
+		UserID:      userID,
+		ContentType: upload.ContentType,
+		FileName:    upload.FileName,
+		Length:      upload.Length,
+		Offset:      0,
+		CreatedAt:   primitive.NewDateTimeFromTime(time.Now()),
+		ExpiresAt:   primitive.NewDateTimeFromTime(upload.ExpiresAt),
+	}
+	if _, err := r.db.Collection("uploads").InsertOne(ctx, doc); err != nil {
+		return "", err
+	}
+	return doc.ID.Hex(), nil
+}
+
+// FindByID finds an upload by ID
+func (r *UploadRepository) FindByID(ctx context.Context, id string) (*entity.Upload, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid upload ID: %w", err)
+	}
+
+	var doc UploadDocument
+	if err := r.db.Collection("uploads").FindOne(ctx, bson.M{"_id": objID}).Decode(&doc); err != nil {
+		return nil, err
+	}
+	return doc.toEntity(), nil
+}
+
+// AppendChunk stores a chunk at the offset and advances the upload past it; false if the upload
+// is no longer at that offset (another request got there first) or the chunk exceeds the declared length
+func (r *UploadRepository) AppendChunk(ctx context.Context, id string, offset int64, data []byte, expiresAt time.Time) (bool, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return false, fmt.Errorf("invalid upload ID: %w", err)
+	}
+
+	size := int64(len(data))
+	filter := bson.M{"_id": objID, "offset": offset, "length": bson.M{"$gte": offset + size}}
+	result, err := r.db.Collection("uploads").UpdateOne(ctx, filter, bson.M{
+		"$inc": bson.M{"offset": size},
+		"$set": bson.M{"expiresAt": primitive.NewDateTimeFromTime(expiresAt)},
+	})
+	if err != nil {
+		return false, err
+	}
+	if result.MatchedCount == 0 {
+		return false, nil
+	}
+
+	// A chunk left at this offset by a failed request is replaced
+	chunk := UploadChunkDocument{UploadID: objID, Offset: offset, Data: data}
+	_, err = r.db.Collection("uploadChunks").ReplaceOne(ctx,
+		bson.M{"uploadId": objID, "offset": offset}, chunk, options.Replace().SetUpsert(true))
+	if err != nil {
+		// Give the offset back so that the client can resend the chunk
+		_, _ = r.db.Collection("uploads").UpdateOne(ctx,
+			bson.M{"_id": objID, "offset": offset + size}, bson.M{"$inc": bson.M{"offset": -size}})
+		return false, fmt.Errorf("failed to store upload chunk: %w", err)
+	}
+	return true, nil
+}
+
+// ReadData reads the received bytes of an upload in order
+func (r *UploadRepository) ReadData(ctx context.Context, id string) ([]byte, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid upload ID: %w", err)
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "offset", Value: 1}})
+	cursor, err := r.db.Collection("uploadChunks").Find(ctx, bson.M{"uploadId": objID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var data []byte
+	for cursor.Next(ctx) {
+		var chunk UploadChunkDocument
+		if err := cursor.Decode(&chunk); err != nil {
+			return nil, err
+		}
+		if chunk.Offset != int64(len(data)) {
+			return nil, fmt.Errorf("upload %s has a gap at offset %d", id, len(data))
+		}
+		data = append(data, chunk.Data...)
+	}
+	return data, cursor.Err()
+}
+
+// CountByUserID counts the uploads of a user
+func (r *UploadRepository) CountByUserID(ctx context.Context, userID string) (int64, error) {
+	objID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid user ID: %w", err)
+	}
+	return r.db.Collection("uploads").CountDocuments(ctx, bson.M{"userId": objID})
+}
+
+// Delete deletes uploads with their chunks
+func (r *UploadRepository) Delete(ctx context.Context, ids []string) error {
+	objIDs := make([]primitive.ObjectID, len(ids))
+	for i, id := range ids {
+		objID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return fmt.Errorf("invalid upload ID: %w", err)
+		}
+		objIDs[i] = objID
+	}
+	return r.deleteUploads(ctx, objIDs)
+}
+
+// DeleteExpired deletes uploads that expired before now with their chunks and returns them
+func (r *UploadRepository) DeleteExpired(ctx context.Context, now time.Time) ([]*entity.Upload, error) {
+	cursor, err := r.db.Collection("uploads").Find(ctx, bson.M{"expiresAt": bson.M{"$lt": primitive.NewDateTimeFromTime(now)}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []UploadDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	uploads := make([]*entity.Upload, len(docs))
+	objIDs := make([]primitive.ObjectID, len(docs))
+	for i, doc := range docs {
+		uploads[i] = doc.toEntity()
+		objIDs[i] = doc.ID
+	}
+	if err := r.deleteUploads(ctx, objIDs); err != nil {
+		return nil, err
+	}
+	return uploads, nil
+}
+
+// DeleteByUserID deletes all uploads of a user with their chunks
+func (r *UploadRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	objID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	objIDs, err := r.findIDs(ctx, bson.M{"userId": objID})
+	if err != nil {
+		return err
+	}
+	return r.deleteUploads(ctx, objIDs)
+}
+
+func (r *UploadRepository) findIDs(ctx context.Context, filter bson.M) ([]primitive.ObjectID, error) {
+	cursor, err := r.db.Collection("uploads").Find(ctx, filter, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	objIDs := make([]primitive.ObjectID, len(docs))
+	for i, doc := range docs {
+		objIDs[i] = doc.ID
+	}
+	return objIDs, nil
+}
+
+// deleteUploads deletes the chunks first, so that an interrupted deletion leaves no chunks without an upload
+func (r *UploadRepository) deleteUploads(ctx context.Context, objIDs []primitive.ObjectID) error {
+	if len(objIDs) == 0 {
+		return nil
+	}
+	if _, err := r.db.Collection("uploadChunks").DeleteMany(ctx, bson.M{"uploadId": bson.M{"$in": objIDs}}); err != nil {
+		return fmt.Errorf("failed to delete upload chunks: %w", err)
+	}
+	if _, err := r.db.Collection("uploads").DeleteMany(ctx, bson.M{"_id": bson.M{"$in": objIDs}}); err != nil {
+		return fmt.Errorf("failed to delete uploads: %w", err)
+	}
+	return nil
+}
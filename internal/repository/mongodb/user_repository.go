@@ -37,6 +37,8 @@ type UserDocument struct {
 	IsAdmin      bool               `bson:"isAdmin"`
 	HasAvatar    bool               `bson:"hasAvatar"`
 	Avatar       bson.M              `bson:"avatar,omitempty"`
+	StorageUsed  int64               `bson:"storageUsed"` // Changed only by AddStorageUsed, never by Update
+	CalendarTokenVersion int         `bson:"calendarTokenVersion,omitempty"` // Changed only by IncrementCalendarTokenVersion
 	CreatedAt    primitive.DateTime  `bson:"createdAt"`
 }
 
@@ -48,6 +50,10 @@ func (doc *UserDocument) toEntity() *entity.User {
 			avatar[k] = v
 		}
 	}
+	var avatarSize int64
+	if data, ok := doc.Avatar["data"].(primitive.Binary); ok && doc.HasAvatar {
+		avatarSize = int64(len(data.Data))
+	}
 	
 	return &entity.User{
 		ID:           doc.ID.Hex(),
@@ -57,6 +63,9 @@ func (doc *UserDocument) toEntity() *entity.User {
 		IsAdmin:      doc.IsAdmin,
 		HasAvatar:    doc.HasAvatar,
 		Avatar:       avatar,
+		AvatarSize:   avatarSize,
+		StorageUsed:  doc.StorageUsed,
+		CalendarTokenVersion: doc.CalendarTokenVersion,
 		CreatedAt:    doc.CreatedAt.Time(),
 	}
 }
@@ -88,6 +97,8 @@ func fromEntity(user *entity.User) (*UserDocument, error) {
 		IsAdmin:      user.IsAdmin,
 		HasAvatar:    user.HasAvatar,
 		Avatar:       avatar,
+		StorageUsed:  user.StorageUsed,
+		CalendarTokenVersion: user.CalendarTokenVersion,
 		CreatedAt:    primitive.NewDateTimeFromTime(user.CreatedAt),
 	}, nil
 }
@@ -122,6 +133,31 @@ func (r *UserRepository) FindByID(ctx context.Context, id string) (*entity.User,
 	return doc.toEntity(), nil
 }
 
+// FindByIDs finds users by IDs, without avatar data; unknown IDs are skipped
+func (r *UserRepository) FindByIDs(ctx context.Context, ids []string) ([]*entity.User, error) {
+	objIDs, err := objectIDsFromHex(ids, "user")
+	if err != nil {
+		return nil, err
+	}
+	
+	opts := options.Find().SetProjection(bson.M{"avatar": 0})
+	cursor, err := r.db.Collection("users").Find(ctx, bson.M{"_id": bson.M{"$in": objIDs}}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	
+	var docs []UserDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	users := make([]*entity.User, len(docs))
+	for i, doc := range docs {
+		users[i] = doc.toEntity()
+	}
+	return users, nil
+}
+
 // Create creates a new user
 func (r *UserRepository) Create(ctx context.Context, user *entity.User) (string, error) {
 	doc, err := fromEntity(user)
@@ -174,6 +210,47 @@ func (r *UserRepository) Update(ctx context.Context, id string, user *entity.Use
 	return err
 }
 
+// AddStorageUsed changes the bytes a user stores by delta; an increase is applied only while the total
+// stays within quota, false otherwise. Decreases of users that no longer exist are ignored
+func (r *UserRepository) AddStorageUsed(ctx context.Context, id string, delta, quota int64) (bool, error) {
+	userID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return false, fmt.Errorf("invalid user ID: %w", err)
+	}
+	if delta == 0 {
+		return true, nil
+	}
+	
+	filter := bson.M{"_id": userID}
+	if delta > 0 {
+		filter["storageUsed"] = bson.M{"$lte": quota - delta}
+	}
+	result, err := r.db.Collection("users").UpdateOne(ctx, filter, bson.M{"$inc": bson.M{"storageUsed": delta}})
+	if err != nil {
+		return false, err
+	}
+	return delta < 0 || result.MatchedCount > 0, nil
+}
+
+// IncrementCalendarTokenVersion increases the calendar feed token version of a user and returns the new version
+func (r *UserRepository) IncrementCalendarTokenVersion(ctx context.Context, id string) (int, error) {
+	userID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return 0, fmt.Errorf("invalid user ID: %w", err)
+	}
+	
+	opts := options.FindOneAndUpdate().
+		SetReturnDocument(options.After).
+		SetProjection(bson.M{"calendarTokenVersion": 1})
+	var doc UserDocument
+	err = r.db.Collection("users").FindOneAndUpdate(ctx, bson.M{"_id": userID},
+		bson.M{"$inc": bson.M{"calendarTokenVersion": 1}}, opts).Decode(&doc)
+	if err != nil {
+		return 0, err
+	}
+	return doc.CalendarTokenVersion, nil
+}
+
 // FindAll finds all users
 func (r *UserRepository) FindAll(ctx context.Context) ([]*entity.User, error) {
 	cursor, err := r.db.Collection("users").Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "createdAt", Value: -1}}))
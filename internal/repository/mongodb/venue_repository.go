@@ -0,0 +1,312 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	"github.com/cnpf/feeder-backend/internal/repository/interface"
+)
+
+// VenueRepository handles venue database operations
+// Implements repository.VenueRepository interface
+type VenueRepository struct {
+	db *mongo.Database
+}
+
+// NewVenueRepository creates a new venue repository
+func NewVenueRepository(db *mongo.Database) repository.VenueRepository {
+	return &VenueRepository{db: db}
+}
+
+// Ensure VenueRepository implements repository.VenueRepository interface
+var _ repository.VenueRepository = (*VenueRepository)(nil)
+
+// GeoJSONPoint represents a GeoJSON point stored in MongoDB (coordinates are [lon, lat])
+type GeoJSONPoint struct {
+	Type        string    `bson:"type"`
+	Coordinates []float64 `bson:"coordinates"`
+}
+
+// VenueDocument represents a venue document in MongoDB (internal to this package)
+type VenueDocument struct {
+	ID         primitive.ObjectID `bson:"_id"`
+	NameRu     string             `bson:"nameRu"`
+	NameRo     string             `bson:"nameRo"`
+	Location   GeoJSONPoint       `bson:"location"`
+	WaterBody  *string            `bson:"waterBody,omitempty"`
+	Sectors    []VenueSectorDoc   `bson:"sectors"`
+	Directions *string            `bson:"directions,omitempty"`
+	Photos     bson.A             `bson:"photos"`
+	CreatedAt  primitive.DateTime `bson:"createdAt"`
+	UpdatedAt  primitive.DateTime `bson:"updatedAt"`
+}
+
+type VenueSectorDoc struct {
+	Name    string `bson:"name"`
+	PegFrom int    `bson:"pegFrom"`
+	PegTo   int    `bson:"pegTo"`
+}
+
+// toEntity converts MongoDB document to domain entity
+func (doc *VenueDocument) toEntity() *entity.Venue {
+	var location entity.GeoPoint
+	if len(doc.Location.Coordinates) == 2 {
+		location = entity.GeoPoint{
+			Lon: doc.Location.Coordinates[0],
+			Lat: doc.Location.Coordinates[1],
+		}
+	}
+
+	sectors := make([]entity.VenueSector, len(doc.Sectors))
+	for i, s := range doc.Sectors {
+		sectors[i] = entity.VenueSector{
+			Name:    s.Name,
+			PegFrom: s.PegFrom,
+			PegTo:   s.PegTo,
+		}
+	}
+
+	photos := make([]interface{}, len(doc.Photos))
+	for i, photo := range doc.Photos {
+		photos[i] = venuePhotoFromDoc(photo)
+	}
+
+	return &entity.Venue{
+		ID:         doc.ID.Hex(),
+		NameRu:     doc.NameRu,
+		NameRo:     doc.NameRo,
+		Location:   location,
+		WaterBody:  doc.WaterBody,
+		Sectors:    sectors,
+		Directions: doc.Directions,
+		Photos:     photos,
+		CreatedAt:  doc.CreatedAt.Time(),
+		UpdatedAt:  doc.UpdatedAt.Time(),
+	}
+}
+
+// venuePhotoFromDoc converts an embedded photo document to a map of its content type and data bytes
+func venuePhotoFromDoc(photo interface{}) map[string]interface{} {
+	var fields bson.M
+	switch doc := photo.(type) {
+	case bson.M:
+		fields = doc
+	case primitive.D:
+		fields = doc.Map()
+	case map[string]interface{}:
+		fields = doc
+	default:
+		return map[string]interface{}{}
+	}
+	
+	result := map[string]interface{}{}
+	if contentType, ok := fields["contentType"].(string); ok {
+		result["contentType"] = contentType
+	}
+	switch data := fields["data"].(type) {
+	case primitive.Binary:
+		result["data"] = data.Data
+	case []byte:
+		result["data"] = data
+	}
+	return result
+}
+
+// venueFromEntity converts domain entity to MongoDB document
+func venueFromEntity(venue *entity.Venue) (*VenueDocument, error) {
+	venueID := primitive.NewObjectID()
+	if venue.ID != "" {
+		var err error
+		venueID, err = primitive.ObjectIDFromHex(venue.ID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid venue ID: %w", err)
+		}
+	}
+
+	sectors := make([]VenueSectorDoc, len(venue.Sectors))
+	for i, s := range venue.Sectors {
+		sectors[i] = VenueSectorDoc{
+			Name:    s.Name,
+			PegFrom: s.PegFrom,
+			PegTo:   s.PegTo,
+		}
+	}
+
+	photos := bson.A{}
+	for _, photo := range venue.Photos {
+		photos = append(photos, photo)
+	}
+
+	now := primitive.NewDateTimeFromTime(time.Now())
+	createdAt := now
+	if !venue.CreatedAt.IsZero() {
+		createdAt = primitive.NewDateTimeFromTime(venue.CreatedAt)
+	}
+	updatedAt := now
+	if !venue.UpdatedAt.IsZero() {
+		updatedAt = primitive.NewDateTimeFromTime(venue.UpdatedAt)
+	}
+
+	return &VenueDocument{
+		ID:     venueID,
+		NameRu: venue.NameRu,
+		NameRo: venue.NameRo,
+		Location: GeoJSONPoint{
+			Type:        "Point",
+			Coordinates: []float64{venue.Location.Lon, venue.Location.Lat},
+		},
+		WaterBody:  venue.WaterBody,
+		Sectors:    sectors,
+		Directions: venue.Directions,
+		Photos:     photos,
+		CreatedAt:  createdAt,
+		UpdatedAt:  updatedAt,
+	}, nil
+}
+
+// Create creates a new venue
+func (r *VenueRepository) Create(ctx context.Context, venue *entity.Venue) (string, error) {
+	doc, err := venueFromEntity(venue)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := r.db.Collection("venues").InsertOne(ctx, doc)
+	if err != nil {
+		return "", fmt.Errorf("failed to create venue: %w", err)
+	}
+
+	oid, ok := result.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return "", fmt.Errorf("unexpected InsertedID type: %T", result.InsertedID)
+	}
+	return oid.Hex(), nil
+}
+
+// FindByID finds a venue by ID
+func (r *VenueRepository) FindByID(ctx context.Context, id string) (*entity.Venue, error) {
+	venueID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid venue ID: %w", err)
+	}
+
+	var doc VenueDocument
+	err = r.db.Collection("venues").FindOne(ctx, bson.M{"_id": venueID}).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("venue not found")
+		}
+		return nil, fmt.Errorf("failed to find venue: %w", err)
+	}
+	return doc.toEntity(), nil
+}
+
+// FindAll finds all venues
+func (r *VenueRepository) FindAll(ctx context.Context) ([]*entity.Venue, error) {
+	cursor, err := r.db.Collection("venues").Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "nameRu", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []VenueDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	venues := make([]*entity.Venue, len(docs))
+	for i, doc := range docs {
+		venues[i] = doc.toEntity()
+	}
+	return venues, nil
+}
+
+// FindNear finds venues within radiusKm of the point, nearest first
+// Uses the 2dsphere index on "location" (see EnsureIndexes)
+func (r *VenueRepository) FindNear(ctx context.Context, point entity.GeoPoint, radiusKm float64) ([]*entity.Venue, error) {
+	filter := bson.M{
+		"location": bson.M{
+			"$nearSphere": bson.M{
+				"$geometry": bson.M{
+					"type":        "Point",
+					"coordinates": []float64{point.Lon, point.Lat},
+				},
+				"$maxDistance": radiusKm * 1000, // meters
+			},
+		},
+	}
+
+	cursor, err := r.db.Collection("venues").Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find venues: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []VenueDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	venues := make([]*entity.Venue, len(docs))
+	for i, doc := range docs {
+		venues[i] = doc.toEntity()
+	}
+	return venues, nil
+}
+
+// Update updates a venue
+func (r *VenueRepository) Update(ctx context.Context, id string, venue *entity.Venue) error {
+	venueID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid venue ID: %w", err)
+	}
+
+	doc, err := venueFromEntity(venue)
+	if err != nil {
+		return err
+	}
+
+	update := bson.M{
+		"nameRu":     doc.NameRu,
+		"nameRo":     doc.NameRo,
+		"location":   doc.Location,
+		"waterBody":  doc.WaterBody,
+		"sectors":    doc.Sectors,
+		"directions": doc.Directions,
+		"photos":     doc.Photos,
+		"updatedAt":  primitive.NewDateTimeFromTime(time.Now()),
+	}
+
+	result, err := r.db.Collection("venues").UpdateOne(ctx, bson.M{"_id": venueID}, bson.M{"$set": update})
+	if err != nil {
+		return fmt.Errorf("failed to update venue: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("venue not found")
+	}
+	return nil
+}
+
+// Delete deletes a venue
+func (r *VenueRepository) Delete(ctx context.Context, id string) error {
+	venueID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid venue ID: %w", err)
+	}
+
+	result, err := r.db.Collection("venues").DeleteOne(ctx, bson.M{"_id": venueID})
+	if err != nil {
+		return fmt.Errorf("failed to delete venue: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("venue not found")
+	}
+	return nil
+}
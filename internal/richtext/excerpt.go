@@ -0,0 +1,36 @@
+package richtext
+
+import (
+	"html"
+	"strings"
+	"unicode"
+
+	"github.com/microcosm-cc/bluemonday"
+)
+
+// textPolicy strips all markup, leaving the text content
+var textPolicy = bluemonday.StrictPolicy()
+
+// Excerpt returns the beginning of Markdown as plain text of at most maxRunes runes (for previews and descriptions)
+// Longer text is cut at a word boundary and ends with an ellipsis
+func Excerpt(source string, maxRunes int) string {
+	rendered := ToHTML(source, nil)
+	plain := html.UnescapeString(textPolicy.Sanitize(rendered))
+	plain = strings.Join(strings.Fields(plain), " ")
+
+	runes := []rune(plain)
+	if len(runes) <= maxRunes {
+		return plain
+	}
+
+	cut := maxRunes - 1
+	for i := cut; i > maxRunes/2; i-- {
+		if unicode.IsSpace(runes[i]) {
+			cut = i
+			break
+		}
+	}
+	return strings.TrimRightFunc(string(runes[:cut]), func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsPunct(r)
+	}) + "…"
+}
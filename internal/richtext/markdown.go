@@ -0,0 +1,105 @@
+package richtext
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// PhotoScheme prefixes inline references to the photos of a report: ![caption](photo:1) is the first photo,
+// ![caption](photo:<photo ID>) a photo regardless of its position
+const PhotoScheme = "photo:"
+
+// PhotoURL returns the URL of the photo referenced after PhotoScheme, or false if there is no such photo
+type PhotoURL func(ref string) (string, bool)
+
+// reportPhotoSrc matches the only image sources allowed in rendered HTML: photos served by the API
+var reportPhotoSrc = regexp.MustCompile(`^/api/reports/[0-9a-f]{24}/photos/[0-9a-f]{24}$`)
+
+// policy keeps formatting, links, tables and report photos; everything else (scripts, styles,
+// event handlers, external images, unsafe URLs) is removed
+var policy = newPolicy()
+
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.NewPolicy()
+	p.AllowElements("p", "br", "hr", "h1", "h2", "h3", "h4", "h5", "h6", "blockquote", "pre", "code",
+		"strong", "b", "em", "i", "u", "s", "del", "sub", "sup", "ul", "ol", "li")
+	p.AllowAttrs("start").Matching(bluemonday.Integer).OnElements("ol")
+	p.AllowTables()
+	p.AllowAttrs("align").Matching(regexp.MustCompile(`^(left|center|right)$`)).OnElements("th", "td")
+
+	p.AllowStandardURLs()
+	p.AllowURLSchemes("http", "https", "mailto")
+	p.AllowAttrs("href", "title").OnElements("a")
+	p.RequireNoFollowOnLinks(true)
+	p.AddTargetBlankToFullyQualifiedLinks(true)
+
+	p.AllowAttrs("src").Matching(reportPhotoSrc).OnElements("img")
+	p.AllowAttrs("alt", "title").OnElements("img")
+	return p
+}
+
+// ToHTML renders Markdown (a restricted HTML subset may be mixed in) into sanitized HTML
+// Line breaks are kept, so plain text written before Markdown support renders as before
+func ToHTML(source string, photoURL PhotoURL) string {
+	if strings.TrimSpace(source) == "" {
+		return ""
+	}
+
+	md := goldmark.New(
+		goldmark.WithExtensions(
+			extension.NewTable(extension.WithTableCellAlignMethod(extension.TableCellAlignAttribute)),
+			extension.Strikethrough,
+			extension.Linkify,
+		),
+		goldmark.WithParserOptions(
+			parser.WithASTTransformers(util.Prioritized(&photoTransformer{photoURL: photoURL}, 100)),
+		),
+		// Raw HTML is passed on to the sanitizer, which keeps the allowed subset
+		goldmark.WithRendererOptions(html.WithHardWraps(), html.WithUnsafe()),
+	)
+
+	var buf bytes.Buffer
+	if err := md.Convert([]byte(source), &buf); err != nil {
+		return policy.Sanitize("<p>" + source + "</p>")
+	}
+	return policy.Sanitize(buf.String())
+}
+
+// photoTransformer resolves photo: references; other images and references to missing photos
+// are replaced by their captions
+type photoTransformer struct {
+	photoURL PhotoURL
+}
+
+func (t *photoTransformer) Transform(doc *ast.Document, reader text.Reader, pc parser.Context) {
+	var images []*ast.Image
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if image, ok := node.(*ast.Image); ok && entering {
+			images = append(images, image)
+		}
+		return ast.WalkContinue, nil
+	})
+
+	for _, image := range images {
+		destination := string(image.Destination)
+		if strings.HasPrefix(destination, PhotoScheme) && t.photoURL != nil {
+			if url, ok := t.photoURL(strings.TrimPrefix(destination, PhotoScheme)); ok {
+				image.Destination = []byte(url)
+				continue
+			}
+		}
+
+		caption := ast.NewString(image.Text(reader.Source()))
+		image.Parent().ReplaceChild(image.Parent(), image, caption)
+	}
+}
@@ -11,6 +11,8 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
 )
 
 // SearchResult represents a search result
@@ -22,6 +24,9 @@ type SearchResult struct {
 	Location    string
 	HasPhotos   bool
 	PhotosCount int
+	// Competition a report is about, empty for competitions and standalone reports
+	CompetitionID    string
+	CompetitionTitle string
 }
 
 // scoredResult represents a search result with relevance score
@@ -50,6 +55,7 @@ func SearchReports(ctx context.Context, db *mongo.Database, query string) ([]Sea
 		orConditions = append(orConditions,
 			bson.M{"title": regex},
 			bson.M{"text": regex},
+			bson.M{"tags": regex},
 		)
 	}
 
@@ -58,7 +64,9 @@ func SearchReports(ctx context.Context, db *mongo.Database, query string) ([]Sea
 	}
 
 	cursor, err := db.Collection("reports").Find(ctx, bson.M{
-		"$or": orConditions,
+		"$or":       orConditions,
+		"deletedAt": nil,
+		"status":    bson.M{"$nin": bson.A{string(entity.ReportStatusDraft), string(entity.ReportStatusScheduled)}},
 	}, options.Find().SetLimit(20).SetSort(bson.M{"createdAt": -1})) // Get more results for filtering
 	if err != nil {
 		return nil, err
@@ -83,11 +91,12 @@ func SearchReports(ctx context.Context, db *mongo.Database, query string) ([]Sea
 
 		title := getString(doc, "title")
 		text := getString(doc, "text")
+		tags := getStrings(doc, "tags")
 		
-		// Calculate relevance score
+		// Calculate relevance score; tags are chosen by the author, so a tag match outweighs a text match
 		content := title + " " + text
-		score := calculateRelevanceScore(content, keyWords)
-		matchingWordsCount := countMatchingWords(content, keyWords)
+		score := calculateRelevanceScore(content, keyWords) + calculateTagScore(tags, keyWords)
+		matchingWordsCount := countMatchingWords(content+" "+strings.Join(tags, " "), keyWords)
 		
 		// Require matching words based on query length:
 		// - 1-2 words: require all words to match
@@ -105,14 +114,20 @@ func SearchReports(ctx context.Context, db *mongo.Database, query string) ([]Sea
 			}
 			photosCount := len(photos)
 
+			var competitionID string
+			if objID, ok := doc["competitionId"].(primitive.ObjectID); ok {
+				competitionID = objID.Hex()
+			}
+
 			scoredResults = append(scoredResults, scoredResult{
 				result: SearchResult{
-					Type:        "report",
-					ID:          id,
-					Title:       title,
-					Text:        text,
-					HasPhotos:   photosCount > 0,
-					PhotosCount: photosCount,
+					Type:          "report",
+					ID:            id,
+					Title:         title,
+					Text:          text,
+					HasPhotos:     photosCount > 0,
+					PhotosCount:   photosCount,
+					CompetitionID: competitionID,
 				},
 				score: score,
 			})
@@ -122,9 +137,57 @@ func SearchReports(ctx context.Context, db *mongo.Database, query string) ([]Sea
 	// Sort by score (descending) and take top 5
 	results := sortAndLimitResults(scoredResults, 5)
 
+	if err := addReportCompetitions(ctx, db, results); err != nil {
+		return nil, err
+	}
+
 	return results, nil
 }
 
+// addReportCompetitions fills the competition titles of reports linked to a competition;
+// links to competitions in the trash are dropped
+func addReportCompetitions(ctx context.Context, db *mongo.Database, results []SearchResult) error {
+	var ids []primitive.ObjectID
+	for _, result := range results {
+		if objID, err := primitive.ObjectIDFromHex(result.CompetitionID); err == nil {
+			ids = append(ids, objID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	cursor, err := db.Collection("competitions").Find(ctx, bson.M{
+		"_id":       bson.M{"$in": ids},
+		"deletedAt": nil,
+	}, options.Find().SetProjection(bson.M{"title": 1}))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	titles := make(map[string]string)
+	for cursor.Next(ctx) {
+		var doc bson.M
+		if err := cursor.Decode(&doc); err != nil {
+			continue
+		}
+		if objID, ok := doc["_id"].(primitive.ObjectID); ok {
+			titles[objID.Hex()] = getString(doc, "title")
+		}
+	}
+
+	for i := range results {
+		title, ok := titles[results[i].CompetitionID]
+		if !ok {
+			results[i].CompetitionID = ""
+			continue
+		}
+		results[i].CompetitionTitle = title
+	}
+	return nil
+}
+
 // SearchCompetitions searches for competitions matching the query
 // Query should already be optimized by AI (key words extracted, translated, transliterated)
 // Uses relevance scoring to filter out irrelevant results
@@ -152,8 +215,23 @@ func SearchCompetitions(ctx context.Context, db *mongo.Database, query string) (
 		return []SearchResult{}, nil
 	}
 
+	// Competitions linked to a venue match by the venue's ru/ro names as well,
+	// so place names don't need special-casing in the translation map
+	venueTexts, err := findVenuesByKeywords(ctx, db, keyWords)
+	if err != nil {
+		return nil, err
+	}
+	if len(venueTexts) > 0 {
+		venueIDs := make([]primitive.ObjectID, 0, len(venueTexts))
+		for id := range venueTexts {
+			venueIDs = append(venueIDs, id)
+		}
+		orConditions = append(orConditions, bson.M{"venueId": bson.M{"$in": venueIDs}})
+	}
+
 	cursor, err := db.Collection("competitions").Find(ctx, bson.M{
-		"$or": orConditions,
+		"$or":       orConditions,
+		"deletedAt": nil,
 	}, options.Find().SetLimit(20).SetSort(bson.M{"createdAt": -1})) // Get more results for filtering
 	if err != nil {
 		return nil, err
@@ -179,6 +257,9 @@ func SearchCompetitions(ctx context.Context, db *mongo.Database, query string) (
 		title := getString(doc, "title")
 		location := getString(doc, "location")
 		content := title + " " + location
+		if venueID, ok := doc["venueId"].(primitive.ObjectID); ok {
+			content += " " + venueTexts[venueID]
+		}
 
 		// Calculate relevance score
 		score := calculateRelevanceScore(content, keyWords)
@@ -203,6 +284,50 @@ func SearchCompetitions(ctx context.Context, db *mongo.Database, query string) (
 	return results, nil
 }
 
+// findVenuesByKeywords finds venues whose names or water body match any key word
+// Returns venue ID -> searchable venue text
+func findVenuesByKeywords(ctx context.Context, db *mongo.Database, keyWords []string) (map[primitive.ObjectID]string, error) {
+	var orConditions []bson.M
+	for _, word := range keyWords {
+		if word == "" {
+			continue
+		}
+		regex := bson.M{"$regex": regexp.QuoteMeta(word), "$options": "i"}
+		orConditions = append(orConditions,
+			bson.M{"nameRu": regex},
+			bson.M{"nameRo": regex},
+			bson.M{"waterBody": regex},
+		)
+	}
+
+	venueTexts := make(map[primitive.ObjectID]string)
+	if len(orConditions) == 0 {
+		return venueTexts, nil
+	}
+
+	cursor, err := db.Collection("venues").Find(ctx, bson.M{
+		"$or": orConditions,
+	}, options.Find().SetLimit(20).SetProjection(bson.M{"nameRu": 1, "nameRo": 1, "waterBody": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var doc bson.M
+		if err := cursor.Decode(&doc); err != nil {
+			continue
+		}
+		objID, ok := doc["_id"].(primitive.ObjectID)
+		if !ok {
+			continue
+		}
+		venueTexts[objID] = getString(doc, "nameRu") + " " + getString(doc, "nameRo") + " " + getString(doc, "waterBody")
+	}
+
+	return venueTexts, nil
+}
+
 // SearchAll searches both reports and competitions
 // If query is empty or same as original, also tries fallback with ExpandQuery for compatibility
 func SearchAll(ctx context.Context, db *mongo.Database, query string) ([]SearchResult, error) {
@@ -255,6 +380,21 @@ func getString(doc bson.M, key string) string {
 	return ""
 }
 
+// getStrings returns a string array field of a document, skipping non-string elements
+func getStrings(doc bson.M, key string) []string {
+	values, ok := doc[key].(bson.A)
+	if !ok {
+		return nil
+	}
+	strs := make([]string, 0, len(values))
+	for _, val := range values {
+		if str, ok := val.(string); ok {
+			strs = append(strs, str)
+		}
+	}
+	return strs
+}
+
 // extractKeyWordsFromQuery extracts meaningful words from a single optimized query
 // AI should have already removed stop words, but we filter them again just in case
 func extractKeyWordsFromQuery(query string) []string {
@@ -447,6 +587,22 @@ func calculateRelevanceScore(content string, keyWords []string) int {
 	return score
 }
 
+// calculateTagScore scores key words found in the tags of a report:
+// a tag equal to a key word scores more than a tag containing it
+func calculateTagScore(tags []string, keyWords []string) int {
+	score := 0
+	for _, word := range keyWords {
+		for _, tag := range tags {
+			if tag == word {
+				score += 8
+			} else if strings.Contains(tag, word) {
+				score += 3
+			}
+		}
+	}
+	return score
+}
+
 // countMatchingWords counts how many key words are found in content
 func countMatchingWords(content string, keyWords []string) int {
 	contentLower := strings.ToLower(content)
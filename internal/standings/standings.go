@@ -0,0 +1,107 @@
+package standings
+
+import (
+	"sort"
+
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+)
+
+// Row is a single line of the standings table
+type Row struct {
+	Place        int
+	Registration *entity.Registration
+	TourWeights  []int // Weight in grams per tour (index 0 is tour 1)
+	TotalWeight  int
+	TotalFish    int
+	Disqualified bool              // Disqualified from the whole competition
+	Penalties    []*entity.Penalty // Active penalties of the registration
+}
+
+// Compute builds the standings of a competition from its registrations, tour results and penalties
+// A disqualification in a tour zeroes the catch of that tour; a disqualification without a tour
+// zeroes the whole catch and moves the row to the end
+// Rows are ordered by total weight, then by fish count; equal rows share a place
+func Compute(registrations []*entity.Registration, results []*entity.TourResult, penalties []*entity.Penalty, tours int) []*Row {
+	rowsByRegistration := make(map[string]*Row, len(registrations))
+	rows := make([]*Row, 0, len(registrations))
+	for _, reg := range registrations {
+		row := &Row{
+			Registration: reg,
+			TourWeights:  make([]int, tours),
+		}
+		rowsByRegistration[reg.ID] = row
+		rows = append(rows, row)
+	}
+
+	disqualifiedTours := make(map[string]map[int]bool)
+	for _, penalty := range penalties {
+		row, ok := rowsByRegistration[penalty.RegistrationID]
+		if !ok {
+			continue
+		}
+		row.Penalties = append(row.Penalties, penalty)
+		if penalty.Type != entity.PenaltyTypeDisqualification {
+			continue
+		}
+		if penalty.Tour == nil {
+			row.Disqualified = true
+			continue
+		}
+		if disqualifiedTours[penalty.RegistrationID] == nil {
+			disqualifiedTours[penalty.RegistrationID] = make(map[int]bool)
+		}
+		disqualifiedTours[penalty.RegistrationID][*penalty.Tour] = true
+	}
+
+	for _, result := range results {
+		row, ok := rowsByRegistration[result.RegistrationID]
+		if !ok || result.Tour < 1 {
+			continue
+		}
+		if row.Disqualified || disqualifiedTours[result.RegistrationID][result.Tour] {
+			continue
+		}
+		for len(row.TourWeights) < result.Tour {
+			row.TourWeights = append(row.TourWeights, 0)
+		}
+		row.TourWeights[result.Tour-1] += result.Weight
+		row.TotalWeight += result.Weight
+		row.TotalFish += result.FishCount
+	}
+
+	// Results for tours beyond the competition schedule widen every row equally
+	for _, row := range rows {
+		if len(row.TourWeights) > tours {
+			tours = len(row.TourWeights)
+		}
+	}
+	for _, row := range rows {
+		for len(row.TourWeights) < tours {
+			row.TourWeights = append(row.TourWeights, 0)
+		}
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].Disqualified != rows[j].Disqualified {
+			return !rows[i].Disqualified
+		}
+		if rows[i].TotalWeight != rows[j].TotalWeight {
+			return rows[i].TotalWeight > rows[j].TotalWeight
+		}
+		return rows[i].TotalFish > rows[j].TotalFish
+	})
+
+	for i, row := range rows {
+		if i > 0 && sameScore(row, rows[i-1]) {
+			row.Place = rows[i-1].Place
+			continue
+		}
+		row.Place = i + 1
+	}
+
+	return rows
+}
+
+func sameScore(a, b *Row) bool {
+	return a.Disqualified == b.Disqualified && a.TotalWeight == b.TotalWeight && a.TotalFish == b.TotalFish
+}
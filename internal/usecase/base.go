@@ -21,36 +21,163 @@ type UseCase interface {
 	UpdatePassword(ctx context.Context, userID string, oldPassword, newPassword string) (bool, error)
 	
 	// Reports
-	GetReports(ctx context.Context, currentUserID string, limit *int) ([]*model.Report, error)
-	GetReport(ctx context.Context, currentUserID string, id string) (*model.Report, error)
-	CreateReport(ctx context.Context, userID string, title, text string, photos []*PhotoUpload) (*model.Report, error)
-	UpdateReport(ctx context.Context, userID string, id string, title, text *string, removePhoto []int, removeAllPhotos *bool, photos []*PhotoUpload) (*model.Report, error)
+	GetReports(ctx context.Context, currentUserID string, limit *int, filter ReportsFilter) ([]*model.Report, error)
+	GetReport(ctx context.Context, currentUserID string, viewerKey string, id string) (*model.Report, error)
+	CreateReport(ctx context.Context, userID string, title, text string, status, publishAt *string, link *ReportLinkInput, taxonomy *ReportTaxonomyInput, photos *ReportPhotosInput) (*model.Report, error)
+	UpdateReport(ctx context.Context, userID string, id string, title, text *string, link *ReportLinkInput, taxonomy *ReportTaxonomyInput, photos *ReportPhotosInput) (*model.Report, error)
 	DeleteReport(ctx context.Context, userID string, id string) (bool, error)
+	GetReportDrafts(ctx context.Context, userID string, limit *int) ([]*model.Report, error)
+	PublishReport(ctx context.Context, userID string, id string) (*model.Report, error)
+	ScheduleReport(ctx context.Context, userID string, id string, publishAt string) (*model.Report, error)
+	UnpublishReport(ctx context.Context, userID string, id string) (*model.Report, error)
+	PublishScheduledReports(ctx context.Context) (int, error)
+	GetTagSuggestions(ctx context.Context, prefix *string, limit *int) ([]*model.TagCount, error)
+	GetReportCategories() []*model.ReportCategory
+	GetReportPhoto(ctx context.Context, currentUserID string, reportID string, photoRef string) (*ExportFile, error)
+	GetCompetitionGallery(ctx context.Context, competitionID string, tour *int) ([]*model.GalleryPhoto, error)
 	
 	// Competitions
 	GetCompetitions(ctx context.Context) ([]*model.Competition, error)
 	GetCompetition(ctx context.Context, id string) (*model.Competition, error)
 	CreateCompetition(ctx context.Context, input *model.CompetitionInput) (*model.Competition, error)
-	UpdateCompetition(ctx context.Context, id string, input *model.CompetitionInput) (*model.Competition, error)
-	DeleteCompetition(ctx context.Context, id string) (bool, error)
+	UpdateCompetition(ctx context.Context, userID string, id string, input *model.CompetitionInput) (*model.Competition, error)
+	DeleteCompetition(ctx context.Context, userID string, id string) (bool, error)
 	
 	// Admin
 	GetAdminUsers(ctx context.Context) ([]*model.User, error)
 	GetAdminUser(ctx context.Context, id string) (*model.User, error)
 	AdminUpdateUser(ctx context.Context, id string, isAdmin *bool) (*model.User, error)
-	AdminDeleteUser(ctx context.Context, id string) (bool, error)
+	AdminDeleteUser(ctx context.Context, currentUserID string, id string) (bool, error)
 	
 	// Registrations
-	CreateRegistration(ctx context.Context, userID string, competitionID string, registrationType string, teamName *string, participants []ParticipantInput, coach *CoachInput) (*model.Registration, error)
+	CreateRegistration(ctx context.Context, userID string, competitionID string, registrationType string, teamName *string, participants []ParticipantInput, coaches []CoachInput, eligibilityOverrideReason *string) (*model.Registration, error)
 	GetRegistrationsByCompetition(ctx context.Context, competitionID string, currentUserID string) ([]*model.Registration, error)
-	UpdateRegistration(ctx context.Context, userID string, registrationID string, teamName *string, participants []ParticipantInput, coach *CoachInput) (*model.Registration, error)
+	UpdateRegistration(ctx context.Context, userID string, registrationID string, teamName *string, participants []ParticipantInput, coaches []CoachInput, eligibilityOverrideReason *string) (*model.Registration, error)
 	DeleteRegistration(ctx context.Context, userID string, registrationID string) (bool, error)
+	SetRegistrationPaid(ctx context.Context, userID string, registrationID string, paid bool) (*model.Registration, error)
+	
+	// Venues
+	GetVenues(ctx context.Context, near *model.NearInput) ([]*model.Venue, error)
+	GetVenue(ctx context.Context, id string) (*model.Venue, error)
+	CreateVenue(ctx context.Context, input *model.VenueInput, photos []*PhotoUpload) (*model.Venue, error)
+	UpdateVenue(ctx context.Context, id string, input *model.VenueInput, photos []*PhotoUpload) (*model.Venue, error)
+	DeleteVenue(ctx context.Context, id string) (bool, error)
+	GetVenuePhoto(ctx context.Context, venueID string, index int) (*ExportFile, error)
+	
+	// Calendar (iCalendar feeds)
+	GetCompetitionsCalendar(ctx context.Context) ([]byte, error)
+	GetCompetitionCalendar(ctx context.Context, id string) ([]byte, error)
+	GetUserCalendar(ctx context.Context, userID string, tokenVersion int) ([]byte, error)
+	GetCalendarFeedURL(ctx context.Context, userID string) (string, error)
+	RegenerateCalendarFeedURL(ctx context.Context, userID string) (string, error)
+	
+	// Syndication (Atom/RSS feeds and sitemap)
+	GetReportsFeed(ctx context.Context, format string) ([]byte, error)
+	GetCompetitionsFeed(ctx context.Context, format string) ([]byte, error)
+	GetSitemap(ctx context.Context) ([]byte, error)
+	
+	// Social previews (Open Graph / Twitter Card pages of shared links)
+	GetReportSharePage(ctx context.Context, id string) (*SharePage, error)
+	GetCompetitionSharePage(ctx context.Context, id string) (*SharePage, error)
+	
+	// Resumable uploads (tus protocol), attached to reports by upload ID
+	CreateUpload(ctx context.Context, userID string, length int64, contentType, fileName string) (*UploadStatus, error)
+	GetUpload(ctx context.Context, userID string, id string) (*UploadStatus, error)
+	AppendUpload(ctx context.Context, userID string, id string, offset int64, chunk io.Reader) (*UploadStatus, error)
+	DeleteUpload(ctx context.Context, userID string, id string) error
+	PurgeExpiredUploads(ctx context.Context) (int, error)
+	
+	// Storage quotas (limits by role, bytes stored by the user)
+	GetStorageUsage(ctx context.Context, userID string) (*model.StorageUsage, error)
+	
+	// Results and sector draw
+	AssignSector(ctx context.Context, userID string, registrationID string, sector *string, peg *int) (*model.Registration, error)
+	SetTourResult(ctx context.Context, input *model.TourResultInput) (*model.TourResult, error)
+	DeleteTourResult(ctx context.Context, id string) (bool, error)
+	GetTourResults(ctx context.Context, competitionID string) ([]*model.TourResult, error)
+	GetStandings(ctx context.Context, competitionID string, currentUserID string) ([]*model.Standing, error)
+	
+	// Export (start lists and protocols)
+	ExportStartList(ctx context.Context, userID string, competitionID string, format string) (*ExportFile, error)
+	ExportProtocol(ctx context.Context, userID string, competitionID string, format string) (*ExportFile, error)
+	
+	// Bulk import (CSV/XLSX)
+	ImportCompetitions(ctx context.Context, file *ImportFile, dryRun bool) (*model.ImportResult, error)
+	ImportRegistrations(ctx context.Context, userID string, competitionID string, file *ImportFile, dryRun bool) (*model.ImportResult, error)
+	
+	// Competition duplication and templates
+	DuplicateCompetition(ctx context.Context, id string, shiftDates *bool, startDate *string) (*model.Competition, error)
+	SaveCompetitionTemplate(ctx context.Context, competitionID string, name string) (*model.CompetitionTemplate, error)
+	GetCompetitionTemplates(ctx context.Context) ([]*model.CompetitionTemplate, error)
+	GetCompetitionPrefill(ctx context.Context, templateID string, startDate string) (*model.CompetitionPrefill, error)
+	DeleteCompetitionTemplate(ctx context.Context, id string) (bool, error)
+	
+	// Judges and penalties
+	SetCompetitionJudges(ctx context.Context, competitionID string, userIDs []string) (*model.Competition, error)
+	CreatePenalty(ctx context.Context, userID string, input *model.PenaltyInput) (*model.Penalty, error)
+	UpdatePenalty(ctx context.Context, userID string, id string, input *model.UpdatePenaltyInput) (*model.Penalty, error)
+	DeletePenalty(ctx context.Context, userID string, id string) (bool, error)
+	GetPenalties(ctx context.Context, competitionID string) ([]*model.Penalty, error)
+	GetPenalty(ctx context.Context, id string) (*model.Penalty, error)
+	
+	// Personal dashboard
+	GetMyRegistrations(ctx context.Context, userID string, upcomingOnly bool) ([]*model.MyRegistration, error)
+	GetDashboard(ctx context.Context, userID string) (*model.Dashboard, error)
+	
+	// Check-in (QR codes)
+	GetRegistrationQRCode(ctx context.Context, userID string, registrationID string) (*ExportFile, error)
+	CheckIn(ctx context.Context, userID string, code string, tour int) (*model.CheckInResult, error)
+	CancelCheckIn(ctx context.Context, userID string, registrationID string, tour int) (bool, error)
+	GetCheckInStatus(ctx context.Context, userID string, competitionID string, tour int) (*model.CheckInStatus, error)
+	
+	// Protests
+	FileProtest(ctx context.Context, userID string, input *model.ProtestInput) (*model.Protest, error)
+	WithdrawProtest(ctx context.Context, userID string, id string) (*model.Protest, error)
+	DecideProtest(ctx context.Context, userID string, id string, input *model.DecideProtestInput) (*model.Protest, error)
+	GetProtests(ctx context.Context, userID string, competitionID string, status *string) ([]*model.Protest, error)
+	
+	// Notifications
+	GetNotifications(ctx context.Context, userID string, unreadOnly bool, limit *int) ([]*model.Notification, error)
+	GetUnreadNotificationsCount(ctx context.Context, userID string) (int, error)
+	MarkNotificationsRead(ctx context.Context, userID string, ids []string) (bool, error)
+	
+	// Deletion rules and trash
+	GetCompetitionDeletionPreview(ctx context.Context, id string) (*model.DeletionPreview, error)
+	GetUserDeletionPreview(ctx context.Context, currentUserID string, id string) (*model.DeletionPreview, error)
+	GetTrash(ctx context.Context, currentUserID string) (*model.Trash, error)
+	GetDeletedCompetitions(ctx context.Context) ([]*model.Competition, error)
+	RestoreCompetition(ctx context.Context, id string) (*model.Competition, error)
+	RestoreReport(ctx context.Context, currentUserID string, id string) (*model.Report, error)
+	RestoreRegistration(ctx context.Context, currentUserID string, id string) (*model.Registration, error)
+	PurgeTrash(ctx context.Context) (int, error)
+	
+	// Revision history
+	GetRevisions(ctx context.Context, userID string, entityID string) ([]*model.Revision, error)
+	RevertToRevision(ctx context.Context, userID string, revisionID string) (*model.RevertResult, error)
+	
+	// Comments and moderation
+	GetComments(ctx context.Context, userID string, reportID string) ([]*model.Comment, error)
+	CreateComment(ctx context.Context, userID string, input *model.CommentInput) (*model.Comment, error)
+	UpdateComment(ctx context.Context, userID string, id string, text string) (*model.Comment, error)
+	DeleteComment(ctx context.Context, userID string, id string) (bool, error)
+	GetCommentModerationQueue(ctx context.Context, userID string, limit *int) ([]*model.Comment, error)
+	HideComment(ctx context.Context, userID string, id string) (*model.Comment, error)
+	ApproveComment(ctx context.Context, userID string, id string) (*model.Comment, error)
+	
+	// Reactions and popularity
+	ToggleReaction(ctx context.Context, userID string, reportID string, reactionType string) (*model.Report, error)
+	GetPopularReports(ctx context.Context, currentUserID string, period *string, limit *int) ([]*model.Report, error)
 }
 
 // ParticipantInput represents participant input for registration
 type ParticipantInput struct {
-	FirstName string
-	LastName  string
+	FirstName     string
+	LastName      string
+	BirthDate     *string // RFC3339
+	Gender        *string
+	LicenseNumber *string
+	Reserve       bool
+	Captain       bool
 }
 
 // CoachInput represents coach input for registration
@@ -66,6 +193,56 @@ type PhotoUpload struct {
 	ContentType string
 }
 
+// ReportLinkInput links a report to a competition and optionally to one of its tours
+type ReportLinkInput struct {
+	CompetitionID *string // nil keeps the current competition of an updated report
+	Tour          *int    // Tour number (1-based); nil or 0 means the whole competition
+	Unlink        bool    // Removes the competition and tour reference
+}
+
+// ReportTaxonomyInput sets the tags and category of a report
+type ReportTaxonomyInput struct {
+	Tags     []string // nil keeps the current tags of an updated report, empty removes them
+	Category *string  // nil keeps the current category, empty removes it
+}
+
+// PhotoDetails are the caption and photographer credit of a report photo; nil keeps the current value
+type PhotoDetails struct {
+	Caption      *string
+	Photographer *string
+}
+
+// PhotoUpdate changes the details of the report photo with the ID
+type PhotoUpdate struct {
+	ID string
+	PhotoDetails
+}
+
+// UploadedPhoto attaches a completed resumable upload to a report as a photo
+type UploadedPhoto struct {
+	UploadID string
+	PhotoDetails
+}
+
+// ReportPhotosInput changes the photos of a report; existing photos are addressed by their IDs
+type ReportPhotosInput struct {
+	Uploads   []*PhotoUpload  // Appended after the current photos
+	Details   []PhotoDetails  // Details of Uploads, by index
+	Uploaded  []UploadedPhoto // Resumable uploads, appended after Uploads
+	Remove    []string       // IDs of photos to delete; unknown IDs (already deleted) are ignored
+	RemoveAt  []int          // Positions (0-based) of photos to delete, for clients without photo IDs
+	RemoveAll bool
+	Updates   []PhotoUpdate
+	Order     []string // Photo IDs in the new order; photos not listed follow in their current order
+}
+
+// ReportsFilter selects published reports in GetReports; empty fields match all reports
+type ReportsFilter struct {
+	CompetitionID *string
+	Tags          []string // Reports having all of the tags
+	Category      *string
+}
+
 // GetCurrentUserFromContext extracts current user from context
 func GetCurrentUserFromContext(ctx context.Context) (*auth.CurrentUser, error) {
 	// This will be implemented in resolver layer
@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cnpf/feeder-backend/internal/auth"
+	"github.com/cnpf/feeder-backend/internal/calendar"
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	apperrors "github.com/cnpf/feeder-backend/internal/errors"
+)
+
+// ErrCalendarLinkRevoked is returned for calendar feed URLs issued before the user regenerated the link
+var ErrCalendarLinkRevoked = fmt.Errorf("Ссылка на календарь больше не действует; получите новую в профиле")
+
+// GetCompetitionsCalendar implements UseCase.GetCompetitionsCalendar
+func (u *UseCaseImpl) GetCompetitionsCalendar(ctx context.Context) ([]byte, error) {
+	competitions, err := u.competitionRepo.FindAll(ctx)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить соревнования", err)
+	}
+
+	return calendar.Render(competitions, calendar.DefaultOptions("CNPF Feeder — соревнования")), nil
+}
+
+// GetCompetitionCalendar implements UseCase.GetCompetitionCalendar
+func (u *UseCaseImpl) GetCompetitionCalendar(ctx context.Context, id string) ([]byte, error) {
+	competition, err := u.competitionRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("Соревнование не найдено")
+	}
+
+	return calendar.Render([]*entity.Competition{competition}, calendar.DefaultOptions(competition.Title)), nil
+}
+
+// GetUserCalendar implements UseCase.GetUserCalendar
+// Contains only competitions the user is registered for; tokenVersion comes from the feed URL
+func (u *UseCaseImpl) GetUserCalendar(ctx context.Context, userID string, tokenVersion int) ([]byte, error) {
+	user, err := u.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("Пользователь не найден")
+	}
+	if user.CalendarTokenVersion != tokenVersion {
+		return nil, ErrCalendarLinkRevoked
+	}
+
+	registrations, err := u.registrationRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить регистрации", err)
+	}
+
+	seen := make(map[string]bool)
+	competitions := make([]*entity.Competition, 0, len(registrations))
+	for _, reg := range registrations {
+		if seen[reg.CompetitionID] {
+			continue
+		}
+		seen[reg.CompetitionID] = true
+
+		competition, err := u.competitionRepo.FindByID(ctx, reg.CompetitionID)
+		if err != nil {
+			continue // Skip registrations of removed competitions
+		}
+		competitions = append(competitions, competition)
+	}
+
+	return calendar.Render(competitions, calendar.DefaultOptions("CNPF Feeder — мои соревнования")), nil
+}
+
+// GetCalendarFeedURL implements UseCase.GetCalendarFeedURL
+func (u *UseCaseImpl) GetCalendarFeedURL(ctx context.Context, userID string) (string, error) {
+	if userID == "" {
+		return "", fmt.Errorf("Не авторизован")
+	}
+
+	user, err := u.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return "", fmt.Errorf("Пользователь не найден")
+	}
+
+	return calendarFeedURL(userID, user.CalendarTokenVersion)
+}
+
+// RegenerateCalendarFeedURL implements UseCase.RegenerateCalendarFeedURL
+// Issues a new feed URL; URLs issued before stop working
+func (u *UseCaseImpl) RegenerateCalendarFeedURL(ctx context.Context, userID string) (string, error) {
+	if userID == "" {
+		return "", fmt.Errorf("Не авторизован")
+	}
+
+	version, err := u.userRepo.IncrementCalendarTokenVersion(ctx, userID)
+	if err != nil {
+		return "", apperrors.WrapError("Не удалось обновить ссылку на календарь", err)
+	}
+
+	return calendarFeedURL(userID, version)
+}
+
+// calendarFeedURL builds the personal calendar feed URL for a token version
+func calendarFeedURL(userID string, version int) (string, error) {
+	token, err := auth.SignCalendarToken(userID, version)
+	if err != nil {
+		return "", apperrors.WrapError("Не удалось создать токен календаря", err)
+	}
+
+	return fmt.Sprintf("/api/calendar/personal/%s.ics", token), nil
+}
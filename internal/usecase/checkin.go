@@ -0,0 +1,235 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/skip2/go-qrcode"
+
+	"github.com/cnpf/feeder-backend/graph/model"
+	"github.com/cnpf/feeder-backend/graph/scalars"
+	"github.com/cnpf/feeder-backend/internal/auth"
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	apperrors "github.com/cnpf/feeder-backend/internal/errors"
+	"github.com/cnpf/feeder-backend/internal/export"
+	"github.com/cnpf/feeder-backend/internal/notify"
+)
+
+const checkInQRSize = 512 // PNG size in pixels
+
+// GetRegistrationQRCode implements UseCase.GetRegistrationQRCode
+// The QR code encodes the signed check-in token; available to the registration owner, judges and admins
+func (u *UseCaseImpl) GetRegistrationQRCode(ctx context.Context, userID string, registrationID string) (*ExportFile, error) {
+	reg, err := u.registrationRepo.FindByID(ctx, registrationID)
+	if err != nil {
+		return nil, fmt.Errorf("Регистрация не найдена")
+	}
+
+	if reg.UserID != userID {
+		competition, err := u.competitionRepo.FindByID(ctx, reg.CompetitionID)
+		if err != nil {
+			return nil, fmt.Errorf("Соревнование не найдено")
+		}
+		if err := u.checkJudge(ctx, userID, competition); err != nil {
+			return nil, fmt.Errorf("Доступ запрещен")
+		}
+	}
+
+	png, err := registrationQRCode(reg)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось создать QR-код", err)
+	}
+
+	return &ExportFile{
+		Data:        png,
+		ContentType: "image/png",
+		FileName:    fmt.Sprintf("checkin-%s.png", reg.ID),
+	}, nil
+}
+
+// CheckIn implements UseCase.CheckIn
+// Scanning the same code twice for a tour is not an error: the first check-in is returned
+func (u *UseCaseImpl) CheckIn(ctx context.Context, userID string, code string, tour int) (*model.CheckInResult, error) {
+	registrationID, competitionID, err := auth.VerifyCheckInToken(strings.TrimSpace(code))
+	if err != nil {
+		return nil, fmt.Errorf("Неверный QR-код")
+	}
+
+	competition, err := u.competitionRepo.FindByID(ctx, competitionID)
+	if err != nil {
+		return nil, fmt.Errorf("Соревнование не найдено")
+	}
+	if err := u.checkJudge(ctx, userID, competition); err != nil {
+		return nil, err
+	}
+	if tour < 1 || tour > len(competition.Tours) {
+		return nil, fmt.Errorf("Неверный номер тура (доступно туров: %d)", len(competition.Tours))
+	}
+
+	reg, err := u.registrationRepo.FindByID(ctx, registrationID)
+	if err != nil || reg.CompetitionID != competition.ID {
+		return nil, fmt.Errorf("Регистрация не найдена")
+	}
+
+	checkIn, created, err := u.checkInRepo.Create(ctx, &entity.CheckIn{
+		CompetitionID:  competition.ID,
+		RegistrationID: reg.ID,
+		Tour:           tour,
+		JudgeID:        userID,
+	})
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось отметить прибытие", err)
+	}
+
+	return &model.CheckInResult{
+		CheckIn:          u.entityToGraphQLCheckIn(checkIn, reg, userID),
+		AlreadyCheckedIn: !created,
+	}, nil
+}
+
+// CancelCheckIn implements UseCase.CancelCheckIn
+func (u *UseCaseImpl) CancelCheckIn(ctx context.Context, userID string, registrationID string, tour int) (bool, error) {
+	reg, err := u.registrationRepo.FindByID(ctx, registrationID)
+	if err != nil {
+		return false, fmt.Errorf("Регистрация не найдена")
+	}
+
+	competition, err := u.competitionRepo.FindByID(ctx, reg.CompetitionID)
+	if err != nil {
+		return false, fmt.Errorf("Соревнование не найдено")
+	}
+	if err := u.checkJudge(ctx, userID, competition); err != nil {
+		return false, err
+	}
+
+	if err := u.checkInRepo.Delete(ctx, registrationID, tour); err != nil {
+		return false, apperrors.WrapError("Не удалось отменить отметку", err)
+	}
+	return true, nil
+}
+
+// GetCheckInStatus implements UseCase.GetCheckInStatus
+// Lists who has arrived for the tour (in scan order) and who has not yet (by sector and peg)
+func (u *UseCaseImpl) GetCheckInStatus(ctx context.Context, userID string, competitionID string, tour int) (*model.CheckInStatus, error) {
+	competition, err := u.competitionRepo.FindByID(ctx, competitionID)
+	if err != nil {
+		return nil, fmt.Errorf("Соревнование не найдено")
+	}
+	if err := u.checkJudge(ctx, userID, competition); err != nil {
+		return nil, err
+	}
+	if tour < 1 || tour > len(competition.Tours) {
+		return nil, fmt.Errorf("Неверный номер тура (доступно туров: %d)", len(competition.Tours))
+	}
+
+	registrations, err := u.registrationRepo.FindByCompetitionID(ctx, competitionID)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить регистрации", err)
+	}
+
+	checkIns, err := u.checkInRepo.FindByCompetitionAndTour(ctx, competitionID, tour)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить отметки", err)
+	}
+
+	regsByID := make(map[string]*entity.Registration, len(registrations))
+	for _, reg := range registrations {
+		regsByID[reg.ID] = reg
+	}
+
+	arrived := make([]*model.CheckIn, 0, len(checkIns))
+	arrivedIDs := make(map[string]bool, len(checkIns))
+	for _, c := range checkIns {
+		reg, ok := regsByID[c.RegistrationID]
+		if !ok {
+			continue
+		}
+		arrivedIDs[reg.ID] = true
+		arrived = append(arrived, u.entityToGraphQLCheckIn(c, reg, userID))
+	}
+
+	missing := make([]*model.Registration, 0, len(registrations)-len(arrived))
+	for _, reg := range export.SortBySectorAndPeg(registrations) {
+		if !arrivedIDs[reg.ID] {
+			missing = append(missing, u.entityToGraphQLRegistration(reg, userID))
+		}
+	}
+
+	return &model.CheckInStatus{
+		CompetitionID: competitionID,
+		Tour:          tour,
+		Total:         len(registrations),
+		ArrivedCount:  len(arrived),
+		Arrived:       arrived,
+		Missing:       missing,
+	}, nil
+}
+
+// sendRegistrationConfirmation e-mails the registration details with the check-in QR code attached
+func (u *UseCaseImpl) sendRegistrationConfirmation(user *entity.User, competition *entity.Competition, reg *entity.Registration) {
+	if user.Email == "" {
+		return
+	}
+
+	go func() {
+		png, err := registrationQRCode(reg)
+		if err != nil {
+			log.Printf("Failed to create QR code for registration %s: %v", reg.ID, err)
+			return
+		}
+
+		body := fmt.Sprintf("Регистрация на соревнование «%s» подтверждена.\n\nУчастник / команда: %s\n",
+			competition.Title, export.RegistrationName(reg))
+		if competition.StartDate != nil {
+			body += fmt.Sprintf("Дата начала: %s\n", competition.StartDate.Format("02.01.2006"))
+		}
+		body += "\nПокажите QR-код из вложения судье при прибытии на каждый тур."
+
+		msg := notify.Message{
+			To:      user.Email,
+			Subject: fmt.Sprintf("Регистрация: %s", competition.Title),
+			Body:    body,
+			Attachments: []notify.Attachment{{
+				FileName:    "checkin.png",
+				ContentType: "image/png",
+				Data:        png,
+			}},
+		}
+		if err := u.mailer.Send(context.Background(), msg); err != nil {
+			log.Printf("Failed to send registration confirmation: %v", err)
+		}
+	}()
+}
+
+// registrationQRCode renders the check-in token of a registration as a PNG QR code
+func registrationQRCode(reg *entity.Registration) ([]byte, error) {
+	token, err := auth.SignCheckInToken(reg.ID, reg.CompetitionID)
+	if err != nil {
+		return nil, err
+	}
+	return qrcode.Encode(token, qrcode.Medium, checkInQRSize)
+}
+
+// Helper function to convert entity.CheckIn to model.CheckIn
+func (u *UseCaseImpl) entityToGraphQLCheckIn(checkIn *entity.CheckIn, reg *entity.Registration, currentUserID string) *model.CheckIn {
+	return &model.CheckIn{
+		Registration: u.entityToGraphQLRegistration(reg, currentUserID),
+		Tour:         checkIn.Tour,
+		JudgeID:      checkIn.JudgeID,
+		CheckedInAt:  scalars.Time(checkIn.CreatedAt),
+	}
+}
+
+// checkInCodeFor returns the signed check-in token for users who can edit the registration
+func checkInCodeFor(reg *entity.Registration, canEdit bool) *string {
+	if !canEdit {
+		return nil
+	}
+	token, err := auth.SignCheckInToken(reg.ID, reg.CompetitionID)
+	if err != nil {
+		return nil
+	}
+	return &token
+}
@@ -0,0 +1,328 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cnpf/feeder-backend/graph/model"
+	"github.com/cnpf/feeder-backend/graph/scalars"
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	apperrors "github.com/cnpf/feeder-backend/internal/errors"
+)
+
+const (
+	commentEditWindow      = 15 * time.Minute
+	maxCommentLength       = 2000
+	defaultModerationLimit = 50
+	maxModerationLimit     = 200
+)
+
+// commentViewer is the user reading comments; admins moderate and see hidden comments
+type commentViewer struct {
+	id      string
+	isAdmin bool
+}
+
+func (u *UseCaseImpl) commentViewer(ctx context.Context, userID string) commentViewer {
+	if userID == "" {
+		return commentViewer{}
+	}
+	user, err := u.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return commentViewer{}
+	}
+	return commentViewer{id: user.ID, isAdmin: user.IsAdmin}
+}
+
+// canSee reports whether the comment is shown to the viewer: hidden comments only to their author and admins
+func (v commentViewer) canSee(comment *entity.Comment) bool {
+	return comment.Status != entity.CommentStatusHidden || v.isAdmin || (v.id != "" && v.id == comment.AuthorID)
+}
+
+// canEdit mirrors Report.canEdit: the author or an admin
+func (v commentViewer) canEdit(comment *entity.Comment) bool {
+	return !comment.Deleted && v.id != "" && (v.isAdmin || v.id == comment.AuthorID)
+}
+
+// commentableReport finds a report the user may read and discuss: published, or a draft of its editors
+func (u *UseCaseImpl) commentableReport(ctx context.Context, userID string, reportID string) (*entity.Report, error) {
+	report, err := u.reportRepo.FindByID(ctx, reportID)
+	if err != nil {
+		return nil, fmt.Errorf("Отчет не найден")
+	}
+	if !report.IsPublished() && !u.canEditReport(ctx, userID, report) {
+		return nil, fmt.Errorf("Отчет не найден")
+	}
+	return report, nil
+}
+
+// GetComments implements UseCase.GetComments
+// Comments are returned in the order they were written; clients build threads from parentId
+func (u *UseCaseImpl) GetComments(ctx context.Context, userID string, reportID string) ([]*model.Comment, error) {
+	if _, err := u.commentableReport(ctx, userID, reportID); err != nil {
+		return nil, err
+	}
+
+	comments, err := u.commentRepo.FindByReportID(ctx, reportID)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить комментарии", err)
+	}
+
+	viewer := u.commentViewer(ctx, userID)
+	visible := make([]*entity.Comment, 0, len(comments))
+	for _, comment := range comments {
+		if viewer.canSee(comment) {
+			visible = append(visible, comment)
+		}
+	}
+	return u.commentsToGraphQL(ctx, visible, viewer), nil
+}
+
+// CreateComment implements UseCase.CreateComment
+// Comments of admins are approved right away, other comments wait in the moderation queue while visible
+func (u *UseCaseImpl) CreateComment(ctx context.Context, userID string, input *model.CommentInput) (*model.Comment, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	if input == nil {
+		return nil, fmt.Errorf("Входные данные не могут быть пустыми")
+	}
+
+	report, err := u.commentableReport(ctx, userID, input.ReportID)
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := validateCommentText(input.Text)
+	if err != nil {
+		return nil, err
+	}
+
+	var parent *entity.Comment
+	if input.ParentID != nil && *input.ParentID != "" {
+		parent, err = u.commentRepo.FindByID(ctx, *input.ParentID)
+		if err != nil || parent.ReportID != report.ID {
+			return nil, fmt.Errorf("Комментарий для ответа не найден")
+		}
+		if parent.Deleted || parent.Status == entity.CommentStatusHidden {
+			return nil, fmt.Errorf("Нельзя ответить на удаленный или скрытый комментарий")
+		}
+	}
+
+	viewer := u.commentViewer(ctx, userID)
+	comment := &entity.Comment{
+		ReportID: report.ID,
+		AuthorID: userID,
+		Text:     text,
+		Status:   entity.CommentStatusNew,
+	}
+	if parent != nil {
+		comment.ParentID = &parent.ID
+	}
+	if viewer.isAdmin {
+		comment.Status = entity.CommentStatusApproved
+	}
+
+	commentID, err := u.commentRepo.Create(ctx, comment)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось добавить комментарий", err)
+	}
+	created, err := u.commentRepo.FindByID(ctx, commentID)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось найти добавленный комментарий", err)
+	}
+
+	var recipients []string
+	if report.AuthorID != userID {
+		recipients = append(recipients, report.AuthorID)
+	}
+	if parent != nil && parent.AuthorID != userID {
+		recipients = append(recipients, parent.AuthorID)
+	}
+	u.notifyUsers(ctx, recipients, entity.Notification{
+		Type:       "comment_created",
+		Title:      fmt.Sprintf("Новый комментарий: %s", report.Title),
+		Message:    fmt.Sprintf("Новый комментарий к отчету «%s»:\n\n%s", report.Title, text),
+		EntityType: "report",
+		EntityID:   report.ID,
+	})
+
+	return u.entityToGraphQLComment(ctx, created, viewer, nil), nil
+}
+
+// UpdateComment implements UseCase.UpdateComment
+// Authors can edit their comments within the edit window, admins at any time;
+// an approved comment edited by its author goes back to the moderation queue
+func (u *UseCaseImpl) UpdateComment(ctx context.Context, userID string, id string, text string) (*model.Comment, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+
+	comment, err := u.commentRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("Комментарий не найден")
+	}
+	viewer := u.commentViewer(ctx, userID)
+	if !viewer.canEdit(comment) {
+		return nil, fmt.Errorf("Доступ запрещен")
+	}
+	if !viewer.isAdmin && time.Since(comment.CreatedAt) > commentEditWindow {
+		return nil, fmt.Errorf("Комментарий можно изменить только в течение %d минут после публикации", int(commentEditWindow.Minutes()))
+	}
+
+	text, err = validateCommentText(text)
+	if err != nil {
+		return nil, err
+	}
+	// An edit by the author has to be checked again, otherwise approved text could be replaced freely
+	if err := u.commentRepo.UpdateText(ctx, id, text, !viewer.isAdmin); err != nil {
+		return nil, apperrors.WrapError("Не удалось изменить комментарий", err)
+	}
+
+	return u.reloadComment(ctx, id, viewer)
+}
+
+// DeleteComment implements UseCase.DeleteComment
+// A comment with replies stays in the thread as a placeholder without text
+func (u *UseCaseImpl) DeleteComment(ctx context.Context, userID string, id string) (bool, error) {
+	if userID == "" {
+		return false, fmt.Errorf("Не авторизован")
+	}
+
+	comment, err := u.commentRepo.FindByID(ctx, id)
+	if err != nil {
+		return false, fmt.Errorf("Комментарий не найден")
+	}
+	if !u.commentViewer(ctx, userID).canEdit(comment) {
+		return false, fmt.Errorf("Доступ запрещен")
+	}
+
+	hasReplies, err := u.commentRepo.HasReplies(ctx, id)
+	if err != nil {
+		return false, apperrors.WrapError("Не удалось удалить комментарий", err)
+	}
+	if hasReplies {
+		err = u.commentRepo.MarkDeleted(ctx, id)
+	} else {
+		err = u.commentRepo.Delete(ctx, id)
+	}
+	if err != nil {
+		return false, apperrors.WrapError("Не удалось удалить комментарий", err)
+	}
+
+	return true, nil
+}
+
+// GetCommentModerationQueue implements UseCase.GetCommentModerationQueue
+// Returns comments nobody has reviewed yet, oldest first
+func (u *UseCaseImpl) GetCommentModerationQueue(ctx context.Context, userID string, limit *int) ([]*model.Comment, error) {
+	viewer := u.commentViewer(ctx, userID)
+	if !viewer.isAdmin {
+		return nil, fmt.Errorf("Доступ запрещен")
+	}
+
+	queueLimit := defaultModerationLimit
+	if limit != nil && *limit > 0 {
+		queueLimit = min(*limit, maxModerationLimit)
+	}
+
+	comments, err := u.commentRepo.FindByStatus(ctx, entity.CommentStatusNew, queueLimit)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить комментарии", err)
+	}
+	return u.commentsToGraphQL(ctx, comments, viewer), nil
+}
+
+// HideComment implements UseCase.HideComment
+func (u *UseCaseImpl) HideComment(ctx context.Context, userID string, id string) (*model.Comment, error) {
+	return u.moderateComment(ctx, userID, id, entity.CommentStatusHidden)
+}
+
+// ApproveComment implements UseCase.ApproveComment
+// Approving a hidden comment shows it again
+func (u *UseCaseImpl) ApproveComment(ctx context.Context, userID string, id string) (*model.Comment, error) {
+	return u.moderateComment(ctx, userID, id, entity.CommentStatusApproved)
+}
+
+func (u *UseCaseImpl) moderateComment(ctx context.Context, userID string, id string, status entity.CommentStatus) (*model.Comment, error) {
+	viewer := u.commentViewer(ctx, userID)
+	if !viewer.isAdmin {
+		return nil, fmt.Errorf("Доступ запрещен")
+	}
+
+	comment, err := u.commentRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("Комментарий не найден")
+	}
+	if comment.Deleted {
+		return nil, fmt.Errorf("Комментарий удален")
+	}
+
+	if err := u.commentRepo.SetStatus(ctx, id, status, userID); err != nil {
+		return nil, apperrors.WrapError("Не удалось изменить статус комментария", err)
+	}
+	return u.reloadComment(ctx, id, viewer)
+}
+
+func (u *UseCaseImpl) reloadComment(ctx context.Context, id string, viewer commentViewer) (*model.Comment, error) {
+	comment, err := u.commentRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось найти обновленный комментарий", err)
+	}
+	return u.entityToGraphQLComment(ctx, comment, viewer, nil), nil
+}
+
+func validateCommentText(text string) (string, error) {
+	text = strings.TrimSpace(text)
+	if text == "" || len([]rune(text)) > maxCommentLength {
+		return "", fmt.Errorf("Текст комментария должен быть от 1 до %d символов", maxCommentLength)
+	}
+	return text, nil
+}
+
+// commentsToGraphQL converts comments, loading each author once
+func (u *UseCaseImpl) commentsToGraphQL(ctx context.Context, comments []*entity.Comment, viewer commentViewer) []*model.Comment {
+	authors := make(map[string]*model.Author)
+	result := make([]*model.Comment, len(comments))
+	for i, comment := range comments {
+		result[i] = u.entityToGraphQLComment(ctx, comment, viewer, authors)
+	}
+	return result
+}
+
+// Helper function to convert entity.Comment to model.Comment; authors caches converted authors (may be nil)
+func (u *UseCaseImpl) entityToGraphQLComment(ctx context.Context, comment *entity.Comment, viewer commentViewer, authors map[string]*model.Author) *model.Comment {
+	author, ok := authors[comment.AuthorID]
+	if !ok {
+		user, err := u.userRepo.FindByID(ctx, comment.AuthorID)
+		if err != nil {
+			user = deletedAuthor(comment.AuthorID)
+		}
+		author = entityToGraphQLAuthor(user)
+		if authors != nil {
+			authors[comment.AuthorID] = author
+		}
+	}
+
+	var editedAt *scalars.Time
+	if comment.EditedAt != nil {
+		t := scalars.Time(*comment.EditedAt)
+		editedAt = &t
+	}
+
+	return &model.Comment{
+		ID:        comment.ID,
+		ReportID:  comment.ReportID,
+		ParentID:  comment.ParentID,
+		AuthorID:  comment.AuthorID,
+		Author:    author,
+		Text:      comment.Text,
+		Status:    string(comment.Status),
+		Deleted:   comment.Deleted,
+		CanEdit:   viewer.canEdit(comment),
+		EditedAt:  editedAt,
+		CreatedAt: scalars.Time(comment.CreatedAt),
+	}
+}
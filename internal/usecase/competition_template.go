@@ -0,0 +1,298 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cnpf/feeder-backend/graph/model"
+	"github.com/cnpf/feeder-backend/graph/scalars"
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	apperrors "github.com/cnpf/feeder-backend/internal/errors"
+)
+
+const maxTemplateNameLength = 120
+
+// DuplicateCompetition implements UseCase.DuplicateCompetition
+// With shiftDates (default) all dates move so that the copy starts at startDate,
+// or one year later when startDate is omitted; otherwise the dates are copied as is
+func (u *UseCaseImpl) DuplicateCompetition(ctx context.Context, id string, shiftDates *bool, startDate *string) (*model.Competition, error) {
+	competition, err := u.competitionRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("Соревнование не найдено")
+	}
+
+	template, err := templateFromCompetition(competition)
+	if err != nil {
+		return nil, err
+	}
+
+	newStart := *competition.StartDate
+	if shiftDates == nil || *shiftDates {
+		newStart = newStart.AddDate(1, 0, 0)
+		if startDate != nil && *startDate != "" {
+			newStart, err = time.Parse(time.RFC3339, *startDate)
+			if err != nil {
+				return nil, fmt.Errorf("Неверная дата начала: %w", err)
+			}
+		}
+	} else if startDate != nil && *startDate != "" {
+		return nil, fmt.Errorf("Дата начала указывается только при сдвиге дат")
+	}
+
+	input := competitionInputFromTemplate(template, newStart)
+	return u.CreateCompetition(ctx, input)
+}
+
+// SaveCompetitionTemplate implements UseCase.SaveCompetitionTemplate
+func (u *UseCaseImpl) SaveCompetitionTemplate(ctx context.Context, competitionID string, name string) (*model.CompetitionTemplate, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len([]rune(name)) > maxTemplateNameLength {
+		return nil, fmt.Errorf("Название шаблона должно быть от 1 до %d символов", maxTemplateNameLength)
+	}
+
+	competition, err := u.competitionRepo.FindByID(ctx, competitionID)
+	if err != nil {
+		return nil, fmt.Errorf("Соревнование не найдено")
+	}
+
+	template, err := templateFromCompetition(competition)
+	if err != nil {
+		return nil, err
+	}
+	template.Name = name
+
+	templateID, err := u.templateRepo.Create(ctx, template)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось сохранить шаблон", err)
+	}
+
+	createdTemplate, err := u.templateRepo.FindByID(ctx, templateID)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось найти созданный шаблон", err)
+	}
+
+	return entityToGraphQLCompetitionTemplate(createdTemplate), nil
+}
+
+// GetCompetitionTemplates implements UseCase.GetCompetitionTemplates
+func (u *UseCaseImpl) GetCompetitionTemplates(ctx context.Context) ([]*model.CompetitionTemplate, error) {
+	templates, err := u.templateRepo.FindAll(ctx)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить шаблоны", err)
+	}
+
+	result := make([]*model.CompetitionTemplate, 0, len(templates))
+	for _, template := range templates {
+		result = append(result, entityToGraphQLCompetitionTemplate(template))
+	}
+	return result, nil
+}
+
+// GetCompetitionPrefill implements UseCase.GetCompetitionPrefill
+func (u *UseCaseImpl) GetCompetitionPrefill(ctx context.Context, templateID string, startDate string) (*model.CompetitionPrefill, error) {
+	template, err := u.templateRepo.FindByID(ctx, templateID)
+	if err != nil {
+		return nil, fmt.Errorf("Шаблон не найден")
+	}
+
+	start, err := time.Parse(time.RFC3339, startDate)
+	if err != nil {
+		return nil, fmt.Errorf("Неверная дата начала: %w", err)
+	}
+
+	input := competitionInputFromTemplate(template, start)
+
+	tours := make([]*model.TourPrefill, len(input.Tours))
+	for i, tour := range input.Tours {
+		tours[i] = &model.TourPrefill{
+			Date: tour.Date,
+			Time: tour.Time,
+		}
+	}
+
+	return &model.CompetitionPrefill{
+		Title:                input.Title,
+		StartDate:            input.StartDate,
+		EndDate:              input.EndDate,
+		Location:             input.Location,
+		VenueID:              input.VenueID,
+		Tours:                tours,
+		OpeningDate:          input.OpeningDate,
+		OpeningTime:          input.OpeningTime,
+		IndividualFormat:     input.IndividualFormat,
+		TeamFormat:           input.TeamFormat,
+		Fee:                  input.Fee,
+		TeamLimit:            input.TeamLimit,
+		Regulations:          input.Regulations,
+		ProtestWindowMinutes: input.ProtestWindowMinutes,
+		Eligibility:          entityToGraphQLEligibility(template.Eligibility),
+		TeamRules:            templateTeamRules(template),
+	}, nil
+}
+
+// DeleteCompetitionTemplate implements UseCase.DeleteCompetitionTemplate
+func (u *UseCaseImpl) DeleteCompetitionTemplate(ctx context.Context, id string) (bool, error) {
+	if err := u.templateRepo.Delete(ctx, id); err != nil {
+		return false, apperrors.WrapError("Не удалось удалить шаблон", err)
+	}
+	return true, nil
+}
+
+// templateFromCompetition converts the dates of a competition into offsets from its start date
+func templateFromCompetition(competition *entity.Competition) (*entity.CompetitionTemplate, error) {
+	if competition.StartDate == nil {
+		return nil, fmt.Errorf("У соревнования не указана дата начала")
+	}
+	start := *competition.StartDate
+
+	tours := make([]entity.TemplateTour, len(competition.Tours))
+	for i, tour := range competition.Tours {
+		tours[i] = entity.TemplateTour{
+			DayOffset: daysBetween(start, tour.Date),
+			Time:      tour.Time,
+		}
+	}
+
+	// Without a valid end date (legacy records) the competition ends with its last tour, or on the start date
+	durationDays := 0
+	if competition.EndDate != nil && !competition.EndDate.Before(start) {
+		durationDays = daysBetween(start, *competition.EndDate)
+	} else {
+		for _, tour := range tours {
+			if tour.DayOffset > durationDays {
+				durationDays = tour.DayOffset
+			}
+		}
+	}
+
+	var openingOffset *int
+	if competition.OpeningDate != nil {
+		o := daysBetween(start, *competition.OpeningDate)
+		openingOffset = &o
+	}
+
+	return &entity.CompetitionTemplate{
+		Title:             competition.Title,
+		Location:          competition.Location,
+		VenueID:           competition.VenueID,
+		DurationDays:      durationDays,
+		Tours:             tours,
+		OpeningOffsetDays: openingOffset,
+		OpeningTime:       competition.OpeningTime,
+		IndividualFormat:  competition.IndividualFormat,
+		TeamFormat:        competition.TeamFormat,
+		Fee:               competition.Fee,
+		TeamLimit:         competition.TeamLimit,
+		Regulations:       competition.Regulations,
+		ProtestWindow:     competition.ProtestWindow,
+		Eligibility:       competition.Eligibility,
+		TeamRules:         competition.TeamRules,
+	}, nil
+}
+
+// competitionInputFromTemplate builds CompetitionInput with all dates placed relative to start
+func competitionInputFromTemplate(template *entity.CompetitionTemplate, start time.Time) *model.CompetitionInput {
+	tours := make([]*model.TourInput, len(template.Tours))
+	for i, tour := range template.Tours {
+		tours[i] = &model.TourInput{
+			Date: start.AddDate(0, 0, tour.DayOffset).Format(time.RFC3339),
+			Time: tour.Time,
+		}
+	}
+
+	var openingDate *string
+	if template.OpeningOffsetDays != nil {
+		d := start.AddDate(0, 0, *template.OpeningOffsetDays).Format(time.RFC3339)
+		openingDate = &d
+	}
+
+	var fee *string
+	if template.Fee != nil {
+		f := strconv.FormatFloat(*template.Fee, 'f', -1, 64)
+		fee = &f
+	}
+
+	var teamLimit *string
+	if template.TeamLimit != nil {
+		t := strconv.Itoa(*template.TeamLimit)
+		teamLimit = &t
+	}
+
+	return &model.CompetitionInput{
+		Title:                template.Title,
+		StartDate:            start.Format(time.RFC3339),
+		EndDate:              start.AddDate(0, 0, template.DurationDays).Format(time.RFC3339),
+		Location:             template.Location,
+		VenueID:              template.VenueID,
+		Tours:                tours,
+		OpeningDate:          openingDate,
+		OpeningTime:          template.OpeningTime,
+		IndividualFormat:     template.IndividualFormat,
+		TeamFormat:           template.TeamFormat,
+		Fee:                  fee,
+		TeamLimit:            teamLimit,
+		Regulations:          template.Regulations,
+		ProtestWindowMinutes: template.ProtestWindow,
+		Eligibility:          eligibilityToInput(template.Eligibility),
+		TeamRules:            teamRulesToInput(template.TeamRules),
+	}
+}
+
+// daysBetween returns whole calendar days from a to b (rounded, so DST shifts do not matter)
+func daysBetween(a, b time.Time) int {
+	return int(math.Round(b.Sub(a).Hours() / 24))
+}
+
+// Helper function to convert entity.CompetitionTemplate to model.CompetitionTemplate
+func entityToGraphQLCompetitionTemplate(template *entity.CompetitionTemplate) *model.CompetitionTemplate {
+	if template == nil {
+		return nil
+	}
+
+	tours := make([]*model.TemplateTour, len(template.Tours))
+	for i, tour := range template.Tours {
+		tours[i] = &model.TemplateTour{
+			DayOffset: tour.DayOffset,
+			Time:      tour.Time,
+		}
+	}
+
+	var createdAt *scalars.Time
+	if !template.CreatedAt.IsZero() {
+		t := scalars.Time(template.CreatedAt)
+		createdAt = &t
+	}
+
+	return &model.CompetitionTemplate{
+		ID:                   template.ID,
+		Name:                 template.Name,
+		Title:                template.Title,
+		Location:             template.Location,
+		VenueID:              template.VenueID,
+		DurationDays:         template.DurationDays,
+		Tours:                tours,
+		OpeningOffsetDays:    template.OpeningOffsetDays,
+		OpeningTime:          template.OpeningTime,
+		IndividualFormat:     template.IndividualFormat,
+		TeamFormat:           template.TeamFormat,
+		Fee:                  template.Fee,
+		TeamLimit:            template.TeamLimit,
+		Regulations:          template.Regulations,
+		ProtestWindowMinutes: template.ProtestWindow,
+		Eligibility:          entityToGraphQLEligibility(template.Eligibility),
+		TeamRules:            templateTeamRules(template),
+		CreatedAt:            createdAt,
+	}
+}
+
+// templateTeamRules returns the team rules of a template, nil when the default rules apply
+func templateTeamRules(template *entity.CompetitionTemplate) *model.TeamRules {
+	if template.TeamRules == nil {
+		return nil
+	}
+	return entityToGraphQLTeamRules(*template.TeamRules)
+}
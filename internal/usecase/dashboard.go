@@ -0,0 +1,247 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/cnpf/feeder-backend/graph/model"
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	apperrors "github.com/cnpf/feeder-backend/internal/errors"
+)
+
+// Payment statuses of a registration
+const (
+	paymentStatusNotRequired = "not_required"
+	paymentStatusPending     = "pending"
+	paymentStatusPaid        = "paid"
+)
+
+const (
+	dashboardResultsLimit = 5
+	dashboardReportsLimit = 5
+)
+
+// userRegistration is a registration of the user together with its competition
+type userRegistration struct {
+	registration *entity.Registration
+	competition  *entity.Competition
+}
+
+// SetRegistrationPaid implements UseCase.SetRegistrationPaid
+func (u *UseCaseImpl) SetRegistrationPaid(ctx context.Context, userID string, registrationID string, paid bool) (*model.Registration, error) {
+	var paidAt *time.Time
+	if paid {
+		now := time.Now()
+		paidAt = &now
+	}
+
+	if err := u.registrationRepo.SetPaid(ctx, registrationID, paidAt); err != nil {
+		return nil, apperrors.WrapError("Не удалось обновить оплату", err)
+	}
+
+	reg, err := u.registrationRepo.FindByID(ctx, registrationID)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось найти обновленную регистрацию", err)
+	}
+	return u.entityToGraphQLRegistration(reg, userID), nil
+}
+
+// GetMyRegistrations implements UseCase.GetMyRegistrations
+// Ordered by competition start date, newest first; upcomingOnly keeps competitions that have not ended yet
+func (u *UseCaseImpl) GetMyRegistrations(ctx context.Context, userID string, upcomingOnly bool) ([]*model.MyRegistration, error) {
+	items, err := u.loadUserRegistrations(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	result := make([]*model.MyRegistration, 0, len(items))
+	for i := len(items) - 1; i >= 0; i-- {
+		if upcomingOnly && !isUpcoming(items[i].competition, now) {
+			continue
+		}
+		myReg, err := u.toMyRegistration(items[i], userID)
+		if err != nil {
+			continue
+		}
+		result = append(result, myReg)
+	}
+	return result, nil
+}
+
+// GetDashboard implements UseCase.GetDashboard
+// Upcoming competitions go soonest first; recent results are taken from started competitions with results;
+// reports are the user's published reports, drafts include scheduled ones
+func (u *UseCaseImpl) GetDashboard(ctx context.Context, userID string) (*model.Dashboard, error) {
+	items, err := u.loadUserRegistrations(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	dashboard := &model.Dashboard{
+		Upcoming:      make([]*model.MyRegistration, 0),
+		RecentResults: make([]*model.CompetitionResultSummary, 0, dashboardResultsLimit),
+	}
+
+	for _, item := range items {
+		if !isUpcoming(item.competition, now) {
+			continue
+		}
+		myReg, err := u.toMyRegistration(item, userID)
+		if err != nil {
+			continue
+		}
+		dashboard.Upcoming = append(dashboard.Upcoming, myReg)
+	}
+
+	for i := len(items) - 1; i >= 0 && len(dashboard.RecentResults) < dashboardResultsLimit; i-- {
+		item := items[i]
+		if item.competition.StartDate == nil || item.competition.StartDate.After(now) {
+			continue
+		}
+		summary, err := u.resultSummary(ctx, item, userID)
+		if err != nil {
+			log.Printf("Failed to compute results of competition %s: %v", item.competition.ID, err)
+			continue
+		}
+		if summary != nil {
+			dashboard.RecentResults = append(dashboard.RecentResults, summary)
+		}
+	}
+
+	reports, err := u.reportRepo.FindByAuthorID(ctx, userID, dashboardReportsLimit)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить отчеты", err)
+	}
+	dashboard.Reports = u.reportsToGraphQL(ctx, reports, userID)
+
+	drafts, err := u.reportRepo.FindUnpublished(ctx, &userID, dashboardReportsLimit)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить черновики", err)
+	}
+	dashboard.Drafts = u.reportsToGraphQL(ctx, drafts, userID)
+
+	unread, err := u.notificationRepo.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить уведомления", err)
+	}
+	dashboard.UnreadNotificationsCount = int(unread)
+
+	return dashboard, nil
+}
+
+// loadUserRegistrations loads the registrations of a user with their competitions,
+// ordered by competition start date (oldest first); registrations of removed competitions are skipped
+func (u *UseCaseImpl) loadUserRegistrations(ctx context.Context, userID string) ([]userRegistration, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+
+	registrations, err := u.registrationRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить регистрации", err)
+	}
+
+	competitions := make(map[string]*entity.Competition)
+	items := make([]userRegistration, 0, len(registrations))
+	for _, reg := range registrations {
+		competition, ok := competitions[reg.CompetitionID]
+		if !ok {
+			competition, err = u.competitionRepo.FindByID(ctx, reg.CompetitionID)
+			if err != nil {
+				continue
+			}
+			competitions[reg.CompetitionID] = competition
+		}
+		items = append(items, userRegistration{registration: reg, competition: competition})
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return startDate(items[i].competition).Before(startDate(items[j].competition))
+	})
+	return items, nil
+}
+
+// resultSummary returns the standings line of the user's registration, nil if the competition has no results yet
+func (u *UseCaseImpl) resultSummary(ctx context.Context, item userRegistration, userID string) (*model.CompetitionResultSummary, error) {
+	_, rows, err := u.computeStandings(ctx, item.competition.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	hasResults := false
+	for _, row := range rows {
+		if row.TotalWeight > 0 || row.TotalFish > 0 {
+			hasResults = true
+			break
+		}
+	}
+	if !hasResults {
+		return nil, nil
+	}
+
+	for _, row := range rows {
+		if row.Registration.ID != item.registration.ID {
+			continue
+		}
+		competition, err := u.entityToGraphQLCompetition(item.competition)
+		if err != nil {
+			return nil, err
+		}
+		place := row.Place
+		return &model.CompetitionResultSummary{
+			Competition:       competition,
+			Registration:      u.entityToGraphQLRegistration(row.Registration, userID),
+			Place:             &place,
+			TotalParticipants: len(rows),
+			TotalWeight:       row.TotalWeight,
+			TotalFish:         row.TotalFish,
+			Disqualified:      row.Disqualified,
+		}, nil
+	}
+	return nil, nil
+}
+
+func (u *UseCaseImpl) toMyRegistration(item userRegistration, userID string) (*model.MyRegistration, error) {
+	competition, err := u.entityToGraphQLCompetition(item.competition)
+	if err != nil {
+		return nil, err
+	}
+	return &model.MyRegistration{
+		Registration:  u.entityToGraphQLRegistration(item.registration, userID),
+		Competition:   competition,
+		PaymentStatus: paymentStatus(item.competition, item.registration),
+	}, nil
+}
+
+// paymentStatus reports whether the entry fee of a registration is paid
+func paymentStatus(competition *entity.Competition, reg *entity.Registration) string {
+	switch {
+	case reg.PaidAt != nil:
+		return paymentStatusPaid
+	case competition.Fee == nil || *competition.Fee <= 0:
+		return paymentStatusNotRequired
+	default:
+		return paymentStatusPending
+	}
+}
+
+// isUpcoming reports whether the competition has not ended yet (it may be running today)
+func isUpcoming(competition *entity.Competition, now time.Time) bool {
+	end := competition.EndDate
+	if end == nil {
+		end = competition.StartDate
+	}
+	return end == nil || !end.Before(now.Truncate(24*time.Hour))
+}
+
+func startDate(competition *entity.Competition) time.Time {
+	if competition.StartDate == nil {
+		return time.Time{}
+	}
+	return *competition.StartDate
+}
@@ -0,0 +1,273 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cnpf/feeder-backend/graph/model"
+	"github.com/cnpf/feeder-backend/graph/scalars"
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	apperrors "github.com/cnpf/feeder-backend/internal/errors"
+)
+
+// DeletedUserName is shown instead of the author of records kept after their user was deleted
+const DeletedUserName = "Удаленный пользователь"
+
+// Actions applied to related records when an entity is deleted
+const (
+	deletionActionDelete    = "delete"
+	deletionActionAnonymize = "anonymize"
+	deletionActionUnlink    = "unlink"
+)
+
+// userDeletionPlan describes what happens to the records of a user when the account is deleted:
+// registrations in competitions that have not started yet (with their results, penalties, protests and check-ins),
+// notifications, reactions and unattached uploads are deleted,
+// earlier registrations (with their results), reports and comments are anonymized, judge panels are unlinked
+type userDeletionPlan struct {
+	blockReasons           []string
+	deleteRegistrations    []string
+	anonymizeRegistrations []string
+	results                int64
+	penalties              int64
+	protests               int64
+	checkIns               int64
+	reports                int64
+	comments               int64
+	notifications          int64
+	reactions              int64
+	judgePanels            int
+}
+
+func (p *userDeletionPlan) effects() []*model.DeletionEffect {
+	return deletionEffects(
+		&model.DeletionEffect{Entity: "registrations", Action: deletionActionDelete, Count: len(p.deleteRegistrations)},
+		&model.DeletionEffect{Entity: "registrations", Action: deletionActionAnonymize, Count: len(p.anonymizeRegistrations)},
+		&model.DeletionEffect{Entity: "results", Action: deletionActionDelete, Count: int(p.results)},
+		&model.DeletionEffect{Entity: "penalties", Action: deletionActionDelete, Count: int(p.penalties)},
+		&model.DeletionEffect{Entity: "protests", Action: deletionActionDelete, Count: int(p.protests)},
+		&model.DeletionEffect{Entity: "checkIns", Action: deletionActionDelete, Count: int(p.checkIns)},
+		&model.DeletionEffect{Entity: "reports", Action: deletionActionAnonymize, Count: int(p.reports)},
+		&model.DeletionEffect{Entity: "comments", Action: deletionActionAnonymize, Count: int(p.comments)},
+		&model.DeletionEffect{Entity: "notifications", Action: deletionActionDelete, Count: int(p.notifications)},
+		&model.DeletionEffect{Entity: "reactions", Action: deletionActionDelete, Count: int(p.reactions)},
+		&model.DeletionEffect{Entity: "judgePanels", Action: deletionActionUnlink, Count: p.judgePanels},
+	)
+}
+
+// planUserDeletion collects the records affected by deleting a user and the reasons that block it
+func (u *UseCaseImpl) planUserDeletion(ctx context.Context, currentUserID string, id string) (*userDeletionPlan, error) {
+	user, err := u.userRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("Пользователь не найден")
+	}
+
+	plan := &userDeletionPlan{}
+	// The plan touches many collections; without a transaction a failure would leave the user half deleted
+	if !u.txManager.Supported() {
+		plan.blockReasons = append(plan.blockReasons, "MongoDB не поддерживает транзакции (нужен replica set)")
+	}
+	if user.ID == currentUserID {
+		plan.blockReasons = append(plan.blockReasons, "Нельзя удалить самого себя")
+	}
+	if user.IsAdmin {
+		adminsCount, err := u.userRepo.CountAdmins(ctx)
+		if err != nil {
+			return nil, apperrors.WrapError("Не удалось подсчитать количество админов", err)
+		}
+		if adminsCount <= 1 {
+			plan.blockReasons = append(plan.blockReasons, "Нельзя удалить последнего админа")
+		}
+	}
+
+	registrations, err := u.registrationRepo.FindByUserID(ctx, id)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить регистрации", err)
+	}
+	now := time.Now()
+	for _, reg := range registrations {
+		// Registrations of competitions in the trash are kept: the competition may be restored
+		competition, err := u.competitionRepo.FindByID(ctx, reg.CompetitionID)
+		if err == nil && competition.StartDate != nil && competition.StartDate.After(now) {
+			plan.deleteRegistrations = append(plan.deleteRegistrations, reg.ID)
+		} else {
+			plan.anonymizeRegistrations = append(plan.anonymizeRegistrations, reg.ID)
+		}
+	}
+
+	trashed, err := u.registrationRepo.FindDeleted(ctx)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить корзину", err)
+	}
+	for _, reg := range trashed {
+		if reg.UserID == id {
+			plan.anonymizeRegistrations = append(plan.anonymizeRegistrations, reg.ID)
+		}
+	}
+
+	if plan.results, err = u.resultRepo.CountByRegistrationIDs(ctx, plan.deleteRegistrations); err != nil {
+		return nil, apperrors.WrapError("Не удалось получить результаты", err)
+	}
+	if plan.penalties, err = u.penaltyRepo.CountByRegistrationIDs(ctx, plan.deleteRegistrations); err != nil {
+		return nil, apperrors.WrapError("Не удалось получить штрафы", err)
+	}
+	if plan.protests, err = u.protestRepo.CountByRegistrationIDs(ctx, plan.deleteRegistrations); err != nil {
+		return nil, apperrors.WrapError("Не удалось получить протесты", err)
+	}
+	if plan.checkIns, err = u.checkInRepo.CountByRegistrationIDs(ctx, plan.deleteRegistrations); err != nil {
+		return nil, apperrors.WrapError("Не удалось получить отметки о прибытии", err)
+	}
+
+	if plan.reports, err = u.reportRepo.CountByAuthorID(ctx, id); err != nil {
+		return nil, apperrors.WrapError("Не удалось получить отчеты", err)
+	}
+	if plan.comments, err = u.commentRepo.CountByAuthorID(ctx, id); err != nil {
+		return nil, apperrors.WrapError("Не удалось получить комментарии", err)
+	}
+	if plan.notifications, err = u.notificationRepo.CountByUserID(ctx, id); err != nil {
+		return nil, apperrors.WrapError("Не удалось получить уведомления", err)
+	}
+	if plan.reactions, err = u.reactionRepo.CountByUserID(ctx, id); err != nil {
+		return nil, apperrors.WrapError("Не удалось получить реакции", err)
+	}
+	judged, err := u.competitionRepo.FindByJudgeID(ctx, id)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить соревнования", err)
+	}
+	plan.judgePanels = len(judged)
+
+	return plan, nil
+}
+
+// GetUserDeletionPreview implements UseCase.GetUserDeletionPreview
+func (u *UseCaseImpl) GetUserDeletionPreview(ctx context.Context, currentUserID string, id string) (*model.DeletionPreview, error) {
+	plan, err := u.planUserDeletion(ctx, currentUserID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.DeletionPreview{
+		Blocked:      len(plan.blockReasons) > 0,
+		BlockReasons: append([]string{}, plan.blockReasons...),
+		Effects:      plan.effects(),
+	}, nil
+}
+
+// AdminDeleteUser implements UseCase.AdminDeleteUser
+// All changes of the deletion plan are applied in one transaction
+func (u *UseCaseImpl) AdminDeleteUser(ctx context.Context, currentUserID string, id string) (bool, error) {
+	plan, err := u.planUserDeletion(ctx, currentUserID, id)
+	if err != nil {
+		return false, err
+	}
+	if len(plan.blockReasons) > 0 {
+		return false, fmt.Errorf("Удаление невозможно: %s", strings.Join(plan.blockReasons, "; "))
+	}
+
+	err = u.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		for _, regID := range plan.deleteRegistrations {
+			if err := u.purgeRegistration(ctx, regID); err != nil {
+				return err
+			}
+		}
+		if err := u.registrationRepo.Anonymize(ctx, plan.anonymizeRegistrations); err != nil {
+			return err
+		}
+		if err := u.reportRepo.AnonymizeAuthor(ctx, id); err != nil {
+			return err
+		}
+		if err := u.commentRepo.AnonymizeAuthor(ctx, id); err != nil {
+			return err
+		}
+		if err := u.competitionRepo.RemoveJudge(ctx, id); err != nil {
+			return err
+		}
+		if err := u.notificationRepo.DeleteByUserID(ctx, id); err != nil {
+			return err
+		}
+		if err := u.reactionRepo.DeleteByUserID(ctx, id); err != nil {
+			return err
+		}
+		if err := u.uploadRepo.DeleteByUserID(ctx, id); err != nil {
+			return err
+		}
+		return u.userRepo.Delete(ctx, id)
+	})
+	if err != nil {
+		return false, apperrors.WrapError("Не удалось удалить пользователя", err)
+	}
+
+	return true, nil
+}
+
+// GetCompetitionDeletionPreview implements UseCase.GetCompetitionDeletionPreview
+// Nothing is deleted right away: the competition goes to the trash and its records are purged with it
+func (u *UseCaseImpl) GetCompetitionDeletionPreview(ctx context.Context, id string) (*model.DeletionPreview, error) {
+	if _, err := u.competitionRepo.FindByID(ctx, id); err != nil {
+		return nil, fmt.Errorf("Соревнование не найдено")
+	}
+
+	registrations, err := u.registrationRepo.FindByCompetitionID(ctx, id)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить регистрации", err)
+	}
+	results, err := u.resultRepo.FindByCompetitionID(ctx, id)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить результаты", err)
+	}
+	penalties, err := u.penaltyRepo.FindByCompetitionID(ctx, id)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить штрафы", err)
+	}
+	protests, err := u.protestRepo.FindByCompetitionID(ctx, id, nil)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить протесты", err)
+	}
+	checkIns, err := u.checkInRepo.CountByCompetitionID(ctx, id)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить отметки о прибытии", err)
+	}
+
+	restorableUntil := scalars.Time(time.Now().Add(u.trashRetention))
+	return &model.DeletionPreview{
+		Blocked:      false,
+		BlockReasons: []string{},
+		Effects: deletionEffects(
+			&model.DeletionEffect{Entity: "registrations", Action: deletionActionDelete, Count: len(registrations)},
+			&model.DeletionEffect{Entity: "results", Action: deletionActionDelete, Count: len(results)},
+			&model.DeletionEffect{Entity: "penalties", Action: deletionActionDelete, Count: len(penalties)},
+			&model.DeletionEffect{Entity: "protests", Action: deletionActionDelete, Count: len(protests)},
+			&model.DeletionEffect{Entity: "checkIns", Action: deletionActionDelete, Count: int(checkIns)},
+		),
+		RestorableUntil: &restorableUntil,
+	}, nil
+}
+
+// DeleteCompetition implements UseCase.DeleteCompetition
+// The competition is moved to the trash and can be restored during the retention period
+func (u *UseCaseImpl) DeleteCompetition(ctx context.Context, userID string, id string) (bool, error) {
+	err := u.competitionRepo.SoftDelete(ctx, id, userID)
+	if err != nil {
+		return false, apperrors.WrapError("Не удалось удалить соревнование", err)
+	}
+
+	return true, nil
+}
+
+// deletionEffects drops effects that touch no records
+func deletionEffects(effects ...*model.DeletionEffect) []*model.DeletionEffect {
+	result := make([]*model.DeletionEffect, 0, len(effects))
+	for _, effect := range effects {
+		if effect.Count > 0 {
+			result = append(result, effect)
+		}
+	}
+	return result
+}
+
+// deletedAuthor stands in for the author of a report whose user was deleted
+func deletedAuthor(id string) *entity.User {
+	return &entity.User{ID: id, Username: DeletedUserName}
+}
@@ -0,0 +1,247 @@
+package usecase
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cnpf/feeder-backend/graph/model"
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+)
+
+const (
+	maxEligibilityAge            = 120
+	maxEligibilityCategoryLength = 100
+	maxEligibilityOverrideLength = 1000
+)
+
+// eligibilityViolation is a broken eligibility rule, described in Russian and Romanian
+type eligibilityViolation struct {
+	ru string
+	ro string
+}
+
+func (v eligibilityViolation) String() string {
+	return v.ru + " / " + v.ro
+}
+
+// checkEligibility evaluates the competition eligibility rules against every participant
+// Age is counted in full years on the competition start date
+func checkEligibility(competition *entity.Competition, participants []entity.Participant) []eligibilityViolation {
+	rules := competition.Eligibility
+	if rules.IsEmpty() {
+		return nil
+	}
+
+	refDate := time.Now()
+	if competition.StartDate != nil {
+		refDate = *competition.StartDate
+	}
+
+	var violations []eligibilityViolation
+	add := func(i int, p entity.Participant, ru, ro string) {
+		name := p.LastName + " " + p.FirstName
+		violations = append(violations, eligibilityViolation{
+			ru: fmt.Sprintf("участник %d (%s): %s", i+1, name, ru),
+			ro: fmt.Sprintf("participantul %d (%s): %s", i+1, name, ro),
+		})
+	}
+
+	for i, p := range participants {
+		if rules.MinAge != nil || rules.MaxAge != nil {
+			if p.BirthDate == nil {
+				add(i, p, "не указана дата рождения", "nu este indicată data nașterii")
+			} else {
+				age := ageOn(*p.BirthDate, refDate)
+				if rules.MinAge != nil && age < *rules.MinAge {
+					add(i, p, fmt.Sprintf("возраст %d, минимальный — %d", age, *rules.MinAge),
+						fmt.Sprintf("vârsta %d, minima — %d", age, *rules.MinAge))
+				}
+				if rules.MaxAge != nil && age > *rules.MaxAge {
+					add(i, p, fmt.Sprintf("возраст %d, максимальный — %d", age, *rules.MaxAge),
+						fmt.Sprintf("vârsta %d, maxima — %d", age, *rules.MaxAge))
+				}
+			}
+		}
+
+		if rules.Gender != nil {
+			switch {
+			case p.Gender == nil:
+				add(i, p, "не указан пол", "nu este indicat sexul")
+			case *p.Gender != *rules.Gender && *rules.Gender == entity.GenderFemale:
+				add(i, p, "допускаются только женщины", "sunt admise doar femei")
+			case *p.Gender != *rules.Gender:
+				add(i, p, "допускаются только мужчины", "sunt admiși doar bărbați")
+			}
+		}
+
+		if rules.LicenseRequired && p.LicenseNumber == nil {
+			add(i, p, "не указан номер лицензии", "nu este indicat numărul licenței")
+		}
+	}
+
+	return violations
+}
+
+// eligibilityError builds the rejection message with all violations, Russian first, then Romanian
+func eligibilityError(competition *entity.Competition, violations []eligibilityViolation) error {
+	ru := make([]string, len(violations))
+	ro := make([]string, len(violations))
+	for i, v := range violations {
+		ru[i] = v.ru
+		ro[i] = v.ro
+	}
+
+	ruSubject, roSubject := "условиям соревнования", "condițiilor competiției"
+	if category := competition.Eligibility.Category; category != nil {
+		ruSubject = fmt.Sprintf("условиям категории «%s»", *category)
+		roSubject = fmt.Sprintf("condițiilor categoriei «%s»", *category)
+	}
+
+	return fmt.Errorf("Регистрация не соответствует %s: %s / Înregistrarea nu corespunde %s: %s",
+		ruSubject, strings.Join(ru, "; "), roSubject, strings.Join(ro, "; "))
+}
+
+// applyEligibility checks the registration participants and sets its eligibility override
+// Ineligible registrations are accepted only from admins with a justification; an earlier override
+// stays valid while the violations are the same (e.g. when the team name is edited)
+func applyEligibility(competition *entity.Competition, reg *entity.Registration, user *entity.User, overrideReason *string, previous *entity.EligibilityOverride) error {
+	reason := ""
+	if overrideReason != nil {
+		reason = strings.TrimSpace(*overrideReason)
+	}
+	if reason != "" && !user.IsAdmin {
+		return fmt.Errorf("Доступ запрещен: допуск в обход условий может дать только администратор")
+	}
+	if len([]rune(reason)) > maxEligibilityOverrideLength {
+		return fmt.Errorf("Обоснование допуска слишком длинное (макс %d символов)", maxEligibilityOverrideLength)
+	}
+
+	violations := checkEligibility(competition, reg.Participants)
+	if len(violations) == 0 {
+		reg.EligibilityOverride = nil
+		return nil
+	}
+
+	messages := make([]string, len(violations))
+	for i, v := range violations {
+		messages[i] = v.String()
+	}
+
+	if reason == "" {
+		if previous != nil && slices.Equal(previous.Violations, messages) {
+			reg.EligibilityOverride = previous
+			return nil
+		}
+		if user.IsAdmin {
+			return fmt.Errorf("%w (укажите обоснование, чтобы допустить участника в обход условий)", eligibilityError(competition, violations))
+		}
+		return eligibilityError(competition, violations)
+	}
+
+	reg.EligibilityOverride = &entity.EligibilityOverride{
+		AdminID:    user.ID,
+		Reason:     reason,
+		Violations: messages,
+		CreatedAt:  time.Now(),
+	}
+	return nil
+}
+
+// ageOn returns full years between birth and date
+func ageOn(birth, date time.Time) int {
+	age := date.Year() - birth.Year()
+	if date.Month() < birth.Month() || (date.Month() == birth.Month() && date.Day() < birth.Day()) {
+		age--
+	}
+	return age
+}
+
+// eligibilityFromInput validates competition eligibility input; empty input means no restrictions
+func eligibilityFromInput(input *model.EligibilityRulesInput) (*entity.EligibilityRules, error) {
+	if input == nil {
+		return nil, nil
+	}
+
+	rules := &entity.EligibilityRules{
+		MinAge:          input.MinAge,
+		MaxAge:          input.MaxAge,
+		LicenseRequired: input.LicenseRequired != nil && *input.LicenseRequired,
+	}
+
+	if input.Category != nil {
+		category := strings.TrimSpace(*input.Category)
+		if len([]rune(category)) > maxEligibilityCategoryLength {
+			return nil, fmt.Errorf("Название категории слишком длинное (макс %d символов)", maxEligibilityCategoryLength)
+		}
+		if category != "" {
+			rules.Category = &category
+		}
+	}
+
+	for _, age := range []*int{rules.MinAge, rules.MaxAge} {
+		if age != nil && (*age < 0 || *age > maxEligibilityAge) {
+			return nil, fmt.Errorf("Возраст должен быть от 0 до %d лет", maxEligibilityAge)
+		}
+	}
+	if rules.MinAge != nil && rules.MaxAge != nil && *rules.MinAge > *rules.MaxAge {
+		return nil, fmt.Errorf("Минимальный возраст не может быть больше максимального")
+	}
+
+	if input.Gender != nil && *input.Gender != "" {
+		gender := entity.Gender(*input.Gender)
+		if !entity.IsValidGender(gender) {
+			return nil, fmt.Errorf("Неверный пол (допустимо: male, female)")
+		}
+		rules.Gender = &gender
+	}
+
+	if rules.IsEmpty() && rules.Category == nil {
+		return nil, nil
+	}
+	return rules, nil
+}
+
+// eligibilityToInput converts rules back to input (used to copy competitions and templates)
+func eligibilityToInput(rules *entity.EligibilityRules) *model.EligibilityRulesInput {
+	if rules == nil {
+		return nil
+	}
+
+	var gender *string
+	if rules.Gender != nil {
+		g := string(*rules.Gender)
+		gender = &g
+	}
+	licenseRequired := rules.LicenseRequired
+
+	return &model.EligibilityRulesInput{
+		Category:        rules.Category,
+		MinAge:          rules.MinAge,
+		MaxAge:          rules.MaxAge,
+		Gender:          gender,
+		LicenseRequired: &licenseRequired,
+	}
+}
+
+// Helper function to convert entity.EligibilityRules to model.EligibilityRules
+func entityToGraphQLEligibility(rules *entity.EligibilityRules) *model.EligibilityRules {
+	if rules == nil {
+		return nil
+	}
+
+	var gender *string
+	if rules.Gender != nil {
+		g := string(*rules.Gender)
+		gender = &g
+	}
+
+	return &model.EligibilityRules{
+		Category:        rules.Category,
+		MinAge:          rules.MinAge,
+		MaxAge:          rules.MaxAge,
+		Gender:          gender,
+		LicenseRequired: rules.LicenseRequired,
+	}
+}
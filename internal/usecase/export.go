@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	apperrors "github.com/cnpf/feeder-backend/internal/errors"
+	"github.com/cnpf/feeder-backend/internal/export"
+)
+
+// ExportFile is a rendered document ready to be downloaded
+type ExportFile struct {
+	Data        []byte
+	ContentType string
+	FileName    string
+}
+
+// ExportStartList implements UseCase.ExportStartList
+func (u *UseCaseImpl) ExportStartList(ctx context.Context, userID string, competitionID string, format string) (*ExportFile, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+
+	exportFormat, err := export.ParseFormat(format)
+	if err != nil {
+		return nil, fmt.Errorf("Неподдерживаемый формат: %s", format)
+	}
+
+	competition, err := u.competitionRepo.FindByID(ctx, competitionID)
+	if err != nil {
+		return nil, fmt.Errorf("Соревнование не найдено")
+	}
+
+	registrations, err := u.registrationRepo.FindByCompetitionID(ctx, competitionID)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить регистрации", err)
+	}
+
+	tmpl, err := export.LoadTemplate()
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось загрузить шаблон документа", err)
+	}
+
+	doc, err := tmpl.StartList(competition, registrations)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось сформировать стартовый протокол", err)
+	}
+
+	return renderExport(doc, exportFormat, fmt.Sprintf("start-list-%s", competitionID))
+}
+
+// ExportProtocol implements UseCase.ExportProtocol
+func (u *UseCaseImpl) ExportProtocol(ctx context.Context, userID string, competitionID string, format string) (*ExportFile, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+
+	exportFormat, err := export.ParseFormat(format)
+	if err != nil {
+		return nil, fmt.Errorf("Неподдерживаемый формат: %s", format)
+	}
+
+	competition, rows, err := u.computeStandings(ctx, competitionID)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, err := export.LoadTemplate()
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось загрузить шаблон документа", err)
+	}
+
+	doc, err := tmpl.Protocol(competition, rows)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось сформировать итоговый протокол", err)
+	}
+
+	return renderExport(doc, exportFormat, fmt.Sprintf("protocol-%s", competitionID))
+}
+
+// renderExport renders a document into a downloadable file
+func renderExport(doc *export.Document, format export.Format, baseName string) (*ExportFile, error) {
+	data, err := doc.Render(format)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось сформировать файл", err)
+	}
+
+	return &ExportFile{
+		Data:        data,
+		ContentType: format.ContentType(),
+		FileName:    fmt.Sprintf("%s.%s", baseName, format),
+	}, nil
+}
@@ -0,0 +1,235 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	apperrors "github.com/cnpf/feeder-backend/internal/errors"
+	"github.com/cnpf/feeder-backend/internal/feed"
+)
+
+// Feed formats served by GetReportsFeed and GetCompetitionsFeed
+const (
+	FeedFormatAtom = "atom"
+	FeedFormatRSS  = "rss"
+)
+
+const feedReportsLimit = 30
+
+// GetReportsFeed implements UseCase.GetReportsFeed
+// Contains the newest published reports with their photos as enclosures
+func (u *UseCaseImpl) GetReportsFeed(ctx context.Context, format string) ([]byte, error) {
+	reports, err := u.reportRepo.FindPublished(ctx, entity.ReportFilter{}, feedReportsLimit)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить отчеты", err)
+	}
+
+	authorIDs := make([]string, 0, len(reports))
+	for _, report := range reports {
+		authorIDs = append(authorIDs, report.AuthorID)
+	}
+	authors, err := u.userRepo.FindByIDs(ctx, authorIDs)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить авторов", err)
+	}
+	authorNames := make(map[string]string, len(authors))
+	for _, user := range authors {
+		authorNames[user.ID] = user.Username
+	}
+
+	site := feed.DefaultSite()
+	reportsFeed := feed.Feed{
+		ID:    site.API("/api/feeds/reports"),
+		Title: "CNPF Feeder — отчеты",
+		Link:  site.URL,
+		Self:  site.API("/api/feeds/reports." + format),
+	}
+	for _, report := range reports {
+		author, ok := authorNames[report.AuthorID]
+		if !ok {
+			author = DeletedUserName
+		}
+
+		published := report.CreatedAt
+		if report.PublishedAt != nil {
+			published = *report.PublishedAt
+		}
+
+		var categories []string
+		if report.Category != nil {
+			categories = append(categories, string(*report.Category))
+		}
+		categories = append(categories, report.Tags...)
+
+		reportsFeed.Items = append(reportsFeed.Items, feed.Item{
+			ID:         site.ReportURL(report.ID),
+			Title:      report.Title,
+			Link:       site.ReportURL(report.ID),
+			Author:     author,
+			Content:    absolutePhotoURLs(reportTextHTML(report), site),
+			Categories: categories,
+			Published:  published,
+			Updated:    report.UpdatedAt,
+			Enclosures: reportEnclosures(report, site),
+		})
+		reportsFeed.Updated = latest(reportsFeed.Updated, report.UpdatedAt)
+	}
+
+	return renderFeed(reportsFeed, format)
+}
+
+// GetCompetitionsFeed implements UseCase.GetCompetitionsFeed
+// Contains competitions that have not ended yet, the nearest first
+func (u *UseCaseImpl) GetCompetitionsFeed(ctx context.Context, format string) ([]byte, error) {
+	competitions, err := u.competitionRepo.FindAll(ctx)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить соревнования", err)
+	}
+
+	today := time.Now().Truncate(24 * time.Hour)
+	upcoming := make([]*entity.Competition, 0, len(competitions))
+	for _, competition := range competitions {
+		end := competition.EndDate
+		if end == nil {
+			end = competition.StartDate
+		}
+		if end != nil && !end.Before(today) {
+			upcoming = append(upcoming, competition)
+		}
+	}
+	sort.SliceStable(upcoming, func(i, j int) bool {
+		a, b := upcoming[i].StartDate, upcoming[j].StartDate
+		if a == nil || b == nil {
+			return b == nil && a != nil
+		}
+		return a.Before(*b)
+	})
+
+	site := feed.DefaultSite()
+	competitionsFeed := feed.Feed{
+		ID:    site.API("/api/feeds/competitions"),
+		Title: "CNPF Feeder — ближайшие соревнования",
+		Link:  site.URL,
+		Self:  site.API("/api/feeds/competitions." + format),
+	}
+	for _, competition := range upcoming {
+		competitionsFeed.Items = append(competitionsFeed.Items, feed.Item{
+			ID:        site.CompetitionURL(competition.ID),
+			Title:     competition.Title,
+			Link:      site.CompetitionURL(competition.ID),
+			Content:   describeCompetitionHTML(competition),
+			Published: competition.CreatedAt,
+			Updated:   competition.UpdatedAt,
+		})
+		competitionsFeed.Updated = latest(competitionsFeed.Updated, competition.UpdatedAt)
+	}
+
+	return renderFeed(competitionsFeed, format)
+}
+
+// GetSitemap implements UseCase.GetSitemap
+// Lists the home page, published reports and competitions with their last update time
+func (u *UseCaseImpl) GetSitemap(ctx context.Context) ([]byte, error) {
+	competitions, err := u.competitionRepo.FindAll(ctx)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить соревнования", err)
+	}
+	reports, err := u.reportRepo.FindPublishedModifications(ctx, feed.MaxSitemapURLs-len(competitions)-1)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить отчеты", err)
+	}
+
+	site := feed.DefaultSite()
+	urls := make([]feed.SitemapURL, 0, 1+len(reports)+len(competitions))
+	urls = append(urls, feed.SitemapURL{Loc: site.URL + "/"})
+	for _, report := range reports {
+		urls = append(urls, feed.SitemapURL{Loc: site.ReportURL(report.ID), LastMod: report.UpdatedAt})
+	}
+	for _, competition := range competitions {
+		urls = append(urls, feed.SitemapURL{Loc: site.CompetitionURL(competition.ID), LastMod: competition.UpdatedAt})
+	}
+
+	return feed.Sitemap(urls), nil
+}
+
+func renderFeed(f feed.Feed, format string) ([]byte, error) {
+	if f.Updated.IsZero() {
+		f.Updated = time.Now()
+	}
+	switch format {
+	case FeedFormatAtom:
+		return feed.Atom(f), nil
+	case FeedFormatRSS:
+		return feed.RSS(f), nil
+	default:
+		return nil, fmt.Errorf("Неизвестный формат ленты (допустимо: atom, rss)")
+	}
+}
+
+// reportEnclosures lists the photos of a report with their type and size
+func reportEnclosures(report *entity.Report, site feed.Site) []feed.Enclosure {
+	enclosures := make([]feed.Enclosure, 0, len(report.Photos))
+	for _, photo := range report.Photos {
+		enclosures = append(enclosures, feed.Enclosure{
+			URL:    site.API(reportPhotoURL(report.ID, photo.ID)),
+			Type:   photo.ContentType,
+			Length: int(photo.Size),
+		})
+	}
+	return enclosures
+}
+
+// absolutePhotoURLs makes the photo URLs of rendered report text absolute, as feed readers require
+func absolutePhotoURLs(textHTML string, site feed.Site) string {
+	return strings.ReplaceAll(textHTML, `src="/api/`, `src="`+site.API("/api/"))
+}
+
+// describeCompetitionHTML builds a short HTML description of a competition for feeds
+func describeCompetitionHTML(c *entity.Competition) string {
+	lines := describeCompetition(c)
+	for i, line := range lines {
+		lines[i] = "<p>" + html.EscapeString(line) + "</p>"
+	}
+	return strings.Join(lines, "\n")
+}
+
+// describeCompetition lists the dates, place, format and fee of a competition as plain text lines
+func describeCompetition(c *entity.Competition) []string {
+	var lines []string
+	if c.StartDate != nil {
+		dates := c.StartDate.Format("02.01.2006")
+		if c.EndDate != nil && !c.EndDate.Equal(*c.StartDate) {
+			dates += " — " + c.EndDate.Format("02.01.2006")
+		}
+		lines = append(lines, "Даты: "+dates)
+	}
+	if c.Location != "" {
+		lines = append(lines, "Место: "+c.Location)
+	}
+	var formats []string
+	if c.IndividualFormat {
+		formats = append(formats, "личный зачет")
+	}
+	if c.TeamFormat {
+		formats = append(formats, "командный зачет")
+	}
+	if len(formats) > 0 {
+		lines = append(lines, "Формат: "+strings.Join(formats, ", "))
+	}
+	if c.Fee != nil {
+		lines = append(lines, fmt.Sprintf("Взнос: %.2f", *c.Fee))
+	}
+	return lines
+}
+
+func latest(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
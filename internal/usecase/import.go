@@ -0,0 +1,222 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/cnpf/feeder-backend/graph/model"
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	apperrors "github.com/cnpf/feeder-backend/internal/errors"
+	"github.com/cnpf/feeder-backend/internal/importer"
+)
+
+const maxImportFileSize = 5 * 1024 * 1024 // 5MB
+
+// errImportWithoutTransactions rejects an import commit that could leave part of the rows written;
+// checking files with dryRun works without transactions
+var errImportWithoutTransactions = fmt.Errorf("Импорт недоступен: MongoDB не поддерживает транзакции (нужен replica set); проверка файла (dryRun) доступна")
+
+// ImportFile represents an uploaded CSV or XLSX file
+type ImportFile struct {
+	File     io.Reader
+	Size     int64
+	FileName string
+}
+
+// ImportCompetitions implements UseCase.ImportCompetitions
+// Every row goes through the same validation as createCompetition; with dryRun nothing is written,
+// otherwise all valid rows are created in one transaction
+func (u *UseCaseImpl) ImportCompetitions(ctx context.Context, file *ImportFile, dryRun bool) (*model.ImportResult, error) {
+	table, err := readImportTable(file)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := importer.Competitions(table)
+	if err != nil {
+		return nil, err
+	}
+
+	result := newImportResult(dryRun, len(rows))
+	competitions := make([]*entity.Competition, len(rows))
+	for i, row := range rows {
+		if row.Err == nil {
+			competitions[i], row.Err = u.competitionFromInput(ctx, row.Input)
+		}
+		addImportRow(result, row.Number, row.Err)
+	}
+
+	if dryRun || result.Valid == 0 {
+		return result, nil
+	}
+	if !u.txManager.Supported() {
+		return nil, errImportWithoutTransactions
+	}
+
+	err = u.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		result.Imported = 0
+		for i, competition := range competitions {
+			if competition == nil {
+				continue
+			}
+			competition.CreatedAt = time.Now()
+			competition.UpdatedAt = time.Now()
+
+			id, err := u.competitionRepo.Create(ctx, competition)
+			if err != nil {
+				return fmt.Errorf("row %d: %w", rows[i].Number, err)
+			}
+			result.Rows[i].ID = &id
+			result.Imported++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось импортировать соревнования", err)
+	}
+
+	return result, nil
+}
+
+// ImportRegistrations implements UseCase.ImportRegistrations
+// Registrations are created on behalf of the importing admin (paper registrations)
+func (u *UseCaseImpl) ImportRegistrations(ctx context.Context, userID string, competitionID string, file *ImportFile, dryRun bool) (*model.ImportResult, error) {
+	competition, err := u.competitionRepo.FindByID(ctx, competitionID)
+	if err != nil {
+		return nil, fmt.Errorf("Соревнование не найдено")
+	}
+
+	table, err := readImportTable(file)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := importer.Registrations(table, competitionID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := newImportResult(dryRun, len(rows))
+	registrations := make([]*entity.Registration, len(rows))
+	for i, row := range rows {
+		if row.Err == nil {
+			participants, coaches := registrationInputToUseCase(row.Input)
+			registrations[i], row.Err = registrationFromInput(competition, row.Input.Type, row.Input.TeamName, participants, coaches)
+		}
+		if row.Err == nil {
+			if violations := checkEligibility(competition, registrations[i].Participants); len(violations) > 0 {
+				registrations[i], row.Err = nil, eligibilityError(competition, violations)
+			}
+		}
+		addImportRow(result, row.Number, row.Err)
+	}
+
+	if dryRun || result.Valid == 0 {
+		return result, nil
+	}
+	if !u.txManager.Supported() {
+		return nil, errImportWithoutTransactions
+	}
+
+	err = u.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		result.Imported = 0
+		for i, registration := range registrations {
+			if registration == nil {
+				continue
+			}
+			registration.UserID = userID
+			registration.CreatedAt = time.Now()
+			registration.UpdatedAt = time.Now()
+
+			id, err := u.registrationRepo.Create(ctx, registration)
+			if err != nil {
+				return fmt.Errorf("row %d: %w", rows[i].Number, err)
+			}
+			result.Rows[i].ID = &id
+			result.Imported++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось импортировать регистрации", err)
+	}
+
+	return result, nil
+}
+
+// readImportTable checks the upload and parses it into a table
+func readImportTable(file *ImportFile) (*importer.Table, error) {
+	if file == nil || file.File == nil {
+		return nil, fmt.Errorf("Файл не загружен")
+	}
+	if file.Size > maxImportFileSize {
+		return nil, fmt.Errorf("Файл слишком большой (макс 5МБ)")
+	}
+
+	data, err := io.ReadAll(io.LimitReader(file.File, maxImportFileSize+1))
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось прочитать файл", err)
+	}
+	if len(data) > maxImportFileSize {
+		return nil, fmt.Errorf("Файл слишком большой (макс 5МБ)")
+	}
+
+	table, err := importer.Read(data, file.FileName)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось разобрать файл (поддерживаются CSV и XLSX)", err)
+	}
+	return table, nil
+}
+
+// registrationInputToUseCase converts GraphQL registration input parts to UseCase inputs
+func registrationInputToUseCase(input *model.CreateRegistrationInput) ([]ParticipantInput, []CoachInput) {
+	participants := make([]ParticipantInput, len(input.Participants))
+	for i, p := range input.Participants {
+		participants[i] = ParticipantInput{
+			FirstName:     p.FirstName,
+			LastName:      p.LastName,
+			BirthDate:     p.BirthDate,
+			Gender:        p.Gender,
+			LicenseNumber: p.LicenseNumber,
+			Reserve:       p.Reserve != nil && *p.Reserve,
+			Captain:       p.Captain != nil && *p.Captain,
+		}
+	}
+
+	coaches := make([]CoachInput, 0, len(input.Coaches)+1)
+	if input.Coach != nil {
+		coaches = append(coaches, CoachInput{
+			FirstName: input.Coach.FirstName,
+			LastName:  input.Coach.LastName,
+		})
+	}
+	for _, c := range input.Coaches {
+		coaches = append(coaches, CoachInput{
+			FirstName: c.FirstName,
+			LastName:  c.LastName,
+		})
+	}
+	return participants, coaches
+}
+
+func newImportResult(dryRun bool, total int) *model.ImportResult {
+	return &model.ImportResult{
+		DryRun: dryRun,
+		Total:  total,
+		Rows:   make([]*model.ImportRowResult, 0, total),
+	}
+}
+
+// addImportRow records the validation outcome of a file row
+func addImportRow(result *model.ImportResult, number int, err error) {
+	row := &model.ImportRowResult{Row: number, Ok: err == nil}
+	if err != nil {
+		message := err.Error()
+		row.Error = &message
+	} else {
+		result.Valid++
+	}
+	result.Rows = append(result.Rows, row)
+}
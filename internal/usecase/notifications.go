@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"log"
+
+	"github.com/cnpf/feeder-backend/graph/model"
+	"github.com/cnpf/feeder-backend/graph/scalars"
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	apperrors "github.com/cnpf/feeder-backend/internal/errors"
+	"github.com/cnpf/feeder-backend/internal/notify"
+)
+
+const (
+	defaultNotificationsLimit = 20
+	maxNotificationsLimit     = 100
+)
+
+// GetNotifications implements UseCase.GetNotifications
+func (u *UseCaseImpl) GetNotifications(ctx context.Context, userID string, unreadOnly bool, limit *int) ([]*model.Notification, error) {
+	notificationsLimit := defaultNotificationsLimit
+	if limit != nil {
+		notificationsLimit = min(max(*limit, 1), maxNotificationsLimit)
+	}
+
+	notifications, err := u.notificationRepo.FindByUserID(ctx, userID, unreadOnly, notificationsLimit)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить уведомления", err)
+	}
+
+	result := make([]*model.Notification, 0, len(notifications))
+	for _, n := range notifications {
+		result = append(result, entityToGraphQLNotification(n))
+	}
+	return result, nil
+}
+
+// GetUnreadNotificationsCount implements UseCase.GetUnreadNotificationsCount
+func (u *UseCaseImpl) GetUnreadNotificationsCount(ctx context.Context, userID string) (int, error) {
+	count, err := u.notificationRepo.CountUnread(ctx, userID)
+	if err != nil {
+		return 0, apperrors.WrapError("Не удалось получить уведомления", err)
+	}
+	return int(count), nil
+}
+
+// MarkNotificationsRead implements UseCase.MarkNotificationsRead
+func (u *UseCaseImpl) MarkNotificationsRead(ctx context.Context, userID string, ids []string) (bool, error) {
+	if err := u.notificationRepo.MarkRead(ctx, userID, ids); err != nil {
+		return false, apperrors.WrapError("Не удалось обновить уведомления", err)
+	}
+	return true, nil
+}
+
+// notifyUsers stores an in-app notification for every recipient and sends it by e-mail in the background
+// Delivery problems are logged and never fail the operation that triggered the notification
+func (u *UseCaseImpl) notifyUsers(ctx context.Context, userIDs []string, n entity.Notification) {
+	seen := make(map[string]bool, len(userIDs))
+	notifications := make([]*entity.Notification, 0, len(userIDs))
+	for _, userID := range userIDs {
+		if userID == "" || seen[userID] {
+			continue
+		}
+		seen[userID] = true
+		item := n
+		item.UserID = userID
+		notifications = append(notifications, &item)
+	}
+
+	if err := u.notificationRepo.CreateMany(ctx, notifications); err != nil {
+		log.Printf("Failed to store notifications %s: %v", n.Type, err)
+	}
+
+	go func() {
+		ctx := context.Background()
+		for _, item := range notifications {
+			user, err := u.userRepo.FindByID(ctx, item.UserID)
+			if err != nil || user.Email == "" {
+				continue
+			}
+			msg := notify.Message{To: user.Email, Subject: item.Title, Body: item.Message}
+			if err := u.mailer.Send(ctx, msg); err != nil {
+				log.Printf("Failed to send notification %s: %v", item.Type, err)
+			}
+		}
+	}()
+}
+
+// Helper function to convert entity.Notification to model.Notification
+func entityToGraphQLNotification(n *entity.Notification) *model.Notification {
+	var entityType, entityID *string
+	if n.EntityType != "" {
+		entityType = &n.EntityType
+	}
+	if n.EntityID != "" {
+		entityID = &n.EntityID
+	}
+
+	return &model.Notification{
+		ID:         n.ID,
+		Type:       n.Type,
+		Title:      n.Title,
+		Message:    n.Message,
+		EntityType: entityType,
+		EntityID:   entityID,
+		Read:       n.ReadAt != nil,
+		CreatedAt:  scalars.Time(n.CreatedAt),
+	}
+}
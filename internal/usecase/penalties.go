@@ -0,0 +1,295 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cnpf/feeder-backend/graph/model"
+	"github.com/cnpf/feeder-backend/graph/scalars"
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	apperrors "github.com/cnpf/feeder-backend/internal/errors"
+)
+
+const (
+	maxJudges              = 20
+	maxPenaltyReasonLength = 1000
+)
+
+// SetCompetitionJudges implements UseCase.SetCompetitionJudges
+// Replaces the judge panel; an empty list removes all judges
+func (u *UseCaseImpl) SetCompetitionJudges(ctx context.Context, competitionID string, userIDs []string) (*model.Competition, error) {
+	if _, err := u.competitionRepo.FindByID(ctx, competitionID); err != nil {
+		return nil, fmt.Errorf("Соревнование не найдено")
+	}
+
+	judgeIDs := make([]string, 0, len(userIDs))
+	seen := make(map[string]bool, len(userIDs))
+	for _, id := range userIDs {
+		if !seen[id] {
+			seen[id] = true
+			judgeIDs = append(judgeIDs, id)
+		}
+	}
+	if len(judgeIDs) > maxJudges {
+		return nil, fmt.Errorf("Слишком много судей (макс %d)", maxJudges)
+	}
+	for _, id := range judgeIDs {
+		if _, err := u.userRepo.FindByID(ctx, id); err != nil {
+			return nil, fmt.Errorf("Пользователь %s не найден", id)
+		}
+	}
+
+	if err := u.competitionRepo.SetJudges(ctx, competitionID, judgeIDs); err != nil {
+		return nil, apperrors.WrapError("Не удалось назначить судей", err)
+	}
+
+	competition, err := u.competitionRepo.FindByID(ctx, competitionID)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось найти обновленное соревнование", err)
+	}
+
+	return u.entityToGraphQLCompetition(competition)
+}
+
+// CreatePenalty implements UseCase.CreatePenalty
+// Only judges of the competition and admins can issue penalties
+func (u *UseCaseImpl) CreatePenalty(ctx context.Context, userID string, input *model.PenaltyInput) (*model.Penalty, error) {
+	if input == nil {
+		return nil, fmt.Errorf("Входные данные не могут быть пустыми")
+	}
+
+	reg, err := u.registrationRepo.FindByID(ctx, input.RegistrationID)
+	if err != nil {
+		return nil, fmt.Errorf("Регистрация не найдена")
+	}
+
+	competition, err := u.competitionRepo.FindByID(ctx, reg.CompetitionID)
+	if err != nil {
+		return nil, fmt.Errorf("Соревнование не найдено")
+	}
+
+	if err := u.checkJudge(ctx, userID, competition); err != nil {
+		return nil, err
+	}
+
+	penaltyType, reason, err := validatePenalty(competition, input.Tour, input.Type, input.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	penalty := &entity.Penalty{
+		CompetitionID:  competition.ID,
+		RegistrationID: reg.ID,
+		Tour:           input.Tour,
+		Type:           penaltyType,
+		Reason:         reason,
+		JudgeID:        userID,
+	}
+	change := entity.PenaltyChange{
+		Action:    entity.PenaltyActionCreated,
+		UserID:    userID,
+		Tour:      input.Tour,
+		Type:      penaltyType,
+		Reason:    reason,
+		ChangedAt: now,
+	}
+
+	penaltyID, err := u.penaltyRepo.Create(ctx, penalty, change)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось сохранить штраф", err)
+	}
+
+	createdPenalty, err := u.penaltyRepo.FindByID(ctx, penaltyID)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось найти созданный штраф", err)
+	}
+
+	return entityToGraphQLPenalty(createdPenalty), nil
+}
+
+// UpdatePenalty implements UseCase.UpdatePenalty
+func (u *UseCaseImpl) UpdatePenalty(ctx context.Context, userID string, id string, input *model.UpdatePenaltyInput) (*model.Penalty, error) {
+	if input == nil {
+		return nil, fmt.Errorf("Входные данные не могут быть пустыми")
+	}
+
+	penalty, err := u.penaltyRepo.FindByID(ctx, id)
+	if err != nil || penalty.DeletedAt != nil {
+		return nil, fmt.Errorf("Штраф не найден")
+	}
+
+	competition, err := u.competitionRepo.FindByID(ctx, penalty.CompetitionID)
+	if err != nil {
+		return nil, fmt.Errorf("Соревнование не найдено")
+	}
+
+	if err := u.checkJudge(ctx, userID, competition); err != nil {
+		return nil, err
+	}
+
+	penaltyType, reason, err := validatePenalty(competition, input.Tour, input.Type, input.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	penalty.Tour = input.Tour
+	penalty.Type = penaltyType
+	penalty.Reason = reason
+	change := entity.PenaltyChange{
+		Action:    entity.PenaltyActionUpdated,
+		UserID:    userID,
+		Tour:      input.Tour,
+		Type:      penaltyType,
+		Reason:    reason,
+		ChangedAt: time.Now(),
+	}
+
+	if err := u.penaltyRepo.Update(ctx, penalty, change); err != nil {
+		return nil, apperrors.WrapError("Не удалось обновить штраф", err)
+	}
+
+	updatedPenalty, err := u.penaltyRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось найти обновленный штраф", err)
+	}
+
+	return entityToGraphQLPenalty(updatedPenalty), nil
+}
+
+// DeletePenalty implements UseCase.DeletePenalty
+// The penalty stops affecting standings but stays available with its history
+func (u *UseCaseImpl) DeletePenalty(ctx context.Context, userID string, id string) (bool, error) {
+	penalty, err := u.penaltyRepo.FindByID(ctx, id)
+	if err != nil || penalty.DeletedAt != nil {
+		return false, fmt.Errorf("Штраф не найден")
+	}
+
+	competition, err := u.competitionRepo.FindByID(ctx, penalty.CompetitionID)
+	if err != nil {
+		return false, fmt.Errorf("Соревнование не найдено")
+	}
+
+	if err := u.checkJudge(ctx, userID, competition); err != nil {
+		return false, err
+	}
+
+	change := entity.PenaltyChange{
+		Action:    entity.PenaltyActionDeleted,
+		UserID:    userID,
+		Tour:      penalty.Tour,
+		Type:      penalty.Type,
+		Reason:    penalty.Reason,
+		ChangedAt: time.Now(),
+	}
+
+	if err := u.penaltyRepo.Delete(ctx, id, change); err != nil {
+		return false, apperrors.WrapError("Не удалось удалить штраф", err)
+	}
+	return true, nil
+}
+
+// GetPenalties implements UseCase.GetPenalties
+func (u *UseCaseImpl) GetPenalties(ctx context.Context, competitionID string) ([]*model.Penalty, error) {
+	penalties, err := u.penaltyRepo.FindByCompetitionID(ctx, competitionID)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить штрафы", err)
+	}
+
+	items := make([]*model.Penalty, 0, len(penalties))
+	for _, penalty := range penalties {
+		items = append(items, entityToGraphQLPenalty(penalty))
+	}
+	return items, nil
+}
+
+// GetPenalty implements UseCase.GetPenalty
+// Deleted penalties are returned as well so that their history stays visible
+func (u *UseCaseImpl) GetPenalty(ctx context.Context, id string) (*model.Penalty, error) {
+	penalty, err := u.penaltyRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("Штраф не найден")
+	}
+	return entityToGraphQLPenalty(penalty), nil
+}
+
+// checkJudge allows admins and judges assigned to the competition
+func (u *UseCaseImpl) checkJudge(ctx context.Context, userID string, competition *entity.Competition) error {
+	for _, judgeID := range competition.JudgeIDs {
+		if judgeID == userID {
+			return nil
+		}
+	}
+
+	user, err := u.userRepo.FindByID(ctx, userID)
+	if err != nil || !user.IsAdmin {
+		return fmt.Errorf("Доступ запрещен: вы не судья этого соревнования")
+	}
+	return nil
+}
+
+// validatePenalty checks penalty fields and returns the normalized type and reason
+func validatePenalty(competition *entity.Competition, tour *int, penaltyType string, reason string) (entity.PenaltyType, string, error) {
+	t := entity.PenaltyType(strings.TrimSpace(penaltyType))
+	if !entity.IsValidPenaltyType(t) {
+		return "", "", fmt.Errorf("Неверный тип штрафа (warning, yellow_card, disqualification)")
+	}
+
+	if tour != nil && (*tour < 1 || *tour > len(competition.Tours)) {
+		return "", "", fmt.Errorf("Неверный номер тура (доступно туров: %d)", len(competition.Tours))
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" || len([]rune(reason)) > maxPenaltyReasonLength {
+		return "", "", fmt.Errorf("Причина должна быть от 1 до %d символов", maxPenaltyReasonLength)
+	}
+
+	return t, reason, nil
+}
+
+// Helper function to convert entity.Penalty to model.Penalty
+func entityToGraphQLPenalty(penalty *entity.Penalty) *model.Penalty {
+	if penalty == nil {
+		return nil
+	}
+
+	history := make([]*model.PenaltyChange, len(penalty.History))
+	for i, h := range penalty.History {
+		history[i] = &model.PenaltyChange{
+			Action:    string(h.Action),
+			UserID:    h.UserID,
+			Tour:      h.Tour,
+			Type:      string(h.Type),
+			Reason:    h.Reason,
+			ChangedAt: scalars.Time(h.ChangedAt),
+		}
+	}
+
+	var createdAt *scalars.Time
+	if !penalty.CreatedAt.IsZero() {
+		t := scalars.Time(penalty.CreatedAt)
+		createdAt = &t
+	}
+
+	var updatedAt *scalars.Time
+	if !penalty.UpdatedAt.IsZero() {
+		t := scalars.Time(penalty.UpdatedAt)
+		updatedAt = &t
+	}
+
+	return &model.Penalty{
+		ID:             penalty.ID,
+		CompetitionID:  penalty.CompetitionID,
+		RegistrationID: penalty.RegistrationID,
+		Tour:           penalty.Tour,
+		Type:           string(penalty.Type),
+		Reason:         penalty.Reason,
+		JudgeID:        penalty.JudgeID,
+		Deleted:        penalty.DeletedAt != nil,
+		History:        history,
+		CreatedAt:      createdAt,
+		UpdatedAt:      updatedAt,
+	}
+}
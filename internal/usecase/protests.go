@@ -0,0 +1,365 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cnpf/feeder-backend/graph/model"
+	"github.com/cnpf/feeder-backend/graph/scalars"
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	apperrors "github.com/cnpf/feeder-backend/internal/errors"
+)
+
+const (
+	defaultProtestWindowMinutes = 60
+	maxProtestWindowMinutes     = 7 * 24 * 60
+	maxProtestTextLength        = 2000
+)
+
+// FileProtest implements UseCase.FileProtest
+// Only the owner of a registration in the competition can protest, and only within the protest window
+// counted from the moment the disputed result or penalty was last changed
+func (u *UseCaseImpl) FileProtest(ctx context.Context, userID string, input *model.ProtestInput) (*model.Protest, error) {
+	if input == nil {
+		return nil, fmt.Errorf("Входные данные не могут быть пустыми")
+	}
+
+	reg, err := u.registrationRepo.FindByID(ctx, input.RegistrationID)
+	if err != nil {
+		return nil, fmt.Errorf("Регистрация не найдена")
+	}
+	if reg.UserID != userID {
+		return nil, fmt.Errorf("Доступ запрещен: протест подается от своей регистрации")
+	}
+
+	competition, err := u.competitionRepo.FindByID(ctx, reg.CompetitionID)
+	if err != nil {
+		return nil, fmt.Errorf("Соревнование не найдено")
+	}
+
+	reason := strings.TrimSpace(input.Reason)
+	if reason == "" || len([]rune(reason)) > maxProtestTextLength {
+		return nil, fmt.Errorf("Текст протеста должен быть от 1 до %d символов", maxProtestTextLength)
+	}
+
+	targetType := entity.ProtestTarget(input.TargetType)
+	var targetRegID string
+	var postedAt time.Time
+	switch targetType {
+	case entity.ProtestTargetResult:
+		result, err := u.resultRepo.FindByID(ctx, input.TargetID)
+		if err != nil || result.CompetitionID != competition.ID {
+			return nil, fmt.Errorf("Результат не найден")
+		}
+		targetRegID, postedAt = result.RegistrationID, result.UpdatedAt
+	case entity.ProtestTargetPenalty:
+		penalty, err := u.penaltyRepo.FindByID(ctx, input.TargetID)
+		if err != nil || penalty.DeletedAt != nil || penalty.CompetitionID != competition.ID {
+			return nil, fmt.Errorf("Штраф не найден")
+		}
+		targetRegID, postedAt = penalty.RegistrationID, penalty.UpdatedAt
+	default:
+		return nil, fmt.Errorf("Неверный предмет протеста (result, penalty)")
+	}
+
+	window := defaultProtestWindowMinutes
+	if competition.ProtestWindow != nil {
+		window = *competition.ProtestWindow
+	}
+	if time.Since(postedAt) > time.Duration(window)*time.Minute {
+		return nil, fmt.Errorf("Срок подачи протеста истек (%d мин)", window)
+	}
+
+	openStatus := entity.ProtestStatusOpen
+	openProtests, err := u.protestRepo.FindByCompetitionID(ctx, competition.ID, &openStatus)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить протесты", err)
+	}
+	for _, p := range openProtests {
+		if p.RegistrationID == reg.ID && p.TargetID == input.TargetID {
+			return nil, fmt.Errorf("Протест по этому решению уже подан")
+		}
+	}
+
+	protest := &entity.Protest{
+		CompetitionID:  competition.ID,
+		RegistrationID: reg.ID,
+		FiledBy:        userID,
+		TargetType:     targetType,
+		TargetID:       input.TargetID,
+		TargetRegID:    targetRegID,
+		Reason:         reason,
+	}
+
+	protestID, err := u.protestRepo.Create(ctx, protest)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось подать протест", err)
+	}
+
+	createdProtest, err := u.protestRepo.FindByID(ctx, protestID)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось найти поданный протест", err)
+	}
+
+	recipients := u.juryUserIDs(ctx, competition)
+	if targetReg, err := u.registrationRepo.FindByID(ctx, targetRegID); err == nil && targetReg.UserID != userID {
+		recipients = append(recipients, targetReg.UserID)
+	}
+	u.notifyUsers(ctx, recipients, entity.Notification{
+		Type:       "protest_filed",
+		Title:      fmt.Sprintf("Протест: %s", competition.Title),
+		Message:    fmt.Sprintf("Подан протест (%s) на соревновании «%s»:\n\n%s", protestTargetName(targetType), competition.Title, reason),
+		EntityType: "protest",
+		EntityID:   protestID,
+	})
+
+	return entityToGraphQLProtest(createdProtest), nil
+}
+
+// WithdrawProtest implements UseCase.WithdrawProtest
+func (u *UseCaseImpl) WithdrawProtest(ctx context.Context, userID string, id string) (*model.Protest, error) {
+	protest, err := u.protestRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("Протест не найден")
+	}
+	if protest.FiledBy != userID {
+		return nil, fmt.Errorf("Доступ запрещен")
+	}
+	if protest.Status != entity.ProtestStatusOpen {
+		return nil, fmt.Errorf("Протест уже рассмотрен")
+	}
+
+	protest.Status = entity.ProtestStatusWithdrawn
+	if err := u.protestRepo.UpdateStatus(ctx, protest); err != nil {
+		return nil, apperrors.WrapError("Не удалось отозвать протест", err)
+	}
+
+	if competition, err := u.competitionRepo.FindByID(ctx, protest.CompetitionID); err == nil {
+		u.notifyUsers(ctx, u.juryUserIDs(ctx, competition), entity.Notification{
+			Type:       "protest_withdrawn",
+			Title:      fmt.Sprintf("Протест отозван: %s", competition.Title),
+			Message:    fmt.Sprintf("Протест на соревновании «%s» отозван заявителем.", competition.Title),
+			EntityType: "protest",
+			EntityID:   protest.ID,
+		})
+	}
+
+	return u.getProtest(ctx, id)
+}
+
+// DecideProtest implements UseCase.DecideProtest
+// An upheld protest against a result replaces the result with the corrected catch;
+// an upheld protest against a penalty cancels the penalty. Both happen in one transaction with the decision
+func (u *UseCaseImpl) DecideProtest(ctx context.Context, userID string, id string, input *model.DecideProtestInput) (*model.Protest, error) {
+	if input == nil {
+		return nil, fmt.Errorf("Входные данные не могут быть пустыми")
+	}
+
+	protest, err := u.protestRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("Протест не найден")
+	}
+	if protest.Status != entity.ProtestStatusOpen {
+		return nil, fmt.Errorf("Протест уже рассмотрен")
+	}
+
+	competition, err := u.competitionRepo.FindByID(ctx, protest.CompetitionID)
+	if err != nil {
+		return nil, fmt.Errorf("Соревнование не найдено")
+	}
+	if err := u.checkJudge(ctx, userID, competition); err != nil {
+		return nil, err
+	}
+
+	decision := strings.TrimSpace(input.Decision)
+	if decision == "" || len([]rune(decision)) > maxProtestTextLength {
+		return nil, fmt.Errorf("Решение должно быть от 1 до %d символов", maxProtestTextLength)
+	}
+
+	protest.Status = entity.ProtestStatusRejected
+	if input.Upheld {
+		protest.Status = entity.ProtestStatusUpheld
+	}
+	protest.Decision = &decision
+	protest.DecidedBy = &userID
+
+	var correctedResult *entity.TourResult
+	if input.Upheld && protest.TargetType == entity.ProtestTargetResult {
+		if input.Weight == nil || input.FishCount == nil {
+			return nil, fmt.Errorf("Укажите исправленный вес и количество рыб")
+		}
+		result, err := u.resultRepo.FindByID(ctx, protest.TargetID)
+		if err != nil {
+			return nil, fmt.Errorf("Результат не найден")
+		}
+		if err := validateTourResult(competition, result.Tour, *input.Weight, *input.FishCount); err != nil {
+			return nil, err
+		}
+		result.Weight = *input.Weight
+		result.FishCount = *input.FishCount
+		result.UpdatedAt = time.Now()
+		correctedResult = result
+	}
+
+	err = u.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if correctedResult != nil {
+			if _, err := u.resultRepo.Upsert(ctx, correctedResult); err != nil {
+				return err
+			}
+		}
+		if input.Upheld && protest.TargetType == entity.ProtestTargetPenalty {
+			penalty, err := u.penaltyRepo.FindByID(ctx, protest.TargetID)
+			if err != nil {
+				return err
+			}
+			if penalty.DeletedAt == nil {
+				err = u.penaltyRepo.Delete(ctx, penalty.ID, entity.PenaltyChange{
+					Action:    entity.PenaltyActionDeleted,
+					UserID:    userID,
+					Tour:      penalty.Tour,
+					Type:      penalty.Type,
+					Reason:    penalty.Reason,
+					ChangedAt: time.Now(),
+				})
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return u.protestRepo.UpdateStatus(ctx, protest)
+	})
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось сохранить решение по протесту", err)
+	}
+
+	recipients := []string{protest.FiledBy}
+	if targetReg, err := u.registrationRepo.FindByID(ctx, protest.TargetRegID); err == nil {
+		recipients = append(recipients, targetReg.UserID)
+	}
+	verdict := "отклонен"
+	if input.Upheld {
+		verdict = "удовлетворен"
+	}
+	u.notifyUsers(ctx, recipients, entity.Notification{
+		Type:       "protest_decided",
+		Title:      fmt.Sprintf("Решение по протесту: %s", competition.Title),
+		Message:    fmt.Sprintf("Протест на соревновании «%s» %s.\n\nРешение жюри: %s", competition.Title, verdict, decision),
+		EntityType: "protest",
+		EntityID:   protest.ID,
+	})
+
+	return u.getProtest(ctx, id)
+}
+
+// GetProtests implements UseCase.GetProtests
+// Judges and admins see all protests; participants see protests they filed or that concern them
+func (u *UseCaseImpl) GetProtests(ctx context.Context, userID string, competitionID string, status *string) ([]*model.Protest, error) {
+	competition, err := u.competitionRepo.FindByID(ctx, competitionID)
+	if err != nil {
+		return nil, fmt.Errorf("Соревнование не найдено")
+	}
+
+	var statusFilter *entity.ProtestStatus
+	if status != nil && *status != "" {
+		s := entity.ProtestStatus(*status)
+		statusFilter = &s
+	}
+
+	protests, err := u.protestRepo.FindByCompetitionID(ctx, competitionID, statusFilter)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить протесты", err)
+	}
+
+	ownRegistrations := map[string]bool{}
+	isJury := u.checkJudge(ctx, userID, competition) == nil
+	if !isJury {
+		registrations, err := u.registrationRepo.FindByCompetitionID(ctx, competitionID)
+		if err != nil {
+			return nil, apperrors.WrapError("Не удалось получить регистрации", err)
+		}
+		for _, reg := range registrations {
+			if reg.UserID == userID {
+				ownRegistrations[reg.ID] = true
+			}
+		}
+	}
+
+	result := make([]*model.Protest, 0, len(protests))
+	for _, p := range protests {
+		if isJury || p.FiledBy == userID || ownRegistrations[p.TargetRegID] {
+			result = append(result, entityToGraphQLProtest(p))
+		}
+	}
+	return result, nil
+}
+
+func (u *UseCaseImpl) getProtest(ctx context.Context, id string) (*model.Protest, error) {
+	protest, err := u.protestRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось найти протест", err)
+	}
+	return entityToGraphQLProtest(protest), nil
+}
+
+// juryUserIDs returns the judges of a competition, or all admins when no judges are assigned
+func (u *UseCaseImpl) juryUserIDs(ctx context.Context, competition *entity.Competition) []string {
+	if len(competition.JudgeIDs) > 0 {
+		return append([]string{}, competition.JudgeIDs...)
+	}
+
+	users, err := u.userRepo.FindAll(ctx)
+	if err != nil {
+		return nil
+	}
+	var ids []string
+	for _, user := range users {
+		if user.IsAdmin {
+			ids = append(ids, user.ID)
+		}
+	}
+	return ids
+}
+
+func protestTargetName(target entity.ProtestTarget) string {
+	if target == entity.ProtestTargetPenalty {
+		return "на штраф"
+	}
+	return "на результат"
+}
+
+// Helper function to convert entity.Protest to model.Protest
+func entityToGraphQLProtest(protest *entity.Protest) *model.Protest {
+	if protest == nil {
+		return nil
+	}
+
+	var decidedAt *scalars.Time
+	if protest.DecidedAt != nil {
+		t := scalars.Time(*protest.DecidedAt)
+		decidedAt = &t
+	}
+
+	var createdAt *scalars.Time
+	if !protest.CreatedAt.IsZero() {
+		t := scalars.Time(protest.CreatedAt)
+		createdAt = &t
+	}
+
+	return &model.Protest{
+		ID:                   protest.ID,
+		CompetitionID:        protest.CompetitionID,
+		RegistrationID:       protest.RegistrationID,
+		FiledBy:              protest.FiledBy,
+		TargetType:           string(protest.TargetType),
+		TargetID:             protest.TargetID,
+		TargetRegistrationID: protest.TargetRegID,
+		Reason:               protest.Reason,
+		Status:               string(protest.Status),
+		Decision:             protest.Decision,
+		DecidedBy:            protest.DecidedBy,
+		DecidedAt:            decidedAt,
+		CreatedAt:            createdAt,
+	}
+}
@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/cnpf/feeder-backend/graph/model"
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	apperrors "github.com/cnpf/feeder-backend/internal/errors"
+)
+
+// A reaction weighs as much as several views in the popularity score
+const reactionPopularityWeight = 3
+
+const (
+	defaultPopularLimit = 10
+	maxPopularLimit     = 30
+)
+
+// popularityPeriods maps popularReports periods to their length; "all" counts everything
+var popularityPeriods = map[string]time.Duration{
+	"day":   24 * time.Hour,
+	"week":  7 * 24 * time.Hour,
+	"month": 30 * 24 * time.Hour,
+	"all":   0,
+}
+
+// ToggleReaction implements UseCase.ToggleReaction
+// Repeating a reaction removes it; each reaction type is toggled independently
+func (u *UseCaseImpl) ToggleReaction(ctx context.Context, userID string, reportID string, reactionType string) (*model.Report, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	if !entity.IsValidReactionType(entity.ReactionType(reactionType)) {
+		return nil, fmt.Errorf("Неверный тип реакции (допустимо: like, heart, fire, fish, wow)")
+	}
+
+	report, err := u.reportRepo.FindByID(ctx, reportID)
+	if err != nil || !report.IsPublished() {
+		return nil, fmt.Errorf("Отчет не найден")
+	}
+
+	if _, err := u.reactionRepo.Toggle(ctx, reportID, userID, entity.ReactionType(reactionType)); err != nil {
+		return nil, apperrors.WrapError("Не удалось сохранить реакцию", err)
+	}
+
+	return u.entityToGraphQLReport(ctx, report, userID)
+}
+
+// recordReportView counts a view of a published report; authors reading their own reports are not counted
+// viewerKey identifies the reader, so that reloading the page does not add views
+func (u *UseCaseImpl) recordReportView(ctx context.Context, report *entity.Report, currentUserID string, viewerKey string) {
+	if viewerKey == "" || !report.IsPublished() || report.AuthorID == currentUserID {
+		return
+	}
+	if _, err := u.reportViewRepo.Record(ctx, report.ID, viewerKey, time.Now()); err != nil {
+		log.Printf("Failed to record view of report %s: %v", report.ID, err)
+	}
+}
+
+// reactionCounts lists the reactions of a report in the order of entity.ReactionTypes, without zero counts;
+// reacted are the types the current user has left
+func reactionCounts(counts map[entity.ReactionType]int64, reacted []entity.ReactionType) []*model.ReactionCount {
+	reactedTypes := make(map[entity.ReactionType]bool, len(reacted))
+	for _, t := range reacted {
+		reactedTypes[t] = true
+	}
+
+	reactions := make([]*model.ReactionCount, 0, len(counts))
+	for _, t := range entity.ReactionTypes {
+		if counts[t] == 0 {
+			continue
+		}
+		reactions = append(reactions, &model.ReactionCount{
+			Type:    string(t),
+			Count:   int(counts[t]),
+			Reacted: reactedTypes[t],
+		})
+	}
+	return reactions
+}
+
+// GetPopularReports implements UseCase.GetPopularReports
+// Reports are ranked by views plus weighted reactions received during the period (a week by default)
+func (u *UseCaseImpl) GetPopularReports(ctx context.Context, currentUserID string, period *string, limit *int) ([]*model.Report, error) {
+	periodName := "week"
+	if period != nil && *period != "" {
+		periodName = *period
+	}
+	length, ok := popularityPeriods[periodName]
+	if !ok {
+		return nil, fmt.Errorf("Неверный период (допустимо: day, week, month, all)")
+	}
+
+	reportLimit := defaultPopularLimit
+	if limit != nil && *limit > 0 {
+		reportLimit = min(*limit, maxPopularLimit)
+	}
+
+	var since time.Time
+	if length > 0 {
+		since = time.Now().Add(-length)
+	}
+	views, err := u.reportViewRepo.CountSince(ctx, since)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось подсчитать просмотры", err)
+	}
+	reactions, err := u.reactionRepo.CountSince(ctx, since)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось подсчитать реакции", err)
+	}
+
+	scores := make(map[string]int64, len(views))
+	for reportID, count := range views {
+		scores[reportID] += count
+	}
+	for reportID, count := range reactions {
+		scores[reportID] += count * reactionPopularityWeight
+	}
+
+	// Ties go to newer reports (greater ObjectIDs)
+	ranked := make([]string, 0, len(scores))
+	for reportID := range scores {
+		ranked = append(ranked, reportID)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if scores[ranked[i]] != scores[ranked[j]] {
+			return scores[ranked[i]] > scores[ranked[j]]
+		}
+		return ranked[i] > ranked[j]
+	})
+
+	// Reports moved to the trash or back to drafts are skipped; candidates are loaded in pages, as most are published
+	reports := make([]*entity.Report, 0, reportLimit)
+	for start := 0; start < len(ranked) && len(reports) < reportLimit; start += reportLimit {
+		page := ranked[start:min(start+reportLimit, len(ranked))]
+		found, err := u.reportRepo.FindByIDs(ctx, page)
+		if err != nil {
+			return nil, apperrors.WrapError("Не удалось получить отчеты", err)
+		}
+		byID := make(map[string]*entity.Report, len(found))
+		for _, report := range found {
+			byID[report.ID] = report
+		}
+		for _, reportID := range page {
+			if report, ok := byID[reportID]; ok && report.IsPublished() && len(reports) < reportLimit {
+				reports = append(reports, report)
+			}
+		}
+	}
+
+	return u.reportsToGraphQL(ctx, reports, currentUserID), nil
+}
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+)
+
+// reportLink validates the competition and tour reference of a report against its current link
+func (u *UseCaseImpl) reportLink(ctx context.Context, report *entity.Report, link *ReportLinkInput) (*string, *int, error) {
+	if link.Unlink {
+		return nil, nil, nil
+	}
+
+	competitionID := report.CompetitionID
+	if link.CompetitionID != nil && *link.CompetitionID != "" {
+		competitionID = link.CompetitionID
+	}
+	if competitionID == nil {
+		if link.Tour != nil && *link.Tour != 0 {
+			return nil, nil, fmt.Errorf("Тур указывается только вместе с соревнованием")
+		}
+		return nil, nil, nil
+	}
+
+	competition, err := u.competitionRepo.FindByID(ctx, *competitionID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Соревнование не найдено")
+	}
+	if link.Tour == nil || *link.Tour == 0 {
+		return &competition.ID, nil, nil
+	}
+	if *link.Tour < 1 || *link.Tour > len(competition.Tours) {
+		return nil, nil, fmt.Errorf("Неверный номер тура (доступно туров: %d)", len(competition.Tours))
+	}
+	tour := *link.Tour
+	return &competition.ID, &tour, nil
+}
@@ -0,0 +1,296 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cnpf/feeder-backend/graph/model"
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	apperrors "github.com/cnpf/feeder-backend/internal/errors"
+)
+
+const (
+	maxPhotoCaptionLength = 300
+	maxPhotographerLength = 100
+)
+
+// reportPhotoChanges are the photo edits of UpdateReport, each applied to the stored report by photo ID
+type reportPhotoChanges struct {
+	remove  []string
+	updated []entity.ReportPhoto // Photos with a new caption, photographer or position
+	added   []entity.ReportPhoto
+}
+
+func (c *reportPhotoChanges) empty() bool {
+	return len(c.remove) == 0 && len(c.updated) == 0 && len(c.added) == 0
+}
+
+// readReportPhotos validates and reads uploaded photos with their details; positions continue after firstPosition
+// The photos are charged to the account once they are saved
+func readReportPhotos(account *storageAccount, input *ReportPhotosInput, firstPosition int) ([]entity.ReportPhoto, error) {
+	if input == nil {
+		return nil, nil
+	}
+	if err := account.checkReportPhotos(len(input.Uploads)); err != nil {
+		return nil, err
+	}
+	if len(input.Details) > len(input.Uploads) {
+		return nil, fmt.Errorf("Подписей больше, чем фотографий")
+	}
+
+	photos := make([]entity.ReportPhoto, 0, len(input.Uploads))
+	for i, upload := range input.Uploads {
+		if upload == nil {
+			continue
+		}
+
+		if err := account.checkFile(storageFilePhoto, upload.ContentType, upload.Size); err != nil {
+			return nil, err
+		}
+
+		data, err := io.ReadAll(upload.File)
+		if err != nil {
+			return nil, apperrors.WrapError("Не удалось прочитать файл фотографии", err)
+		}
+		if err := account.checkFile(storageFilePhoto, upload.ContentType, int64(len(data))); err != nil {
+			return nil, err
+		}
+
+		photo := entity.ReportPhoto{
+			ContentType: upload.ContentType,
+			Data:        data,
+			Position:    firstPosition + len(photos),
+			Size:        int64(len(data)),
+			UploadedBy:  account.userID,
+		}
+		if i < len(input.Details) {
+			if err := applyPhotoDetails(&photo, input.Details[i]); err != nil {
+				return nil, err
+			}
+		}
+		photos = append(photos, photo)
+	}
+	return photos, nil
+}
+
+// applyPhotoDetails validates and sets the caption and photographer of a photo; empty values remove them
+func applyPhotoDetails(photo *entity.ReportPhoto, details PhotoDetails) error {
+	if details.Caption != nil {
+		caption := strings.TrimSpace(*details.Caption)
+		if utf8.RuneCountInString(caption) > maxPhotoCaptionLength {
+			return fmt.Errorf("Подпись к фотографии должна быть не длиннее %d символов", maxPhotoCaptionLength)
+		}
+		photo.Caption = caption
+	}
+	if details.Photographer != nil {
+		photographer := strings.TrimSpace(*details.Photographer)
+		if utf8.RuneCountInString(photographer) > maxPhotographerLength {
+			return fmt.Errorf("Имя фотографа должно быть не длиннее %d символов", maxPhotographerLength)
+		}
+		photo.Photographer = photographer
+	}
+	return nil
+}
+
+// planReportPhotos applies the photo edits to the current photos of a report and returns the photos after them
+// with the changes to store. Photos other editors removed meanwhile are skipped rather than reported as errors
+func planReportPhotos(account *storageAccount, current []entity.ReportPhoto, input *ReportPhotosInput) ([]entity.ReportPhoto, *reportPhotoChanges, error) {
+	changes := &reportPhotoChanges{}
+	if input == nil {
+		return current, changes, nil
+	}
+
+	removed := make(map[string]bool)
+	for _, id := range input.Remove {
+		removed[id] = true
+	}
+	for _, index := range input.RemoveAt {
+		if index >= 0 && index < len(current) {
+			removed[current[index].ID] = true
+		}
+	}
+
+	photos := make([]entity.ReportPhoto, 0, len(current))
+	for _, photo := range current {
+		if input.RemoveAll || removed[photo.ID] {
+			changes.remove = append(changes.remove, photo.ID)
+			continue
+		}
+		photos = append(photos, photo)
+	}
+
+	updated := make(map[string]bool)
+	for _, update := range input.Updates {
+		for i := range photos {
+			if photos[i].ID != update.ID {
+				continue
+			}
+			if err := applyPhotoDetails(&photos[i], update.PhotoDetails); err != nil {
+				return nil, nil, err
+			}
+			updated[update.ID] = true
+		}
+	}
+
+	if len(input.Order) > 0 {
+		rank := make(map[string]int, len(input.Order))
+		for i, id := range input.Order {
+			if _, ok := rank[id]; !ok {
+				rank[id] = i
+			}
+		}
+		sort.SliceStable(photos, func(i, j int) bool {
+			ri, okI := rank[photos[i].ID]
+			rj, okJ := rank[photos[j].ID]
+			if okI && okJ {
+				return ri < rj
+			}
+			return okI && !okJ
+		})
+		for i := range photos {
+			if photos[i].Position != i {
+				photos[i].Position = i
+				updated[photos[i].ID] = true
+			}
+		}
+	}
+
+	for _, photo := range photos {
+		if updated[photo.ID] {
+			changes.updated = append(changes.updated, photo)
+		}
+	}
+
+	nextPosition := 0
+	for _, photo := range photos {
+		if photo.Position >= nextPosition {
+			nextPosition = photo.Position + 1
+		}
+	}
+	added, err := readReportPhotos(account, input, nextPosition)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(added) > 0 {
+		if err := account.checkReportPhotos(len(photos) + len(added)); err != nil {
+			return nil, nil, err
+		}
+	}
+	changes.added = added
+
+	return append(photos, added...), changes, nil
+}
+
+// saveReportPhotos stores the photo edits of a report, releasing the storage of removed photos and charging
+// the added ones to the account. The photo limit is checked again by the repository because photos may have
+// been added by another editor since the report was loaded
+func (u *UseCaseImpl) saveReportPhotos(ctx context.Context, account *storageAccount, reportID string, changes *reportPhotoChanges) error {
+	if len(changes.remove) > 0 {
+		removed, err := u.reportRepo.RemovePhotos(ctx, reportID, changes.remove)
+		if err != nil {
+			return err
+		}
+		if err := u.releaseStorage(ctx, photoStorage(removed)); err != nil {
+			return err
+		}
+	}
+	for _, photo := range changes.updated {
+		if err := u.reportRepo.UpdatePhoto(ctx, reportID, photo); err != nil {
+			return err
+		}
+	}
+	if len(changes.added) > 0 {
+		if err := u.reserveStorage(ctx, account, photoStorage(changes.added)[account.userID]); err != nil {
+			return err
+		}
+		added, err := u.reportRepo.AddPhotos(ctx, reportID, changes.added, account.limits.MaxReportPhotos)
+		if err != nil {
+			return err
+		}
+		if !added {
+			return fmt.Errorf("Слишком много фотографий (макс %d)", account.limits.MaxReportPhotos)
+		}
+	}
+	return nil
+}
+
+// GetReportPhoto implements UseCase.GetReportPhoto
+// photoRef is the photo ID; a number is the position, as in photo URLs issued before photos had IDs
+func (u *UseCaseImpl) GetReportPhoto(ctx context.Context, currentUserID string, reportID string, photoRef string) (*ExportFile, error) {
+	report, err := u.reportRepo.FindByID(ctx, reportID)
+	if err != nil {
+		return nil, fmt.Errorf("Отчет не найден")
+	}
+	if !report.IsPublished() && !u.canEditReport(ctx, currentUserID, report) {
+		return nil, fmt.Errorf("Отчет не найден")
+	}
+
+	index := report.PhotoIndex(photoRef)
+	if position, err := strconv.Atoi(photoRef); err == nil && index < 0 {
+		index = position
+	}
+	if index < 0 || index >= len(report.Photos) {
+		return nil, fmt.Errorf("Фотография не найдена")
+	}
+
+	photo := report.Photos[index]
+	return &ExportFile{
+		Data:        photo.Data,
+		ContentType: photo.ContentType,
+		FileName:    "photo-" + photo.ID,
+	}, nil
+}
+
+// GetCompetitionGallery implements UseCase.GetCompetitionGallery
+// Photos of published reports linked to the competition: reports about the whole competition first,
+// then by tour; within a tour by report publication and photo order
+func (u *UseCaseImpl) GetCompetitionGallery(ctx context.Context, competitionID string, tour *int) ([]*model.GalleryPhoto, error) {
+	if _, err := u.competitionRepo.FindByID(ctx, competitionID); err != nil {
+		return nil, fmt.Errorf("Соревнование не найдено")
+	}
+
+	reports, err := u.reportRepo.FindCompetitionPhotos(ctx, competitionID)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить фотографии", err)
+	}
+
+	tourOf := func(r *entity.Report) int {
+		if r.Tour == nil {
+			return 0
+		}
+		return *r.Tour
+	}
+	sort.SliceStable(reports, func(i, j int) bool { return tourOf(reports[i]) < tourOf(reports[j]) })
+
+	gallery := make([]*model.GalleryPhoto, 0)
+	for _, report := range reports {
+		if tour != nil && tourOf(report) != *tour {
+			continue
+		}
+		for i, photo := range report.Photos {
+			gallery = append(gallery, &model.GalleryPhoto{
+				Photo:       entityToGraphQLReportPhoto(report.ID, photo, i),
+				ReportID:    report.ID,
+				ReportTitle: report.Title,
+				Tour:        report.Tour,
+			})
+		}
+	}
+	return gallery, nil
+}
+
+// entityToGraphQLReportPhoto converts a report photo; position is its index in the report
+func entityToGraphQLReportPhoto(reportID string, photo entity.ReportPhoto, position int) *model.ReportPhoto {
+	return &model.ReportPhoto{
+		ID:           photo.ID,
+		URL:          reportPhotoURL(reportID, photo.ID),
+		Caption:      textValue(photo.Caption),
+		Photographer: textValue(photo.Photographer),
+		Position:     position,
+	}
+}
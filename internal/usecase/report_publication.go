@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cnpf/feeder-backend/graph/model"
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	apperrors "github.com/cnpf/feeder-backend/internal/errors"
+)
+
+const maxReportDrafts = 50
+
+// reportPublicationFromInput validates the requested status of a new report and returns its publication times
+// Without a status the report is published right away, or scheduled when publishAt is given
+func reportPublicationFromInput(status, publishAt *string, now time.Time) (entity.ReportStatus, *time.Time, *time.Time, error) {
+	reportStatus := entity.ReportStatusPublished
+	if publishAt != nil && *publishAt != "" {
+		reportStatus = entity.ReportStatusScheduled
+	}
+	if status != nil && *status != "" {
+		reportStatus = entity.ReportStatus(*status)
+		if !entity.IsValidReportStatus(reportStatus) {
+			return "", nil, nil, fmt.Errorf("Неверный статус отчета (допустимо: draft, scheduled, published)")
+		}
+	}
+
+	switch reportStatus {
+	case entity.ReportStatusScheduled:
+		if publishAt == nil || *publishAt == "" {
+			return "", nil, nil, fmt.Errorf("Укажите время публикации")
+		}
+		publishTime, err := parsePublishAt(*publishAt, now)
+		if err != nil {
+			return "", nil, nil, err
+		}
+		return reportStatus, &publishTime, nil, nil
+	case entity.ReportStatusPublished:
+		if publishAt != nil && *publishAt != "" {
+			return "", nil, nil, fmt.Errorf("Время публикации указывается только для отложенной публикации")
+		}
+		return reportStatus, nil, &now, nil
+	default:
+		if publishAt != nil && *publishAt != "" {
+			return "", nil, nil, fmt.Errorf("Время публикации указывается только для отложенной публикации")
+		}
+		return reportStatus, nil, nil, nil
+	}
+}
+
+// parsePublishAt parses the scheduled publication time, which must be in the future
+func parsePublishAt(value string, now time.Time) (time.Time, error) {
+	publishAt, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Неверное время публикации: %w", err)
+	}
+	if !publishAt.After(now) {
+		return time.Time{}, fmt.Errorf("Время публикации должно быть в будущем")
+	}
+	return publishAt, nil
+}
+
+// GetReportDrafts implements UseCase.GetReportDrafts
+// Admins see drafts and scheduled reports of all authors, other users see their own
+func (u *UseCaseImpl) GetReportDrafts(ctx context.Context, userID string, limit *int) ([]*model.Report, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	user, err := u.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("Пользователь не найден")
+	}
+
+	draftsLimit := maxReportDrafts
+	if limit != nil && *limit > 0 && *limit < maxReportDrafts {
+		draftsLimit = *limit
+	}
+
+	var authorID *string
+	if !user.IsAdmin {
+		authorID = &user.ID
+	}
+	reports, err := u.reportRepo.FindUnpublished(ctx, authorID, draftsLimit)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить черновики", err)
+	}
+
+	return u.reportsToGraphQL(ctx, reports, userID), nil
+}
+
+// PublishReport implements UseCase.PublishReport
+func (u *UseCaseImpl) PublishReport(ctx context.Context, userID string, id string) (*model.Report, error) {
+	now := time.Now()
+	return u.setReportPublication(ctx, userID, id, entity.ReportStatusPublished, nil, &now)
+}
+
+// ScheduleReport implements UseCase.ScheduleReport
+// The report is published by the background job; a published report is taken down until then
+func (u *UseCaseImpl) ScheduleReport(ctx context.Context, userID string, id string, publishAt string) (*model.Report, error) {
+	publishTime, err := parsePublishAt(publishAt, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return u.setReportPublication(ctx, userID, id, entity.ReportStatusScheduled, &publishTime, nil)
+}
+
+// UnpublishReport implements UseCase.UnpublishReport
+// The report goes back to drafts, a scheduled publication is cancelled
+func (u *UseCaseImpl) UnpublishReport(ctx context.Context, userID string, id string) (*model.Report, error) {
+	return u.setReportPublication(ctx, userID, id, entity.ReportStatusDraft, nil, nil)
+}
+
+func (u *UseCaseImpl) setReportPublication(ctx context.Context, userID string, id string, status entity.ReportStatus, publishAt, publishedAt *time.Time) (*model.Report, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+
+	report, err := u.reportRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("Отчет не найден")
+	}
+	if !u.canEditReport(ctx, userID, report) {
+		return nil, fmt.Errorf("Доступ запрещен")
+	}
+	if report.Status == status && status != entity.ReportStatusScheduled {
+		if status == entity.ReportStatusPublished {
+			return nil, fmt.Errorf("Отчет уже опубликован")
+		}
+		return nil, fmt.Errorf("Отчет уже в черновиках")
+	}
+
+	if err := u.reportRepo.SetPublication(ctx, id, status, publishAt, publishedAt); err != nil {
+		return nil, apperrors.WrapError("Не удалось изменить статус отчета", err)
+	}
+
+	updated, err := u.reportRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось найти обновленный отчет", err)
+	}
+	return u.entityToGraphQLReport(ctx, updated, userID)
+}
+
+// PublishScheduledReports implements UseCase.PublishScheduledReports
+// Called periodically by the server; returns the number of published reports
+func (u *UseCaseImpl) PublishScheduledReports(ctx context.Context) (int, error) {
+	published, err := u.reportRepo.PublishDue(ctx, time.Now())
+	if err != nil {
+		return 0, apperrors.WrapError("Не удалось опубликовать отложенные отчеты", err)
+	}
+	return int(published), nil
+}
+
+// reportsToGraphQL converts reports, loading their extras for the whole list at once
+func (u *UseCaseImpl) reportsToGraphQL(ctx context.Context, reports []*entity.Report, userID string) []*model.Report {
+	extras := u.loadReportExtras(ctx, reports, userID)
+	result := make([]*model.Report, 0, len(reports))
+	for _, report := range reports {
+		result = append(result, reportToGraphQL(report, extras))
+	}
+	return result
+}
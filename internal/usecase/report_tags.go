@@ -0,0 +1,148 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/cnpf/feeder-backend/graph/model"
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	apperrors "github.com/cnpf/feeder-backend/internal/errors"
+)
+
+const (
+	maxReportTags         = 10
+	minTagLength          = 2
+	maxTagLength          = 30
+	defaultTagSuggestions = 10
+	maxTagSuggestions     = 50
+)
+
+// normalizeTag lowercases a tag and drops the leading '#'; spaces become '-'
+func normalizeTag(tag string) (string, error) {
+	tag = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(tag), "#")))
+	tag = strings.Join(strings.Fields(tag), "-")
+
+	length := len([]rune(tag))
+	if length < minTagLength || length > maxTagLength {
+		return "", fmt.Errorf("Тег должен быть от %d до %d символов: %q", minTagLength, maxTagLength, tag)
+	}
+	for _, r := range tag {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-' && r != '_' {
+			return "", fmt.Errorf("Тег может содержать только буквы, цифры, '-' и '_': %q", tag)
+		}
+	}
+	return tag, nil
+}
+
+// normalizeTags normalizes tags and drops duplicates, keeping their order
+func normalizeTags(tags []string) ([]string, error) {
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool)
+	for _, tag := range tags {
+		tag, err := normalizeTag(tag)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[tag] {
+			seen[tag] = true
+			normalized = append(normalized, tag)
+		}
+	}
+	if len(normalized) > maxReportTags {
+		return nil, fmt.Errorf("Слишком много тегов (макс %d)", maxReportTags)
+	}
+	return normalized, nil
+}
+
+// parseReportCategory checks a category against the fixed list; empty value means no category
+func parseReportCategory(value string) (*entity.ReportCategory, error) {
+	if value == "" {
+		return nil, nil
+	}
+	category := entity.ReportCategory(value)
+	if !entity.IsValidReportCategory(category) {
+		return nil, fmt.Errorf("Неверная категория (допустимо: news, competition_report, technique, tackle_review)")
+	}
+	return &category, nil
+}
+
+// applyReportTaxonomy sets the tags and category of a report; fields missing in the input are kept
+func applyReportTaxonomy(report *entity.Report, taxonomy *ReportTaxonomyInput) error {
+	if taxonomy == nil {
+		return nil
+	}
+	if taxonomy.Tags != nil {
+		tags, err := normalizeTags(taxonomy.Tags)
+		if err != nil {
+			return err
+		}
+		report.Tags = tags
+	}
+	if taxonomy.Category != nil {
+		category, err := parseReportCategory(*taxonomy.Category)
+		if err != nil {
+			return err
+		}
+		report.Category = category
+	}
+	return nil
+}
+
+// reportFilter validates the filter of the reports query
+func reportFilter(filter ReportsFilter) (entity.ReportFilter, error) {
+	var result entity.ReportFilter
+	if filter.CompetitionID != nil && *filter.CompetitionID != "" {
+		result.CompetitionID = filter.CompetitionID
+	}
+	if len(filter.Tags) > 0 {
+		tags, err := normalizeTags(filter.Tags)
+		if err != nil {
+			return entity.ReportFilter{}, err
+		}
+		result.Tags = tags
+	}
+	if filter.Category != nil {
+		category, err := parseReportCategory(*filter.Category)
+		if err != nil {
+			return entity.ReportFilter{}, err
+		}
+		result.Category = category
+	}
+	return result, nil
+}
+
+// GetTagSuggestions implements UseCase.GetTagSuggestions
+// Suggests tags of published reports starting with the prefix, most used first
+func (u *UseCaseImpl) GetTagSuggestions(ctx context.Context, prefix *string, limit *int) ([]*model.TagCount, error) {
+	tagPrefix := ""
+	if prefix != nil {
+		tagPrefix = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(*prefix), "#"))
+	}
+
+	suggestionsLimit := defaultTagSuggestions
+	if limit != nil && *limit > 0 {
+		suggestionsLimit = min(*limit, maxTagSuggestions)
+	}
+
+	tags, err := u.reportRepo.CountTags(ctx, tagPrefix, suggestionsLimit)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить теги", err)
+	}
+
+	result := make([]*model.TagCount, len(tags))
+	for i, tag := range tags {
+		result[i] = &model.TagCount{Tag: tag.Tag, Count: tag.Count}
+	}
+	return result, nil
+}
+
+// GetReportCategories implements UseCase.GetReportCategories
+func (u *UseCaseImpl) GetReportCategories() []*model.ReportCategory {
+	categories := make([]*model.ReportCategory, len(entity.ReportCategoryNames))
+	for i, c := range entity.ReportCategoryNames {
+		categories[i] = &model.ReportCategory{ID: string(c.Category), Name: c.Name}
+	}
+	return categories
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	"github.com/cnpf/feeder-backend/internal/richtext"
+)
+
+// Report text is Markdown source; tables of results take more room than plain text did
+const maxReportTextLength = 20000
+
+// validateReportText trims the Markdown source of a report and checks its length
+func validateReportText(text string) (string, error) {
+	text = strings.TrimSpace(text)
+	if length := utf8.RuneCountInString(text); length < 1 || length > maxReportTextLength {
+		return "", fmt.Errorf("Текст должен быть от 1 до %d символов", maxReportTextLength)
+	}
+	return text, nil
+}
+
+// reportTextHTML renders the text of a report into sanitized HTML; ![caption](photo:N) shows the N-th photo,
+// ![caption](photo:<photo ID>) the photo with the ID
+func reportTextHTML(report *entity.Report) string {
+	return richtext.ToHTML(report.Text, func(ref string) (string, bool) {
+		index := report.PhotoIndex(ref)
+		if number, err := strconv.Atoi(ref); err == nil && index < 0 {
+			index = number - 1
+		}
+		if index < 0 || index >= len(report.Photos) {
+			return "", false
+		}
+		return reportPhotoURL(report.ID, report.Photos[index].ID), true
+	})
+}
+
+// reportPhotoURL returns the URL the API serves a report photo at
+func reportPhotoURL(reportID string, photoID string) string {
+	return fmt.Sprintf("/api/reports/%s/photos/%s", reportID, photoID)
+}
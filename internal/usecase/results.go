@@ -0,0 +1,251 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cnpf/feeder-backend/graph/model"
+	"github.com/cnpf/feeder-backend/graph/scalars"
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	apperrors "github.com/cnpf/feeder-backend/internal/errors"
+	"github.com/cnpf/feeder-backend/internal/standings"
+)
+
+const maxTourWeight = 200 * 1000 // 200 kg in grams, guards against typos
+
+// AssignSector implements UseCase.AssignSector
+// Empty sector clears the assignment; with a venue the sector and peg must exist on it
+func (u *UseCaseImpl) AssignSector(ctx context.Context, userID string, registrationID string, sector *string, peg *int) (*model.Registration, error) {
+	reg, err := u.registrationRepo.FindByID(ctx, registrationID)
+	if err != nil {
+		return nil, fmt.Errorf("Регистрация не найдена")
+	}
+
+	if sector != nil {
+		s := strings.TrimSpace(*sector)
+		sector = &s
+		if s == "" {
+			sector = nil
+		}
+	}
+	if sector == nil && peg != nil {
+		return nil, fmt.Errorf("Номер нельзя назначить без сектора")
+	}
+	if peg != nil && *peg < 1 {
+		return nil, fmt.Errorf("Неверный номер")
+	}
+
+	if sector != nil {
+		competition, err := u.competitionRepo.FindByID(ctx, reg.CompetitionID)
+		if err != nil {
+			return nil, fmt.Errorf("Соревнование не найдено")
+		}
+		if err := u.validateSectorOnVenue(ctx, competition, *sector, peg); err != nil {
+			return nil, err
+		}
+
+		if peg != nil {
+			registrations, err := u.registrationRepo.FindByCompetitionID(ctx, reg.CompetitionID)
+			if err != nil {
+				return nil, apperrors.WrapError("Не удалось получить регистрации", err)
+			}
+			for _, other := range registrations {
+				if other.ID != reg.ID && other.Sector != nil && *other.Sector == *sector && other.Peg != nil && *other.Peg == *peg {
+					return nil, fmt.Errorf("Номер %d в секторе %s уже занят", *peg, *sector)
+				}
+			}
+		}
+	}
+
+	if err := u.registrationRepo.AssignSector(ctx, registrationID, sector, peg); err != nil {
+		return nil, apperrors.WrapError("Не удалось назначить сектор", err)
+	}
+
+	updatedReg, err := u.registrationRepo.FindByID(ctx, registrationID)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось найти обновленную регистрацию", err)
+	}
+
+	return u.entityToGraphQLRegistration(updatedReg, userID), nil
+}
+
+// validateSectorOnVenue checks the sector and peg against the venue sectors (if the venue defines any)
+func (u *UseCaseImpl) validateSectorOnVenue(ctx context.Context, competition *entity.Competition, sector string, peg *int) error {
+	if competition.VenueID == nil {
+		return nil
+	}
+	venue, err := u.venueRepo.FindByID(ctx, *competition.VenueID)
+	if err != nil || len(venue.Sectors) == 0 {
+		return nil
+	}
+
+	for _, s := range venue.Sectors {
+		if s.Name != sector {
+			continue
+		}
+		if peg != nil && (*peg < s.PegFrom || *peg > s.PegTo) {
+			return fmt.Errorf("Номер %d вне диапазона сектора %s (%d–%d)", *peg, s.Name, s.PegFrom, s.PegTo)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("Сектор %s не найден на месте проведения", sector)
+}
+
+// SetTourResult implements UseCase.SetTourResult
+// Saving a result for the same registration and tour again replaces it
+func (u *UseCaseImpl) SetTourResult(ctx context.Context, input *model.TourResultInput) (*model.TourResult, error) {
+	if input == nil {
+		return nil, fmt.Errorf("Входные данные не могут быть пустыми")
+	}
+
+	reg, err := u.registrationRepo.FindByID(ctx, input.RegistrationID)
+	if err != nil {
+		return nil, fmt.Errorf("Регистрация не найдена")
+	}
+
+	competition, err := u.competitionRepo.FindByID(ctx, reg.CompetitionID)
+	if err != nil {
+		return nil, fmt.Errorf("Соревнование не найдено")
+	}
+
+	if err := validateTourResult(competition, input.Tour, input.Weight, input.FishCount); err != nil {
+		return nil, err
+	}
+
+	result := &entity.TourResult{
+		CompetitionID:  reg.CompetitionID,
+		RegistrationID: reg.ID,
+		Tour:           input.Tour,
+		Weight:         input.Weight,
+		FishCount:      input.FishCount,
+		UpdatedAt:      time.Now(),
+	}
+
+	resultID, err := u.resultRepo.Upsert(ctx, result)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось сохранить результат", err)
+	}
+
+	savedResult, err := u.resultRepo.FindByID(ctx, resultID)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось найти сохраненный результат", err)
+	}
+
+	return entityToGraphQLTourResult(savedResult), nil
+}
+
+// validateTourResult checks the tour number against the competition schedule and the catch values
+func validateTourResult(competition *entity.Competition, tour, weight, fishCount int) error {
+	if tour < 1 || tour > len(competition.Tours) {
+		return fmt.Errorf("Неверный номер тура (доступно туров: %d)", len(competition.Tours))
+	}
+	if weight < 0 || weight > maxTourWeight {
+		return fmt.Errorf("Неверный вес улова")
+	}
+	if fishCount < 0 {
+		return fmt.Errorf("Неверное количество рыб")
+	}
+	if fishCount == 0 && weight > 0 {
+		return fmt.Errorf("Вес указан без рыб")
+	}
+	return nil
+}
+
+// DeleteTourResult implements UseCase.DeleteTourResult
+func (u *UseCaseImpl) DeleteTourResult(ctx context.Context, id string) (bool, error) {
+	if err := u.resultRepo.Delete(ctx, id); err != nil {
+		return false, apperrors.WrapError("Не удалось удалить результат", err)
+	}
+	return true, nil
+}
+
+// GetTourResults implements UseCase.GetTourResults
+func (u *UseCaseImpl) GetTourResults(ctx context.Context, competitionID string) ([]*model.TourResult, error) {
+	results, err := u.resultRepo.FindByCompetitionID(ctx, competitionID)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить результаты", err)
+	}
+
+	items := make([]*model.TourResult, 0, len(results))
+	for _, result := range results {
+		items = append(items, entityToGraphQLTourResult(result))
+	}
+	return items, nil
+}
+
+// GetStandings implements UseCase.GetStandings
+func (u *UseCaseImpl) GetStandings(ctx context.Context, competitionID string, currentUserID string) ([]*model.Standing, error) {
+	_, rows, err := u.computeStandings(ctx, competitionID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.Standing, 0, len(rows))
+	for _, row := range rows {
+		penalties := make([]*model.Penalty, len(row.Penalties))
+		for i, penalty := range row.Penalties {
+			penalties[i] = entityToGraphQLPenalty(penalty)
+		}
+		items = append(items, &model.Standing{
+			Place:        row.Place,
+			Registration: u.entityToGraphQLRegistration(row.Registration, currentUserID),
+			TourWeights:  row.TourWeights,
+			TotalWeight:  row.TotalWeight,
+			TotalFish:    row.TotalFish,
+			Disqualified: row.Disqualified,
+			Penalties:    penalties,
+		})
+	}
+	return items, nil
+}
+
+// computeStandings loads a competition with its registrations, results and penalties and computes standings
+func (u *UseCaseImpl) computeStandings(ctx context.Context, competitionID string) (*entity.Competition, []*standings.Row, error) {
+	competition, err := u.competitionRepo.FindByID(ctx, competitionID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Соревнование не найдено")
+	}
+
+	registrations, err := u.registrationRepo.FindByCompetitionID(ctx, competitionID)
+	if err != nil {
+		return nil, nil, apperrors.WrapError("Не удалось получить регистрации", err)
+	}
+
+	results, err := u.resultRepo.FindByCompetitionID(ctx, competitionID)
+	if err != nil {
+		return nil, nil, apperrors.WrapError("Не удалось получить результаты", err)
+	}
+
+	penalties, err := u.penaltyRepo.FindByCompetitionID(ctx, competitionID)
+	if err != nil {
+		return nil, nil, apperrors.WrapError("Не удалось получить штрафы", err)
+	}
+
+	return competition, standings.Compute(registrations, results, penalties, len(competition.Tours)), nil
+}
+
+// Helper function to convert entity.TourResult to model.TourResult
+func entityToGraphQLTourResult(result *entity.TourResult) *model.TourResult {
+	if result == nil {
+		return nil
+	}
+
+	var updatedAt *scalars.Time
+	if !result.UpdatedAt.IsZero() {
+		t := scalars.Time(result.UpdatedAt)
+		updatedAt = &t
+	}
+
+	return &model.TourResult{
+		ID:             result.ID,
+		CompetitionID:  result.CompetitionID,
+		RegistrationID: result.RegistrationID,
+		Tour:           result.Tour,
+		Weight:         result.Weight,
+		FishCount:      result.FishCount,
+		UpdatedAt:      updatedAt,
+	}
+}
@@ -0,0 +1,368 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cnpf/feeder-backend/graph/model"
+	"github.com/cnpf/feeder-backend/graph/scalars"
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	apperrors "github.com/cnpf/feeder-backend/internal/errors"
+)
+
+const revisionDateFormat = "2006-01-02"
+
+// fieldValue is a versioned field rendered as text; nil means the field is empty
+type fieldValue struct {
+	name  string
+	value *string
+}
+
+// reportFields lists the versioned fields of a report; photos are compared by count only
+func reportFields(report *entity.Report) []fieldValue {
+	var tour, category *string
+	if report.Tour != nil {
+		tour = textValue(strconv.Itoa(*report.Tour))
+	}
+	if report.Category != nil {
+		category = textValue(string(*report.Category))
+	}
+
+	return []fieldValue{
+		{"title", textValue(report.Title)},
+		{"text", textValue(report.Text)},
+		{"competitionId", report.CompetitionID},
+		{"tour", tour},
+		{"tags", textValue(strings.Join(report.Tags, ", "))},
+		{"category", category},
+		{"photos", textValue(strconv.Itoa(len(report.Photos)))},
+	}
+}
+
+// competitionFields lists the versioned fields of a competition in the order of CompetitionInput
+func competitionFields(c *entity.Competition) []fieldValue {
+	tours := make([]string, len(c.Tours))
+	for i, tour := range c.Tours {
+		tours[i] = strings.TrimSpace(tour.Date.Format(revisionDateFormat) + " " + tour.Time)
+	}
+
+	var fee, teamLimit, protestWindow *string
+	if c.Fee != nil {
+		fee = textValue(strconv.FormatFloat(*c.Fee, 'f', -1, 64))
+	}
+	if c.TeamLimit != nil {
+		teamLimit = textValue(strconv.Itoa(*c.TeamLimit))
+	}
+	if c.ProtestWindow != nil {
+		protestWindow = textValue(strconv.Itoa(*c.ProtestWindow))
+	}
+
+	return []fieldValue{
+		{"title", textValue(c.Title)},
+		{"startDate", dateValue(c.StartDate)},
+		{"endDate", dateValue(c.EndDate)},
+		{"location", textValue(c.Location)},
+		{"venueId", c.VenueID},
+		{"tours", textValue(strings.Join(tours, "; "))},
+		{"openingDate", dateValue(c.OpeningDate)},
+		{"openingTime", c.OpeningTime},
+		{"individualFormat", textValue(strconv.FormatBool(c.IndividualFormat))},
+		{"teamFormat", textValue(strconv.FormatBool(c.TeamFormat))},
+		{"fee", fee},
+		{"teamLimit", teamLimit},
+		{"regulations", c.Regulations},
+		{"protestWindowMinutes", protestWindow},
+		{"eligibility", eligibilityValue(c.Eligibility)},
+		{"teamRules", teamRulesValue(c.TeamRules)},
+	}
+}
+
+// diffFields returns the fields whose values differ between two renderings of the same entity
+func diffFields(before, after []fieldValue) []entity.FieldChange {
+	var changes []entity.FieldChange
+	for i := range before {
+		if equalValues(before[i].value, after[i].value) {
+			continue
+		}
+		changes = append(changes, entity.FieldChange{
+			Field:    before[i].name,
+			OldValue: before[i].value,
+			NewValue: after[i].value,
+		})
+	}
+	return changes
+}
+
+// recordReportRevision stores the report before the update; updates that change nothing are not recorded
+func (u *UseCaseImpl) recordReportRevision(ctx context.Context, editorID string, before, after *entity.Report) error {
+	changes := diffFields(reportFields(before), reportFields(after))
+	if len(changes) == 0 {
+		return nil
+	}
+
+	snapshot := *before
+	snapshot.Photos = nil
+	return u.recordRevision(ctx, &entity.Revision{
+		EntityType: entity.RevisionEntityReport,
+		EntityID:   before.ID,
+		EditorID:   editorID,
+		Report:     &snapshot,
+		Changes:    changes,
+	})
+}
+
+// recordCompetitionRevision stores the competition before the update; updates that change nothing are not recorded
+func (u *UseCaseImpl) recordCompetitionRevision(ctx context.Context, editorID string, before, after *entity.Competition) error {
+	changes := diffFields(competitionFields(before), competitionFields(after))
+	if len(changes) == 0 {
+		return nil
+	}
+
+	return u.recordRevision(ctx, &entity.Revision{
+		EntityType:  entity.RevisionEntityCompetition,
+		EntityID:    before.ID,
+		EditorID:    editorID,
+		Competition: before,
+		Changes:     changes,
+	})
+}
+
+func (u *UseCaseImpl) recordRevision(ctx context.Context, revision *entity.Revision) error {
+	count, err := u.revisionRepo.CountByEntityID(ctx, revision.EntityID)
+	if err != nil {
+		return err
+	}
+	revision.Version = int(count) + 1
+	revision.CreatedAt = time.Now()
+
+	_, err = u.revisionRepo.Create(ctx, revision)
+	return err
+}
+
+// GetRevisions implements UseCase.GetRevisions
+// Admins see the history of any entity, authors see the history of their own reports
+func (u *UseCaseImpl) GetRevisions(ctx context.Context, userID string, entityID string) ([]*model.Revision, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	user, err := u.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("Пользователь не найден")
+	}
+	if !user.IsAdmin {
+		authorID, err := u.reportRepo.GetAuthorID(ctx, entityID)
+		if err != nil || authorID != user.ID {
+			return nil, fmt.Errorf("Доступ запрещен")
+		}
+	}
+
+	revisions, err := u.revisionRepo.FindByEntityID(ctx, entityID)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить историю изменений", err)
+	}
+
+	editors := make(map[string]*model.Author)
+	result := make([]*model.Revision, len(revisions))
+	for i, revision := range revisions {
+		editor, ok := editors[revision.EditorID]
+		if !ok {
+			user, err := u.userRepo.FindByID(ctx, revision.EditorID)
+			if err != nil {
+				user = deletedAuthor(revision.EditorID)
+			}
+			editor = entityToGraphQLAuthor(user)
+			editors[revision.EditorID] = editor
+		}
+		result[i] = entityToGraphQLRevision(revision, editor)
+	}
+	return result, nil
+}
+
+// RevertToRevision implements UseCase.RevertToRevision
+// The entity gets the field values stored in the revision; the revert itself is recorded as a new revision
+func (u *UseCaseImpl) RevertToRevision(ctx context.Context, userID string, revisionID string) (*model.RevertResult, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	user, err := u.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("Пользователь не найден")
+	}
+
+	revision, err := u.revisionRepo.FindByID(ctx, revisionID)
+	if err != nil {
+		return nil, fmt.Errorf("Версия не найдена")
+	}
+
+	switch {
+	case revision.Report != nil:
+		report, err := u.revertReport(ctx, user, revision)
+		if err != nil {
+			return nil, err
+		}
+		return &model.RevertResult{Report: report}, nil
+	case revision.Competition != nil:
+		if !user.IsAdmin {
+			return nil, fmt.Errorf("Доступ запрещен")
+		}
+		competition, err := u.revertCompetition(ctx, user, revision)
+		if err != nil {
+			return nil, err
+		}
+		return &model.RevertResult{Competition: competition}, nil
+	default:
+		return nil, fmt.Errorf("Версия не найдена")
+	}
+}
+
+// revertReport restores the title, text and competition link of a report; current photos are kept
+// A link to a competition that no longer exists is not restored
+func (u *UseCaseImpl) revertReport(ctx context.Context, user *entity.User, revision *entity.Revision) (*model.Report, error) {
+	current, err := u.reportRepo.FindByID(ctx, revision.EntityID)
+	if err != nil {
+		return nil, fmt.Errorf("Отчет не найден")
+	}
+	if !user.IsAdmin && user.ID != current.AuthorID {
+		return nil, fmt.Errorf("Доступ запрещен")
+	}
+
+	reverted := *current
+	reverted.Title = revision.Report.Title
+	reverted.Text = revision.Report.Text
+	reverted.CompetitionID, reverted.Tour = nil, nil
+	if revision.Report.CompetitionID != nil {
+		if _, err := u.competitionRepo.FindByID(ctx, *revision.Report.CompetitionID); err == nil {
+			reverted.CompetitionID, reverted.Tour = revision.Report.CompetitionID, revision.Report.Tour
+		}
+	}
+	reverted.Tags = revision.Report.Tags
+	reverted.Category = revision.Report.Category
+	reverted.UpdatedAt = time.Now()
+
+	err = u.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := u.recordReportRevision(ctx, user.ID, current, &reverted); err != nil {
+			return err
+		}
+		return u.reportRepo.Update(ctx, current.ID, &reverted)
+	})
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось восстановить версию отчета", err)
+	}
+
+	report, err := u.reportRepo.FindByID(ctx, current.ID)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось найти обновленный отчет", err)
+	}
+	return u.entityToGraphQLReport(ctx, report, user.ID)
+}
+
+// revertCompetition restores the fields of a competition; judges and registrations are not versioned
+func (u *UseCaseImpl) revertCompetition(ctx context.Context, user *entity.User, revision *entity.Revision) (*model.Competition, error) {
+	current, err := u.competitionRepo.FindByID(ctx, revision.EntityID)
+	if err != nil {
+		return nil, fmt.Errorf("Соревнование не найдено")
+	}
+
+	reverted := *revision.Competition
+	reverted.ID = current.ID
+	reverted.JudgeIDs = current.JudgeIDs
+	reverted.CreatedAt = current.CreatedAt
+	reverted.UpdatedAt = time.Now()
+	reverted.DeletedAt = nil
+	reverted.DeletedBy = nil
+
+	err = u.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := u.recordCompetitionRevision(ctx, user.ID, current, &reverted); err != nil {
+			return err
+		}
+		return u.competitionRepo.Update(ctx, current.ID, &reverted)
+	})
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось восстановить версию соревнования", err)
+	}
+
+	competition, err := u.competitionRepo.FindByID(ctx, current.ID)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось найти обновленное соревнование", err)
+	}
+	return u.entityToGraphQLCompetition(competition)
+}
+
+// Helper function to convert entity.Revision to model.Revision
+func entityToGraphQLRevision(revision *entity.Revision, editor *model.Author) *model.Revision {
+	changes := make([]*model.FieldChange, len(revision.Changes))
+	for i, c := range revision.Changes {
+		changes[i] = &model.FieldChange{
+			Field:    c.Field,
+			OldValue: c.OldValue,
+			NewValue: c.NewValue,
+		}
+	}
+
+	return &model.Revision{
+		ID:         revision.ID,
+		EntityType: string(revision.EntityType),
+		EntityID:   revision.EntityID,
+		Version:    revision.Version,
+		EditorID:   revision.EditorID,
+		Editor:     editor,
+		Changes:    changes,
+		CreatedAt:  scalars.Time(revision.CreatedAt),
+	}
+}
+
+func textValue(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func dateValue(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	return textValue(t.Format(revisionDateFormat))
+}
+
+func eligibilityValue(rules *entity.EligibilityRules) *string {
+	if rules == nil {
+		return nil
+	}
+
+	var parts []string
+	if rules.Category != nil {
+		parts = append(parts, "category="+*rules.Category)
+	}
+	if rules.MinAge != nil {
+		parts = append(parts, "minAge="+strconv.Itoa(*rules.MinAge))
+	}
+	if rules.MaxAge != nil {
+		parts = append(parts, "maxAge="+strconv.Itoa(*rules.MaxAge))
+	}
+	if rules.Gender != nil {
+		parts = append(parts, "gender="+string(*rules.Gender))
+	}
+	if rules.LicenseRequired {
+		parts = append(parts, "licenseRequired=true")
+	}
+	return textValue(strings.Join(parts, ", "))
+}
+
+func teamRulesValue(rules *entity.TeamRules) *string {
+	if rules == nil {
+		return nil
+	}
+	return textValue(fmt.Sprintf("minSize=%d, maxSize=%d, maxReserves=%d, maxCoaches=%d, captainRequired=%t",
+		rules.MinSize, rules.MaxSize, rules.MaxReserves, rules.MaxCoaches, rules.CaptainRequired))
+}
+
+func equalValues(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
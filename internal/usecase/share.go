@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cnpf/feeder-backend/internal/feed"
+	"github.com/cnpf/feeder-backend/internal/preview"
+	"github.com/cnpf/feeder-backend/internal/richtext"
+)
+
+// Descriptions longer than this are cut by most previews anyway
+const shareDescriptionLength = 200
+
+// SharePage is the social preview of a report or competition link
+type SharePage struct {
+	URL  string // Page of the SPA people are redirected to
+	HTML []byte // Page with Open Graph and Twitter Card metadata for crawlers
+}
+
+// GetReportSharePage implements UseCase.GetReportSharePage
+// Only published reports have previews
+func (u *UseCaseImpl) GetReportSharePage(ctx context.Context, id string) (*SharePage, error) {
+	report, err := u.reportRepo.FindByID(ctx, id)
+	if err != nil || !report.IsPublished() {
+		return nil, fmt.Errorf("Отчет не найден")
+	}
+
+	site := feed.DefaultSite()
+	page := preview.Page{
+		Type:        "article",
+		Title:       report.Title,
+		Description: richtext.Excerpt(report.Text, shareDescriptionLength),
+		URL:         site.ReportURL(report.ID),
+		Published:   report.PublishedAt,
+		Modified:    &report.UpdatedAt,
+	}
+	if len(report.Photos) > 0 {
+		photo := report.Photos[0]
+		page.Image = site.API(reportPhotoURL(report.ID, photo.ID))
+		page.ImageAlt = photo.Caption
+		if page.ImageAlt == "" {
+			page.ImageAlt = report.Title
+		}
+	}
+
+	return &SharePage{URL: page.URL, HTML: preview.Render(page)}, nil
+}
+
+// GetCompetitionSharePage implements UseCase.GetCompetitionSharePage
+func (u *UseCaseImpl) GetCompetitionSharePage(ctx context.Context, id string) (*SharePage, error) {
+	competition, err := u.competitionRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("Соревнование не найдено")
+	}
+
+	site := feed.DefaultSite()
+	page := preview.Page{
+		Type:        "website",
+		Title:       competition.Title,
+		Description: strings.Join(describeCompetition(competition), ". "),
+		URL:         site.CompetitionURL(competition.ID),
+	}
+
+	return &SharePage{URL: page.URL, HTML: preview.Render(page)}, nil
+}
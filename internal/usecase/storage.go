@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cnpf/feeder-backend/graph/model"
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	apperrors "github.com/cnpf/feeder-backend/internal/errors"
+)
+
+// Kinds of stored files with their own size limits
+const (
+	storageFilePhoto  = "photo"
+	storageFileAvatar = "avatar"
+)
+
+// storageAccount is the single enforcement point of upload limits: every upload path checks its files against
+// the limits of the uploader's role and charges them to the uploader's storage quota through it
+type storageAccount struct {
+	userID string // Empty when nobody is charged: venue photos, the avatar of a user being registered
+	limits entity.StorageLimits
+}
+
+// limitsFor returns the storage limits of a role; unknown roles get the limits of regular users
+func (u *UseCaseImpl) limitsFor(role string) entity.StorageLimits {
+	if limits, ok := u.storageLimits[role]; ok {
+		return limits
+	}
+	return u.storageLimits[entity.RoleUser]
+}
+
+// storageAccountOf returns the storage account of a user
+func (u *UseCaseImpl) storageAccountOf(ctx context.Context, userID string) (*storageAccount, error) {
+	user, err := u.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("Пользователь не найден")
+	}
+	return &storageAccount{userID: user.ID, limits: u.limitsFor(user.Role())}, nil
+}
+
+// checkFile validates the type and declared size of a file before it is read
+func (a *storageAccount) checkFile(kind string, contentType string, size int64) error {
+	if kind == storageFileAvatar {
+		if !strings.HasPrefix(contentType, "image/") {
+			return fmt.Errorf("Аватар должен быть изображением")
+		}
+		if size > a.limits.MaxAvatarSize {
+			return fmt.Errorf("Файл аватара слишком большой (максимум %s)", formatSize(a.limits.MaxAvatarSize))
+		}
+		return nil
+	}
+
+	if !strings.HasPrefix(contentType, "image/") {
+		return fmt.Errorf("Фотография должна быть изображением")
+	}
+	if size > a.limits.MaxPhotoSize {
+		return fmt.Errorf("Файл фотографии слишком большой (макс %s)", formatSize(a.limits.MaxPhotoSize))
+	}
+	return nil
+}
+
+// checkReportPhotos validates the number of photos a report would have
+func (a *storageAccount) checkReportPhotos(count int) error {
+	if count > a.limits.MaxReportPhotos {
+		return fmt.Errorf("Слишком много фотографий (макс %d)", a.limits.MaxReportPhotos)
+	}
+	return nil
+}
+
+// reserveStorage charges bytes to the quota of the account; nothing is charged if the quota would be exceeded
+func (u *UseCaseImpl) reserveStorage(ctx context.Context, account *storageAccount, bytes int64) error {
+	if account.userID == "" || bytes <= 0 {
+		return nil
+	}
+	reserved, err := u.userRepo.AddStorageUsed(ctx, account.userID, bytes, account.limits.Quota)
+	if err != nil {
+		return apperrors.WrapError("Не удалось проверить квоту хранилища", err)
+	}
+	if !reserved {
+		return fmt.Errorf("Недостаточно места в хранилище (квота %s); удалите ненужные фотографии или загрузки",
+			formatSize(account.limits.Quota))
+	}
+	return nil
+}
+
+// releaseStorage returns bytes to the quotas of the users they were charged to
+func (u *UseCaseImpl) releaseStorage(ctx context.Context, bytesByUser map[string]int64) error {
+	for userID, bytes := range bytesByUser {
+		if userID == "" || bytes <= 0 {
+			continue
+		}
+		if _, err := u.userRepo.AddStorageUsed(ctx, userID, -bytes, 0); err != nil {
+			return apperrors.WrapError("Не удалось обновить занятое место в хранилище", err)
+		}
+	}
+	return nil
+}
+
+// photoStorage sums the sizes of report photos by the users they are charged to
+func photoStorage(photos []entity.ReportPhoto) map[string]int64 {
+	bytesByUser := make(map[string]int64)
+	for _, photo := range photos {
+		bytesByUser[photo.UploadedBy] += photo.Size
+	}
+	return bytesByUser
+}
+
+// uploadStorage sums the declared lengths of uploads by their users
+func uploadStorage(uploads []*entity.Upload) map[string]int64 {
+	bytesByUser := make(map[string]int64)
+	for _, upload := range uploads {
+		bytesByUser[upload.UserID] += upload.Length
+	}
+	return bytesByUser
+}
+
+// GetStorageUsage implements UseCase.GetStorageUsage
+// Without a user returns the limits of regular users
+func (u *UseCaseImpl) GetStorageUsage(ctx context.Context, userID string) (*model.StorageUsage, error) {
+	role := entity.RoleUser
+	var used int64
+	if userID != "" {
+		user, err := u.userRepo.FindByID(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("Пользователь не найден")
+		}
+		role = user.Role()
+		used = user.StorageUsed
+	}
+
+	limits := u.limitsFor(role)
+	return &model.StorageUsage{
+		Role:            role,
+		Used:            int(used),
+		Quota:           int(limits.Quota),
+		MaxReportPhotos: limits.MaxReportPhotos,
+		MaxPhotoSize:    int(limits.MaxPhotoSize),
+		MaxAvatarSize:   int(limits.MaxAvatarSize),
+	}, nil
+}
+
+// formatSize formats a size limit for messages: whole megabytes or kilobytes
+func formatSize(bytes int64) string {
+	const kb, mb = 1024, 1024 * 1024
+	if bytes >= mb && bytes%mb == 0 {
+		return fmt.Sprintf("%dМБ", bytes/mb)
+	}
+	return fmt.Sprintf("%dКБ", bytes/kb)
+}
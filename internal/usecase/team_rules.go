@@ -0,0 +1,149 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cnpf/feeder-backend/graph/model"
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+)
+
+const (
+	maxTeamSize     = 20
+	maxTeamReserves = 10
+	maxTeamCoaches  = 5
+)
+
+// validateRegistrationComposition checks participants, reserves, captain and coaches
+// against the registration type and the competition team rules
+func validateRegistrationComposition(competition *entity.Competition, regType entity.RegistrationType, teamName *string, participants []entity.Participant, coaches []entity.Coach) error {
+	main, reserves, captains := 0, 0, 0
+	for _, p := range participants {
+		if p.Reserve {
+			reserves++
+		} else {
+			main++
+		}
+		if p.Captain {
+			captains++
+		}
+	}
+
+	if regType == entity.RegistrationTypeIndividual {
+		if len(participants) != 1 {
+			return fmt.Errorf("Для индивидуальной регистрации нужен один участник")
+		}
+		if reserves > 0 || captains > 0 {
+			return fmt.Errorf("Запасные и капитан указываются только в командной регистрации")
+		}
+		if teamName != nil {
+			return fmt.Errorf("Название команды не требуется для индивидуальной регистрации")
+		}
+		if len(coaches) > 0 {
+			return fmt.Errorf("Тренер не требуется для индивидуальной регистрации")
+		}
+		return nil
+	}
+
+	rules := competition.TeamRulesOrDefault()
+	if main < rules.MinSize || main > rules.MaxSize {
+		if rules.MinSize == rules.MaxSize {
+			return fmt.Errorf("Для командной регистрации нужно участников (без запасных): %d", rules.MinSize)
+		}
+		return fmt.Errorf("Для командной регистрации нужно от %d до %d участников (без запасных)", rules.MinSize, rules.MaxSize)
+	}
+	if reserves > rules.MaxReserves {
+		if rules.MaxReserves == 0 {
+			return fmt.Errorf("Запасные участники не предусмотрены этим соревнованием")
+		}
+		return fmt.Errorf("Слишком много запасных участников (макс %d)", rules.MaxReserves)
+	}
+	if captains > 1 {
+		return fmt.Errorf("Капитан команды может быть только один")
+	}
+	if rules.CaptainRequired && captains == 0 {
+		return fmt.Errorf("Укажите капитана команды")
+	}
+	if len(coaches) > rules.MaxCoaches {
+		if rules.MaxCoaches == 0 {
+			return fmt.Errorf("Тренер не предусмотрен этим соревнованием")
+		}
+		return fmt.Errorf("Слишком много тренеров (макс %d)", rules.MaxCoaches)
+	}
+	if teamName == nil || strings.TrimSpace(*teamName) == "" {
+		return fmt.Errorf("Название команды обязательно")
+	}
+	return nil
+}
+
+// coachesFromInput validates coach input and converts it to domain entities
+func coachesFromInput(coaches []CoachInput) ([]entity.Coach, error) {
+	entityCoaches := make([]entity.Coach, len(coaches))
+	for i, c := range coaches {
+		if strings.TrimSpace(c.FirstName) == "" || strings.TrimSpace(c.LastName) == "" {
+			return nil, fmt.Errorf("Имя и фамилия тренера обязательны")
+		}
+		entityCoaches[i] = entity.Coach{
+			FirstName: strings.TrimSpace(c.FirstName),
+			LastName:  strings.TrimSpace(c.LastName),
+		}
+	}
+	return entityCoaches, nil
+}
+
+// teamRulesFromInput validates competition team rules input; nil input keeps the default rules
+func teamRulesFromInput(input *model.TeamRulesInput) (*entity.TeamRules, error) {
+	if input == nil {
+		return nil, nil
+	}
+
+	rules := &entity.TeamRules{
+		MinSize:         input.MinSize,
+		MaxSize:         input.MaxSize,
+		CaptainRequired: input.CaptainRequired != nil && *input.CaptainRequired,
+	}
+	if input.MaxReserves != nil {
+		rules.MaxReserves = *input.MaxReserves
+	}
+	if input.MaxCoaches != nil {
+		rules.MaxCoaches = *input.MaxCoaches
+	}
+
+	if rules.MinSize < 2 || rules.MaxSize > maxTeamSize || rules.MinSize > rules.MaxSize {
+		return nil, fmt.Errorf("Размер команды должен быть от 2 до %d участников, минимум не больше максимума", maxTeamSize)
+	}
+	if rules.MaxReserves < 0 || rules.MaxReserves > maxTeamReserves {
+		return nil, fmt.Errorf("Количество запасных должно быть от 0 до %d", maxTeamReserves)
+	}
+	if rules.MaxCoaches < 0 || rules.MaxCoaches > maxTeamCoaches {
+		return nil, fmt.Errorf("Количество тренеров должно быть от 0 до %d", maxTeamCoaches)
+	}
+
+	return rules, nil
+}
+
+// teamRulesToInput converts rules back to input (used to copy competitions and templates)
+func teamRulesToInput(rules *entity.TeamRules) *model.TeamRulesInput {
+	if rules == nil {
+		return nil
+	}
+	maxReserves, maxCoaches, captainRequired := rules.MaxReserves, rules.MaxCoaches, rules.CaptainRequired
+	return &model.TeamRulesInput{
+		MinSize:         rules.MinSize,
+		MaxSize:         rules.MaxSize,
+		MaxReserves:     &maxReserves,
+		MaxCoaches:      &maxCoaches,
+		CaptainRequired: &captainRequired,
+	}
+}
+
+// Helper function to convert entity.TeamRules to model.TeamRules
+func entityToGraphQLTeamRules(rules entity.TeamRules) *model.TeamRules {
+	return &model.TeamRules{
+		MinSize:         rules.MinSize,
+		MaxSize:         rules.MaxSize,
+		MaxReserves:     rules.MaxReserves,
+		MaxCoaches:      rules.MaxCoaches,
+		CaptainRequired: rules.CaptainRequired,
+	}
+}
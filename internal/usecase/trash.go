@@ -0,0 +1,240 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/cnpf/feeder-backend/graph/model"
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	apperrors "github.com/cnpf/feeder-backend/internal/errors"
+)
+
+// GetTrash implements UseCase.GetTrash
+func (u *UseCaseImpl) GetTrash(ctx context.Context, currentUserID string) (*model.Trash, error) {
+	competitions, err := u.GetDeletedCompetitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reports, err := u.reportRepo.FindDeleted(ctx)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить удаленные отчеты", err)
+	}
+	registrations, err := u.registrationRepo.FindDeleted(ctx)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить удаленные регистрации", err)
+	}
+
+	trash := &model.Trash{
+		RetentionDays: int(u.trashRetention / (24 * time.Hour)),
+		Competitions:  competitions,
+		Reports:       u.reportsToGraphQL(ctx, reports, currentUserID),
+		Registrations: make([]*model.Registration, 0, len(registrations)),
+	}
+	for _, reg := range registrations {
+		trash.Registrations = append(trash.Registrations, u.entityToGraphQLRegistration(reg, currentUserID))
+	}
+
+	return trash, nil
+}
+
+// GetDeletedCompetitions implements UseCase.GetDeletedCompetitions
+func (u *UseCaseImpl) GetDeletedCompetitions(ctx context.Context) ([]*model.Competition, error) {
+	competitions, err := u.competitionRepo.FindDeleted(ctx)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить удаленные соревнования", err)
+	}
+
+	result := make([]*model.Competition, 0, len(competitions))
+	for _, competition := range competitions {
+		graphQLCompetition, err := u.entityToGraphQLCompetition(competition)
+		if err != nil {
+			continue
+		}
+		result = append(result, graphQLCompetition)
+	}
+	return result, nil
+}
+
+// RestoreCompetition implements UseCase.RestoreCompetition
+func (u *UseCaseImpl) RestoreCompetition(ctx context.Context, id string) (*model.Competition, error) {
+	if err := u.competitionRepo.Restore(ctx, id); err != nil {
+		return nil, apperrors.WrapError("Не удалось восстановить соревнование", err)
+	}
+
+	competition, err := u.competitionRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось найти восстановленное соревнование", err)
+	}
+	return u.entityToGraphQLCompetition(competition)
+}
+
+// RestoreReport implements UseCase.RestoreReport
+func (u *UseCaseImpl) RestoreReport(ctx context.Context, currentUserID string, id string) (*model.Report, error) {
+	if err := u.reportRepo.Restore(ctx, id); err != nil {
+		return nil, apperrors.WrapError("Не удалось восстановить отчет", err)
+	}
+
+	report, err := u.reportRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось найти восстановленный отчет", err)
+	}
+	return u.entityToGraphQLReport(ctx, report, currentUserID)
+}
+
+// RestoreRegistration implements UseCase.RestoreRegistration
+// The competition must not be in the trash, and a participant who registered again cannot get a second registration
+func (u *UseCaseImpl) RestoreRegistration(ctx context.Context, currentUserID string, id string) (*model.Registration, error) {
+	trashed, err := u.registrationRepo.FindDeleted(ctx)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить корзину", err)
+	}
+	var reg *entity.Registration
+	for _, r := range trashed {
+		if r.ID == id {
+			reg = r
+			break
+		}
+	}
+	if reg == nil {
+		return nil, fmt.Errorf("Регистрация не найдена в корзине")
+	}
+
+	if _, err := u.competitionRepo.FindByID(ctx, reg.CompetitionID); err != nil {
+		return nil, fmt.Errorf("Соревнование этой регистрации удалено: сначала восстановите соревнование")
+	}
+	if owner, err := u.userRepo.FindByID(ctx, reg.UserID); err == nil && !owner.IsAdmin {
+		existing, err := u.registrationRepo.FindByCompetitionAndUser(ctx, reg.CompetitionID, reg.UserID)
+		if err == nil && existing != nil {
+			return nil, fmt.Errorf("Пользователь уже зарегистрирован на это соревнование заново")
+		}
+	}
+
+	if err := u.registrationRepo.Restore(ctx, id); err != nil {
+		return nil, apperrors.WrapError("Не удалось восстановить регистрацию", err)
+	}
+
+	restored, err := u.registrationRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось найти восстановленную регистрацию", err)
+	}
+	return u.entityToGraphQLRegistration(restored, currentUserID), nil
+}
+
+// PurgeTrash implements UseCase.PurgeTrash
+// Items that stayed in the trash longer than the retention period are deleted permanently, each one in a
+// transaction: competitions with their registrations, results, penalties, protests and check-ins,
+// registrations with their results, penalties and check-ins, reports with their photos
+func (u *UseCaseImpl) PurgeTrash(ctx context.Context) (int, error) {
+	cutoff := time.Now().Add(-u.trashRetention)
+	expired := func(deletedAt *time.Time) bool {
+		return deletedAt != nil && deletedAt.Before(cutoff)
+	}
+
+	competitions, err := u.competitionRepo.FindDeleted(ctx)
+	if err != nil {
+		return 0, apperrors.WrapError("Не удалось получить удаленные соревнования", err)
+	}
+	registrations, err := u.registrationRepo.FindDeleted(ctx)
+	if err != nil {
+		return 0, apperrors.WrapError("Не удалось получить удаленные регистрации", err)
+	}
+	reports, err := u.reportRepo.FindDeleted(ctx)
+	if err != nil {
+		return 0, apperrors.WrapError("Не удалось получить удаленные отчеты", err)
+	}
+
+	purged := 0
+	purge := func(kind, id string, fn func(ctx context.Context) error) bool {
+		if err := u.txManager.WithTransaction(ctx, fn); err != nil {
+			log.Printf("Failed to purge %s %s: %v", kind, id, err)
+			return false
+		}
+		purged++
+		return true
+	}
+
+	// Registrations of a purged competition are gone with it
+	purgedCompetitions := make(map[string]bool)
+	for _, competition := range competitions {
+		if expired(competition.DeletedAt) {
+			purgedCompetitions[competition.ID] = purge("competition", competition.ID, func(ctx context.Context) error {
+				return u.purgeCompetition(ctx, competition.ID)
+			})
+		}
+	}
+	for _, reg := range registrations {
+		if expired(reg.DeletedAt) && !purgedCompetitions[reg.CompetitionID] {
+			purge("registration", reg.ID, func(ctx context.Context) error {
+				return u.purgeRegistration(ctx, reg.ID)
+			})
+		}
+	}
+	for _, report := range reports {
+		if expired(report.DeletedAt) {
+			purge("report", report.ID, func(ctx context.Context) error {
+				if err := u.revisionRepo.DeleteByEntityID(ctx, report.ID); err != nil {
+					return err
+				}
+				if err := u.commentRepo.DeleteByReportID(ctx, report.ID); err != nil {
+					return err
+				}
+				if err := u.reactionRepo.DeleteByReportID(ctx, report.ID); err != nil {
+					return err
+				}
+				if err := u.reportViewRepo.DeleteByReportID(ctx, report.ID); err != nil {
+					return err
+				}
+				if err := u.releaseStorage(ctx, photoStorage(report.Photos)); err != nil {
+					return err
+				}
+				return u.reportRepo.Delete(ctx, report.ID)
+			})
+		}
+	}
+
+	return purged, nil
+}
+
+func (u *UseCaseImpl) purgeCompetition(ctx context.Context, id string) error {
+	if err := u.registrationRepo.DeleteByCompetitionID(ctx, id); err != nil {
+		return err
+	}
+	if err := u.resultRepo.DeleteByCompetitionID(ctx, id); err != nil {
+		return err
+	}
+	if err := u.penaltyRepo.DeleteByCompetitionID(ctx, id); err != nil {
+		return err
+	}
+	if err := u.protestRepo.DeleteByCompetitionID(ctx, id); err != nil {
+		return err
+	}
+	if err := u.checkInRepo.DeleteByCompetitionID(ctx, id); err != nil {
+		return err
+	}
+	if err := u.revisionRepo.DeleteByEntityID(ctx, id); err != nil {
+		return err
+	}
+	if err := u.reportRepo.UnlinkCompetition(ctx, id); err != nil {
+		return err
+	}
+	return u.competitionRepo.Delete(ctx, id)
+}
+
+func (u *UseCaseImpl) purgeRegistration(ctx context.Context, id string) error {
+	if err := u.resultRepo.DeleteByRegistrationID(ctx, id); err != nil {
+		return err
+	}
+	if err := u.penaltyRepo.DeleteByRegistrationID(ctx, id); err != nil {
+		return err
+	}
+	if err := u.checkInRepo.DeleteByRegistrationID(ctx, id); err != nil {
+		return err
+	}
+	if err := u.protestRepo.DeleteByRegistrationID(ctx, id); err != nil {
+		return err
+	}
+	return u.registrationRepo.Delete(ctx, id)
+}
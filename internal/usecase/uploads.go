@@ -0,0 +1,252 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	apperrors "github.com/cnpf/feeder-backend/internal/errors"
+)
+
+const (
+	uploadExpiry   = 24 * time.Hour // Abandoned uploads are deleted after a day without new chunks
+	maxUserUploads = 30             // Unattached uploads per user, a batch of three reports
+)
+
+// UploadStatus is the state of a resumable upload reported to the client
+type UploadStatus struct {
+	ID        string
+	Offset    int64
+	Length    int64
+	ExpiresAt time.Time
+}
+
+func uploadStatus(upload *entity.Upload) *UploadStatus {
+	return &UploadStatus{
+		ID:        upload.ID,
+		Offset:    upload.Offset,
+		Length:    upload.Length,
+		ExpiresAt: upload.ExpiresAt,
+	}
+}
+
+// CreateUpload implements UseCase.CreateUpload
+// Only photos are uploaded this way: the type must be an image and the size must fit a report photo.
+// The declared length is charged to the storage quota until the upload is attached, deleted or expires
+func (u *UseCaseImpl) CreateUpload(ctx context.Context, userID string, length int64, contentType, fileName string) (*UploadStatus, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	if length <= 0 {
+		return nil, fmt.Errorf("Размер файла должен быть больше нуля")
+	}
+	account, err := u.storageAccountOf(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := account.checkFile(storageFilePhoto, contentType, length); err != nil {
+		return nil, err
+	}
+
+	count, err := u.uploadRepo.CountByUserID(ctx, userID)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось проверить загрузки", err)
+	}
+	if count >= maxUserUploads {
+		return nil, fmt.Errorf("Слишком много незавершенных загрузок (макс %d); прикрепите загруженные фотографии к отчету или удалите их",
+			maxUserUploads)
+	}
+
+	upload := &entity.Upload{
+		UserID:      userID,
+		ContentType: contentType,
+		FileName:    fileName,
+		Length:      length,
+		ExpiresAt:   time.Now().Add(uploadExpiry),
+	}
+	// The reservation is kept only if the upload is created
+	err = u.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := u.reserveStorage(ctx, account, length); err != nil {
+			return err
+		}
+		upload.ID, err = u.uploadRepo.Create(ctx, upload)
+		if err != nil {
+			return apperrors.WrapError("Не удалось создать загрузку", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return uploadStatus(upload), nil
+}
+
+// GetUpload implements UseCase.GetUpload
+func (u *UseCaseImpl) GetUpload(ctx context.Context, userID string, id string) (*UploadStatus, error) {
+	upload, err := u.findUpload(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	return uploadStatus(upload), nil
+}
+
+// AppendUpload implements UseCase.AppendUpload
+// The chunk must start where the received bytes end; the completed file is checked to be an image
+func (u *UseCaseImpl) AppendUpload(ctx context.Context, userID string, id string, offset int64, chunk io.Reader) (*UploadStatus, error) {
+	upload, err := u.findUpload(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if offset != upload.Offset {
+		return nil, fmt.Errorf("Смещение не совпадает с полученным объемом (%d)", upload.Offset)
+	}
+
+	remaining := upload.Length - upload.Offset
+	data, err := io.ReadAll(io.LimitReader(chunk, remaining+1))
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось прочитать данные", err)
+	}
+	if int64(len(data)) > remaining {
+		return nil, fmt.Errorf("Данных больше, чем заявленный размер файла")
+	}
+	if len(data) == 0 {
+		return uploadStatus(upload), nil
+	}
+
+	expiresAt := time.Now().Add(uploadExpiry)
+	appended, err := u.uploadRepo.AppendChunk(ctx, id, offset, data, expiresAt)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось сохранить данные", err)
+	}
+	if !appended {
+		return nil, fmt.Errorf("Смещение не совпадает с полученным объемом")
+	}
+	upload.Offset += int64(len(data))
+	upload.ExpiresAt = expiresAt
+
+	if upload.IsComplete() {
+		if _, err := u.readUpload(ctx, upload); err != nil {
+			_ = u.deleteUploads(ctx, []*entity.Upload{upload})
+			return nil, err
+		}
+	}
+	return uploadStatus(upload), nil
+}
+
+// DeleteUpload implements UseCase.DeleteUpload
+func (u *UseCaseImpl) DeleteUpload(ctx context.Context, userID string, id string) error {
+	upload, err := u.findUpload(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+	if err := u.deleteUploads(ctx, []*entity.Upload{upload}); err != nil {
+		return apperrors.WrapError("Не удалось удалить загрузку", err)
+	}
+	return nil
+}
+
+// PurgeExpiredUploads implements UseCase.PurgeExpiredUploads
+func (u *UseCaseImpl) PurgeExpiredUploads(ctx context.Context) (int, error) {
+	purged, err := u.uploadRepo.DeleteExpired(ctx, time.Now())
+	if err != nil {
+		return 0, apperrors.WrapError("Не удалось удалить просроченные загрузки", err)
+	}
+	if err := u.releaseStorage(ctx, uploadStorage(purged)); err != nil {
+		return 0, err
+	}
+	return len(purged), nil
+}
+
+// deleteUploads deletes uploads and releases their storage
+func (u *UseCaseImpl) deleteUploads(ctx context.Context, uploads []*entity.Upload) error {
+	if len(uploads) == 0 {
+		return nil
+	}
+	ids := make([]string, len(uploads))
+	for i, upload := range uploads {
+		ids[i] = upload.ID
+	}
+	if err := u.uploadRepo.Delete(ctx, ids); err != nil {
+		return err
+	}
+	return u.releaseStorage(ctx, uploadStorage(uploads))
+}
+
+// findUpload finds an upload of the user that has not expired
+func (u *UseCaseImpl) findUpload(ctx context.Context, userID string, id string) (*entity.Upload, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("Не авторизован")
+	}
+	upload, err := u.uploadRepo.FindByID(ctx, id)
+	if err != nil || upload.UserID != userID || upload.ExpiresAt.Before(time.Now()) {
+		return nil, fmt.Errorf("Загрузка не найдена")
+	}
+	return upload, nil
+}
+
+// readUpload reads a completed upload and checks that the content is an image, whatever type the client declared
+func (u *UseCaseImpl) readUpload(ctx context.Context, upload *entity.Upload) ([]byte, error) {
+	data, err := u.uploadRepo.ReadData(ctx, upload.ID)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось прочитать загрузку", err)
+	}
+	if int64(len(data)) != upload.Length {
+		return nil, fmt.Errorf("Загрузка не завершена")
+	}
+	if !strings.HasPrefix(http.DetectContentType(data), "image/") {
+		return nil, fmt.Errorf("Фотография должна быть изображением")
+	}
+	return data, nil
+}
+
+// attachUploadedPhotos adds completed uploads of the user to the photo uploads of a report input;
+// returns the uploads to delete (releasing their storage) before the photos are charged when the report is saved
+func (u *UseCaseImpl) attachUploadedPhotos(ctx context.Context, userID string, photos *ReportPhotosInput) ([]*entity.Upload, error) {
+	if photos == nil || len(photos.Uploaded) == 0 {
+		return nil, nil
+	}
+	if len(photos.Details) > len(photos.Uploads) {
+		return nil, fmt.Errorf("Подписей больше, чем фотографий")
+	}
+
+	// Details of direct uploads are matched by index, so they are padded before the uploaded photos follow
+	details := make([]PhotoDetails, len(photos.Uploads), len(photos.Uploads)+len(photos.Uploaded))
+	copy(details, photos.Details)
+
+	uploads := make([]*entity.Upload, 0, len(photos.Uploaded))
+	attached := make(map[string]bool)
+	for _, uploaded := range photos.Uploaded {
+		if attached[uploaded.UploadID] {
+			continue
+		}
+		attached[uploaded.UploadID] = true
+
+		upload, err := u.findUpload(ctx, userID, uploaded.UploadID)
+		if err != nil {
+			return nil, err
+		}
+		if !upload.IsComplete() {
+			return nil, fmt.Errorf("Загрузка не завершена")
+		}
+		data, err := u.readUpload(ctx, upload)
+		if err != nil {
+			return nil, err
+		}
+
+		photos.Uploads = append(photos.Uploads, &PhotoUpload{
+			File:        bytes.NewReader(data),
+			Size:        int64(len(data)),
+			ContentType: upload.ContentType,
+		})
+		details = append(details, uploaded.PhotoDetails)
+		uploads = append(uploads, upload)
+	}
+	photos.Details = details
+	photos.Uploaded = nil
+	return uploads, nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/cnpf/feeder-backend/internal/auth"
 	"github.com/cnpf/feeder-backend/internal/domain/entity"
 	apperrors "github.com/cnpf/feeder-backend/internal/errors"
+	"github.com/cnpf/feeder-backend/internal/notify"
 	"github.com/cnpf/feeder-backend/internal/repository/interface"
 	"github.com/cnpf/feeder-backend/internal/validation"
 )
@@ -24,6 +25,22 @@ type UseCaseImpl struct {
 	reportRepo       repository.ReportRepository
 	competitionRepo  repository.CompetitionRepository
 	registrationRepo repository.RegistrationRepository
+	venueRepo        repository.VenueRepository
+	resultRepo       repository.ResultRepository
+	penaltyRepo      repository.PenaltyRepository
+	checkInRepo      repository.CheckInRepository
+	protestRepo      repository.ProtestRepository
+	notificationRepo repository.NotificationRepository
+	templateRepo     repository.CompetitionTemplateRepository
+	revisionRepo     repository.RevisionRepository
+	commentRepo      repository.CommentRepository
+	reactionRepo     repository.ReactionRepository
+	reportViewRepo   repository.ReportViewRepository
+	uploadRepo       repository.UploadRepository
+	txManager        repository.TxManager
+	mailer           notify.Mailer
+	trashRetention   time.Duration
+	storageLimits    map[string]entity.StorageLimits // Upload limits by role
 }
 
 // NewUseCase creates a new use case implementation
@@ -32,12 +49,44 @@ func NewUseCase(
 	reportRepo repository.ReportRepository,
 	competitionRepo repository.CompetitionRepository,
 	registrationRepo repository.RegistrationRepository,
+	venueRepo repository.VenueRepository,
+	resultRepo repository.ResultRepository,
+	penaltyRepo repository.PenaltyRepository,
+	checkInRepo repository.CheckInRepository,
+	protestRepo repository.ProtestRepository,
+	notificationRepo repository.NotificationRepository,
+	templateRepo repository.CompetitionTemplateRepository,
+	revisionRepo repository.RevisionRepository,
+	commentRepo repository.CommentRepository,
+	reactionRepo repository.ReactionRepository,
+	reportViewRepo repository.ReportViewRepository,
+	uploadRepo repository.UploadRepository,
+	txManager repository.TxManager,
+	mailer notify.Mailer,
+	trashRetention time.Duration,
+	storageLimits map[string]entity.StorageLimits,
 ) UseCase {
 	return &UseCaseImpl{
 		userRepo:         userRepo,
 		reportRepo:       reportRepo,
 		competitionRepo:  competitionRepo,
 		registrationRepo: registrationRepo,
+		venueRepo:        venueRepo,
+		resultRepo:       resultRepo,
+		penaltyRepo:      penaltyRepo,
+		checkInRepo:      checkInRepo,
+		protestRepo:      protestRepo,
+		notificationRepo: notificationRepo,
+		templateRepo:     templateRepo,
+		revisionRepo:     revisionRepo,
+		commentRepo:      commentRepo,
+		reactionRepo:     reactionRepo,
+		reportViewRepo:   reportViewRepo,
+		uploadRepo:       uploadRepo,
+		txManager:        txManager,
+		mailer:           mailer,
+		trashRetention:   trashRetention,
+		storageLimits:    storageLimits,
 	}
 }
 
@@ -69,17 +118,14 @@ func (u *UseCaseImpl) Register(ctx context.Context, email, username, password, p
 
 	// Handle avatar upload
 	var avatarData map[string]interface{}
+	var storageUsed int64
 	hasAvatar := false
 	if avatar != nil {
-		// Validate file type
-		if !strings.HasPrefix(avatar.ContentType, "image/") {
-			return nil, fmt.Errorf("Аватар должен быть изображением")
-		}
-
-		// Validate file size (max 512KB)
-		const maxAvatarSize = 512 * 1024
-		if avatar.Size > maxAvatarSize {
-			return nil, fmt.Errorf("Файл аватара слишком большой (максимум 512KB)")
+		// Validate file type and size by the limits of the role the user gets;
+		// the avatar is the initial storage usage of the user
+		account := &storageAccount{limits: u.limitsFor((&entity.User{IsAdmin: isAdmin}).Role())}
+		if err := account.checkFile(storageFileAvatar, avatar.ContentType, avatar.Size); err != nil {
+			return nil, err
 		}
 
 		// Read file data
@@ -87,6 +133,10 @@ func (u *UseCaseImpl) Register(ctx context.Context, email, username, password, p
 		if err != nil {
 			return nil, apperrors.WrapError("Не удалось прочитать файл аватара", err)
 		}
+		if err := account.checkFile(storageFileAvatar, avatar.ContentType, int64(len(data))); err != nil {
+			return nil, err
+		}
+		storageUsed = int64(len(data))
 
 		avatarData = map[string]interface{}{
 			"contentType": avatar.ContentType,
@@ -103,6 +153,7 @@ func (u *UseCaseImpl) Register(ctx context.Context, email, username, password, p
 		IsAdmin:      isAdmin,
 		HasAvatar:    hasAvatar,
 		Avatar:       avatarData,
+		StorageUsed:  storageUsed,
 		CreatedAt:    time.Now(),
 	}
 
@@ -198,22 +249,20 @@ func (u *UseCaseImpl) UpdateProfile(ctx context.Context, userID string, username
 		update = true
 	}
 
+	// The avatar is counted against the storage quota: the difference to the previous one is charged or released
+	previousAvatarSize := user.AvatarSize
+	newAvatarSize := previousAvatarSize
 	if removeAvatar != nil && *removeAvatar {
 		user.HasAvatar = false
 		user.Avatar = nil
+		newAvatarSize = 0
 		update = true
 	}
 
+	account := &storageAccount{userID: user.ID, limits: u.limitsFor(user.Role())}
 	if avatar != nil {
-		// Validate file type
-		if !strings.HasPrefix(avatarContentType, "image/") {
-			return nil, fmt.Errorf("Аватар должен быть изображением")
-		}
-
-		// Validate file size (max 512KB)
-		const maxAvatarSize = 512 * 1024
-		if avatarSize > maxAvatarSize {
-			return nil, fmt.Errorf("Файл аватара слишком большой (максимум 512KB)")
+		if err := account.checkFile(storageFileAvatar, avatarContentType, avatarSize); err != nil {
+			return nil, err
 		}
 
 		// Read file data
@@ -221,6 +270,10 @@ func (u *UseCaseImpl) UpdateProfile(ctx context.Context, userID string, username
 		if err != nil {
 			return nil, apperrors.WrapError("Не удалось прочитать файл аватара", err)
 		}
+		if err := account.checkFile(storageFileAvatar, avatarContentType, int64(len(data))); err != nil {
+			return nil, err
+		}
+		newAvatarSize = int64(len(data))
 
 		user.Avatar = map[string]interface{}{
 			"contentType": avatarContentType,
@@ -234,10 +287,18 @@ func (u *UseCaseImpl) UpdateProfile(ctx context.Context, userID string, username
 		return nil, fmt.Errorf("Нет полей для обновления")
 	}
 
-	// Update user
-	err = u.userRepo.Update(ctx, userID, user)
+	// Update user together with the storage usage, so that a failed update leaves the quota as it was
+	err = u.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := u.reserveStorage(ctx, account, newAvatarSize-previousAvatarSize); err != nil {
+			return err
+		}
+		if err := u.userRepo.Update(ctx, userID, user); err != nil {
+			return apperrors.WrapError("Не удалось обновить пользователя", err)
+		}
+		return u.releaseStorage(ctx, map[string]int64{userID: previousAvatarSize - newAvatarSize})
+	})
 	if err != nil {
-		return nil, apperrors.WrapError("Не удалось обновить пользователя", err)
+		return nil, err
 	}
 
 	// Get updated user
@@ -309,40 +370,94 @@ func entityToGraphQLUser(e *entity.User) *model.User {
 	}
 }
 
-// Helper function to convert entity.Report to model.Report
-func (u *UseCaseImpl) entityToGraphQLReport(ctx context.Context, report *entity.Report, currentUserID string) (*model.Report, error) {
-	if report == nil {
-		return nil, nil
+// Helper function to convert entity.User to model.Author
+func entityToGraphQLAuthor(author *entity.User) *model.Author {
+	username := author.Username
+	if username == "" {
+		username = author.Email
+	}
+	var avatarURL *string
+	if author.HasAvatar {
+		url := fmt.Sprintf("/api/user/avatar/%s", author.ID)
+		avatarURL = &url
 	}
 
-	// Get author
-	author, err := u.userRepo.FindByID(ctx, report.AuthorID)
-	if err != nil {
-		// If author not found, create a default author
-		author = &entity.User{
-			ID:        report.AuthorID,
-			Username:  "unknown",
-			HasAvatar: false,
+	return &model.Author{
+		ID:        author.ID,
+		Username:  username,
+		HasAvatar: author.HasAvatar,
+		AvatarURL: avatarURL,
+	}
+}
+
+// reportExtras are the data shown with reports that are stored outside them (authors, counters, reactions),
+// loaded for a whole list of reports at once so that a list costs the same few queries as one report
+type reportExtras struct {
+	viewer    *entity.User // nil for guests
+	authors   map[string]*entity.User
+	comments  map[string]int64
+	views     map[string]int64
+	reactions map[string]map[entity.ReactionType]int64
+	reacted   map[string][]entity.ReactionType
+}
+
+// loadReportExtras loads the extras of reports; data that fails to load is shown as missing (zero counts)
+func (u *UseCaseImpl) loadReportExtras(ctx context.Context, reports []*entity.Report, currentUserID string) *reportExtras {
+	extras := &reportExtras{authors: make(map[string]*entity.User)}
+	if len(reports) == 0 {
+		return extras
+	}
+
+	reportIDs := make([]string, len(reports))
+	authorIDs := make([]string, 0, len(reports))
+	seenAuthors := make(map[string]bool)
+	for i, report := range reports {
+		reportIDs[i] = report.ID
+		if !seenAuthors[report.AuthorID] {
+			seenAuthors[report.AuthorID] = true
+			authorIDs = append(authorIDs, report.AuthorID)
 		}
 	}
 
-	// Format author
-	authorUsername := author.Username
-	if authorUsername == "" {
-		authorUsername = author.Email
+	if currentUserID != "" {
+		if viewer, err := u.userRepo.FindByID(ctx, currentUserID); err == nil {
+			extras.viewer = viewer
+		}
+		extras.reacted, _ = u.reactionRepo.FindUserTypesByReportIDs(ctx, reportIDs, currentUserID)
 	}
-	var authorAvatarURL *string
-	if author.HasAvatar {
-		url := fmt.Sprintf("/api/user/avatar/%s", author.ID)
-		authorAvatarURL = &url
+	if authors, err := u.userRepo.FindByIDs(ctx, authorIDs); err == nil {
+		for _, author := range authors {
+			extras.authors[author.ID] = author
+		}
+	}
+
+	// Hidden and deleted comments are not counted
+	extras.comments, _ = u.commentRepo.CountVisibleByReportIDs(ctx, reportIDs)
+	extras.views, _ = u.reportViewRepo.CountByReportIDs(ctx, reportIDs)
+	extras.reactions, _ = u.reactionRepo.CountByReportIDs(ctx, reportIDs)
+	return extras
+}
+
+// Helper function to convert entity.Report to model.Report
+func (u *UseCaseImpl) entityToGraphQLReport(ctx context.Context, report *entity.Report, currentUserID string) (*model.Report, error) {
+	if report == nil {
+		return nil, nil
+	}
+	return reportToGraphQL(report, u.loadReportExtras(ctx, []*entity.Report{report}, currentUserID)), nil
+}
+
+// reportToGraphQL converts a report with its extras loaded by loadReportExtras
+func reportToGraphQL(report *entity.Report, extras *reportExtras) *model.Report {
+	author, ok := extras.authors[report.AuthorID]
+	if !ok {
+		// Anonymized reports (and reports of users removed before anonymization existed)
+		author = deletedAuthor(report.AuthorID)
 	}
 
 	// Format photos
-	photos := make([]*model.Photo, len(report.Photos))
-	for i := range report.Photos {
-		photos[i] = &model.Photo{
-			URL: fmt.Sprintf("/api/reports/%s/photos/%d", report.ID, i),
-		}
+	photos := make([]*model.ReportPhoto, len(report.Photos))
+	for i, photo := range report.Photos {
+		photos[i] = entityToGraphQLReportPhoto(report.ID, photo, i)
 	}
 
 	// Format dates
@@ -358,31 +473,72 @@ func (u *UseCaseImpl) entityToGraphQLReport(ctx context.Context, report *entity.
 		updatedAt = &t
 	}
 
-	// Determine canEdit (user must be author or admin)
-	canEdit := false
-	if currentUserID != "" {
-		currentUser, err := u.userRepo.FindByID(ctx, currentUserID)
-		if err == nil {
-			canEdit = currentUser.IsAdmin || currentUser.ID == report.AuthorID
-		}
+	var publishAt *scalars.Time
+	if report.PublishAt != nil {
+		t := scalars.Time(*report.PublishAt)
+		publishAt = &t
 	}
 
+	var publishedAt *scalars.Time
+	if report.PublishedAt != nil {
+		t := scalars.Time(*report.PublishedAt)
+		publishedAt = &t
+	}
+
+	var deletedAt *scalars.Time
+	if report.DeletedAt != nil {
+		t := scalars.Time(*report.DeletedAt)
+		deletedAt = &t
+	}
+
+	tags := report.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	var category *string
+	if report.Category != nil {
+		c := string(*report.Category)
+		category = &c
+	}
+
+	canEdit := extras.viewer != nil && (extras.viewer.IsAdmin || extras.viewer.ID == report.AuthorID)
+
 	return &model.Report{
-		ID:        report.ID,
-		Title:     report.Title,
-		Text:      report.Text,
-		CreatedAt: createdAt,
-		UpdatedAt: updatedAt,
-		AuthorID:  report.AuthorID,
-		Author: &model.Author{
-			ID:        author.ID,
-			Username:  authorUsername,
-			HasAvatar: author.HasAvatar,
-			AvatarURL: authorAvatarURL,
-		},
-		Photos:  photos,
-		CanEdit: canEdit,
-	}, nil
+		ID:            report.ID,
+		Title:         report.Title,
+		Text:          report.Text,
+		TextHTML:      reportTextHTML(report),
+		CreatedAt:     createdAt,
+		UpdatedAt:     updatedAt,
+		AuthorID:      report.AuthorID,
+		Author:        entityToGraphQLAuthor(author),
+		Photos:        photos,
+		CanEdit:       canEdit,
+		CompetitionID: report.CompetitionID,
+		Tour:          report.Tour,
+		Tags:          tags,
+		Category:      category,
+		CommentsCount: int(extras.comments[report.ID]),
+		Reactions:     reactionCounts(extras.reactions[report.ID], extras.reacted[report.ID]),
+		ViewCount:     int(extras.views[report.ID]),
+		Status:        string(report.Status),
+		PublishAt:     publishAt,
+		PublishedAt:   publishedAt,
+		DeletedAt:     deletedAt,
+		DeletedBy:     report.DeletedBy,
+	}
+}
+
+// canEditReport checks if the user is the author of the report or an admin
+func (u *UseCaseImpl) canEditReport(ctx context.Context, userID string, report *entity.Report) bool {
+	if userID == "" {
+		return false
+	}
+	user, err := u.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return false
+	}
+	return user.IsAdmin || user.ID == report.AuthorID
 }
 
 // Helper function to convert entity.Competition to model.Competition
@@ -429,27 +585,40 @@ func (u *UseCaseImpl) entityToGraphQLCompetition(competition *entity.Competition
 		updatedAt = &t
 	}
 
+	var deletedAt *scalars.Time
+	if competition.DeletedAt != nil {
+		t := scalars.Time(*competition.DeletedAt)
+		deletedAt = &t
+	}
+
 	return &model.Competition{
-		ID:               competition.ID,
-		Title:            competition.Title,
-		StartDate:        startDate,
-		EndDate:          endDate,
-		Location:         competition.Location,
-		Tours:            tours,
-		OpeningDate:      openingDate,
-		OpeningTime:      competition.OpeningTime,
-		IndividualFormat: competition.IndividualFormat,
-		TeamFormat:       competition.TeamFormat,
-		Fee:              competition.Fee,
-		TeamLimit:        competition.TeamLimit,
-		Regulations:      competition.Regulations,
-		CreatedAt:        createdAt,
-		UpdatedAt:        updatedAt,
+		ID:                   competition.ID,
+		Title:                competition.Title,
+		StartDate:            startDate,
+		EndDate:              endDate,
+		Location:             competition.Location,
+		VenueID:              competition.VenueID,
+		Tours:                tours,
+		OpeningDate:          openingDate,
+		OpeningTime:          competition.OpeningTime,
+		IndividualFormat:     competition.IndividualFormat,
+		TeamFormat:           competition.TeamFormat,
+		Fee:                  competition.Fee,
+		TeamLimit:            competition.TeamLimit,
+		Regulations:          competition.Regulations,
+		JudgeIds:             append([]string{}, competition.JudgeIDs...),
+		ProtestWindowMinutes: competition.ProtestWindow,
+		Eligibility:          entityToGraphQLEligibility(competition.Eligibility),
+		TeamRules:            entityToGraphQLTeamRules(competition.TeamRulesOrDefault()),
+		CreatedAt:            createdAt,
+		UpdatedAt:            updatedAt,
+		DeletedAt:            deletedAt,
+		DeletedBy:            competition.DeletedBy,
 	}, nil
 }
 
 // GetReports implements UseCase.GetReports
-func (u *UseCaseImpl) GetReports(ctx context.Context, currentUserID string, limit *int) ([]*model.Report, error) {
+func (u *UseCaseImpl) GetReports(ctx context.Context, currentUserID string, limit *int, filter ReportsFilter) ([]*model.Report, error) {
 	reportLimit := 20
 	if limit != nil {
 		if *limit < 1 {
@@ -461,99 +630,114 @@ func (u *UseCaseImpl) GetReports(ctx context.Context, currentUserID string, limi
 		}
 	}
 
-	reports, err := u.reportRepo.FindAll(ctx, reportLimit)
+	reportsFilter, err := reportFilter(filter)
 	if err != nil {
-		return nil, apperrors.WrapError("Не удалось получить отчеты", err)
+		return nil, err
 	}
 
-	result := make([]*model.Report, 0, len(reports))
-	for _, report := range reports {
-		graphQLReport, err := u.entityToGraphQLReport(ctx, report, currentUserID)
-		if err != nil {
-			continue // Skip reports with errors
-		}
-		result = append(result, graphQLReport)
+	reports, err := u.reportRepo.FindPublished(ctx, reportsFilter, reportLimit)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить отчеты", err)
 	}
 
-	return result, nil
+	return u.reportsToGraphQL(ctx, reports, currentUserID), nil
 }
 
 // GetReport implements UseCase.GetReport
-func (u *UseCaseImpl) GetReport(ctx context.Context, currentUserID string, id string) (*model.Report, error) {
+// Opening a published report counts a view of viewerKey (empty to skip counting)
+func (u *UseCaseImpl) GetReport(ctx context.Context, currentUserID string, viewerKey string, id string) (*model.Report, error) {
 	report, err := u.reportRepo.FindByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("Отчет не найден")
 	}
+	if !report.IsPublished() && !u.canEditReport(ctx, currentUserID, report) {
+		return nil, fmt.Errorf("Отчет не найден")
+	}
+	u.recordReportView(ctx, report, currentUserID, viewerKey)
 
 	return u.entityToGraphQLReport(ctx, report, currentUserID)
 }
 
 // CreateReport implements UseCase.CreateReport
-func (u *UseCaseImpl) CreateReport(ctx context.Context, userID string, title, text string, photos []*PhotoUpload) (*model.Report, error) {
+func (u *UseCaseImpl) CreateReport(ctx context.Context, userID string, title, text string, status, publishAt *string, link *ReportLinkInput, taxonomy *ReportTaxonomyInput, photos *ReportPhotosInput) (*model.Report, error) {
 	if userID == "" {
 		return nil, fmt.Errorf("Не авторизован")
 	}
 
 	// Validate input
 	title = strings.TrimSpace(title)
-	text = strings.TrimSpace(text)
 
 	if len(title) < 3 || len(title) > 120 {
 		return nil, fmt.Errorf("Заголовок должен быть от 3 до 120 символов")
 	}
-	if len(text) < 1 || len(text) > 5000 {
-		return nil, fmt.Errorf("Текст должен быть от 1 до 5000 символов")
+	text, err := validateReportText(text)
+	if err != nil {
+		return nil, err
 	}
 
-	// Process photo uploads
-	photosList := make([]interface{}, 0)
-	if len(photos) > 0 {
-		const maxPhotos = 10
-		const maxPhotoSize = 2 * 1024 * 1024 // 2MB
+	now := time.Now()
+	reportStatus, publishTime, publishedAt, err := reportPublicationFromInput(status, publishAt, now)
+	if err != nil {
+		return nil, err
+	}
 
-		if len(photos) > maxPhotos {
-			return nil, fmt.Errorf("Слишком много фотографий (макс %d)", maxPhotos)
+	var competitionID *string
+	var tour *int
+	if link != nil {
+		if competitionID, tour, err = u.reportLink(ctx, &entity.Report{}, link); err != nil {
+			return nil, err
 		}
+	}
 
-		for _, upload := range photos {
-			if upload == nil {
-				continue
-			}
-
-			// Validate file type
-			if !strings.HasPrefix(upload.ContentType, "image/") {
-				return nil, fmt.Errorf("Фотография должна быть изображением")
-			}
-
-			// Validate file size
-			if upload.Size > maxPhotoSize {
-				return nil, fmt.Errorf("Файл фотографии слишком большой (макс 2МБ)")
-			}
-
-			// Read file data
-			data, err := io.ReadAll(upload.File)
-			if err != nil {
-				return nil, apperrors.WrapError("Не удалось прочитать файл фотографии", err)
-			}
+	classified := &entity.Report{}
+	if err := applyReportTaxonomy(classified, taxonomy); err != nil {
+		return nil, err
+	}
 
-			photosList = append(photosList, map[string]interface{}{
-				"contentType": upload.ContentType,
-				"data":        data,
-			})
-		}
+	// Process photo uploads, including completed resumable uploads
+	account, err := u.storageAccountOf(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	uploads, err := u.attachUploadedPhotos(ctx, userID, photos)
+	if err != nil {
+		return nil, err
+	}
+	photosList, err := readReportPhotos(account, photos, 0)
+	if err != nil {
+		return nil, err
 	}
 
 	// Create domain entity
 	reportEntity := &entity.Report{
-		AuthorID:  userID,
-		Title:     title,
-		Text:      text,
-		Photos:    photosList,
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
-	}
-
-	reportID, err := u.reportRepo.Create(ctx, reportEntity)
+		AuthorID:      userID,
+		Title:         title,
+		Text:          text,
+		Photos:        photosList,
+		CompetitionID: competitionID,
+		Tour:          tour,
+		Tags:          classified.Tags,
+		Category:      classified.Category,
+		Status:        reportStatus,
+		PublishAt:     publishTime,
+		PublishedAt:   publishedAt,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	// Attached uploads become report photos, so their storage is released before the photos are charged
+	var reportID string
+	err = u.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := u.deleteUploads(ctx, uploads); err != nil {
+			return err
+		}
+		if err := u.reserveStorage(ctx, account, photoStorage(photosList)[userID]); err != nil {
+			return err
+		}
+		var err error
+		reportID, err = u.reportRepo.Create(ctx, reportEntity)
+		return err
+	})
 	if err != nil {
 		return nil, apperrors.WrapError("Не удалось создать отчет", err)
 	}
@@ -568,7 +752,7 @@ func (u *UseCaseImpl) CreateReport(ctx context.Context, userID string, title, te
 }
 
 // UpdateReport implements UseCase.UpdateReport
-func (u *UseCaseImpl) UpdateReport(ctx context.Context, userID string, id string, title, text *string, removePhoto []int, removeAllPhotos *bool, photos []*PhotoUpload) (*model.Report, error) {
+func (u *UseCaseImpl) UpdateReport(ctx context.Context, userID string, id string, title, text *string, link *ReportLinkInput, taxonomy *ReportTaxonomyInput, photos *ReportPhotosInput) (*model.Report, error) {
 	if userID == "" {
 		return nil, fmt.Errorf("Не авторизован")
 	}
@@ -597,13 +781,17 @@ func (u *UseCaseImpl) UpdateReport(ctx context.Context, userID string, id string
 
 	// Start with existing report data
 	updatedReport := &entity.Report{
-		ID:        reportDoc.ID,
-		AuthorID:  reportDoc.AuthorID,
-		Title:     reportDoc.Title,
-		Text:      reportDoc.Text,
-		Photos:    reportDoc.Photos,
-		CreatedAt: reportDoc.CreatedAt,
-		UpdatedAt: time.Now(),
+		ID:            reportDoc.ID,
+		AuthorID:      reportDoc.AuthorID,
+		Title:         reportDoc.Title,
+		Text:          reportDoc.Text,
+		Photos:        reportDoc.Photos,
+		CompetitionID: reportDoc.CompetitionID,
+		Tour:          reportDoc.Tour,
+		Tags:          reportDoc.Tags,
+		Category:      reportDoc.Category,
+		CreatedAt:     reportDoc.CreatedAt,
+		UpdatedAt:     time.Now(),
 	}
 
 	update := false
@@ -620,79 +808,65 @@ func (u *UseCaseImpl) UpdateReport(ctx context.Context, userID string, id string
 
 	// Update text if provided
 	if text != nil {
-		textTrimmed := strings.TrimSpace(*text)
-		if len(textTrimmed) < 1 || len(textTrimmed) > 5000 {
-			return nil, fmt.Errorf("Текст должен быть от 1 до 5000 символов")
+		textTrimmed, err := validateReportText(*text)
+		if err != nil {
+			return nil, err
 		}
 		updatedReport.Text = textTrimmed
 		update = true
 	}
 
-	// Handle photo removal
-	if removeAllPhotos != nil && *removeAllPhotos {
-		updatedReport.Photos = []interface{}{}
-		update = true
-	} else if len(removePhoto) > 0 {
-		removeIdx := make(map[int]bool)
-		for _, idx := range removePhoto {
-			removeIdx[idx] = true
-		}
-		newPhotos := []interface{}{}
-		for i, photo := range updatedReport.Photos {
-			if !removeIdx[i] {
-				newPhotos = append(newPhotos, photo)
-			}
+	// Update competition link if provided
+	if link != nil {
+		competitionID, tour, err := u.reportLink(ctx, updatedReport, link)
+		if err != nil {
+			return nil, err
 		}
-		updatedReport.Photos = newPhotos
+		updatedReport.CompetitionID = competitionID
+		updatedReport.Tour = tour
 		update = true
 	}
 
-	// Handle new photo uploads
-	if len(photos) > 0 {
-		const maxPhotos = 10
-		const maxPhotoSize = 2 * 1024 * 1024 // 2MB
-
-		currentCount := len(updatedReport.Photos)
-		if currentCount+len(photos) > maxPhotos {
-			return nil, fmt.Errorf("too many photos (max %d)", maxPhotos)
-		}
-
-		// Process new uploads
-		for _, upload := range photos {
-			if upload == nil {
-				continue
-			}
-
-			// Validate file type
-			if !strings.HasPrefix(upload.ContentType, "image/") {
-				return nil, fmt.Errorf("Фотография должна быть изображением")
-			}
-
-			// Validate file size
-			if upload.Size > maxPhotoSize {
-				return nil, fmt.Errorf("Файл фотографии слишком большой (макс 2МБ)")
-			}
-
-			// Read file data
-			data, err := io.ReadAll(upload.File)
-			if err != nil {
-				return nil, apperrors.WrapError("Не удалось прочитать файл фотографии", err)
-			}
-
-			updatedReport.Photos = append(updatedReport.Photos, map[string]interface{}{
-				"contentType": upload.ContentType,
-				"data":        data,
-			})
+	// Update tags and category if provided
+	if taxonomy != nil {
+		if err := applyReportTaxonomy(updatedReport, taxonomy); err != nil {
+			return nil, err
 		}
 		update = true
 	}
 
-	if !update {
+	// Photo edits are applied by photo ID, so they do not undo changes other editors made meanwhile;
+	// added photos are charged to the storage quota of the editor who uploads them
+	account := &storageAccount{userID: currentUser.ID, limits: u.limitsFor(currentUser.Role())}
+	uploads, err := u.attachUploadedPhotos(ctx, userID, photos)
+	if err != nil {
+		return nil, err
+	}
+	updatedPhotos, photoChanges, err := planReportPhotos(account, reportDoc.Photos, photos)
+	if err != nil {
+		return nil, err
+	}
+	updatedReport.Photos = updatedPhotos
+
+	if !update && photoChanges.empty() {
 		return nil, fmt.Errorf("Нет полей для обновления")
 	}
 
-	// Update report
-	err = u.reportRepo.Update(ctx, id, updatedReport)
+	// Update report, keeping the previous version in the revision history
+	err = u.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := u.recordReportRevision(ctx, userID, reportDoc, updatedReport); err != nil {
+			return err
+		}
+		if update {
+			if err := u.reportRepo.Update(ctx, id, updatedReport); err != nil {
+				return err
+			}
+		}
+		if err := u.deleteUploads(ctx, uploads); err != nil {
+			return err
+		}
+		return u.saveReportPhotos(ctx, account, id, photoChanges)
+	})
 	if err != nil {
 		return nil, apperrors.WrapError("Не удалось обновить отчет", err)
 	}
@@ -728,7 +902,8 @@ func (u *UseCaseImpl) DeleteReport(ctx context.Context, userID string, id string
 		return false, fmt.Errorf("Доступ запрещен")
 	}
 
-	err = u.reportRepo.Delete(ctx, id)
+	// The report and its photos stay in the trash until the retention period ends
+	err = u.reportRepo.SoftDelete(ctx, id, userID)
 	if err != nil {
 		return false, apperrors.WrapError("не удалось удалить отчет", err)
 	}
@@ -767,105 +942,65 @@ func (u *UseCaseImpl) GetCompetition(ctx context.Context, id string) (*model.Com
 
 // CreateCompetition implements UseCase.CreateCompetition
 func (u *UseCaseImpl) CreateCompetition(ctx context.Context, input *model.CompetitionInput) (*model.Competition, error) {
-	if input == nil {
-		return nil, fmt.Errorf("Входные данные не могут быть пустыми")
-	}
-
-	if !input.IndividualFormat && !input.TeamFormat {
-		return nil, fmt.Errorf("Выберите хотя бы один формат соревнований")
+	competitionEntity, err := u.competitionFromInput(ctx, input)
+	if err != nil {
+		return nil, err
 	}
+	competitionEntity.CreatedAt = time.Now()
+	competitionEntity.UpdatedAt = time.Now()
 
-	// Parse dates
-	startDate, err := time.Parse(time.RFC3339, input.StartDate)
+	competitionID, err := u.competitionRepo.Create(ctx, competitionEntity)
 	if err != nil {
-		return nil, fmt.Errorf("Неверная дата начала: %w", err)
+		return nil, apperrors.WrapError("Не удалось создать соревнование", err)
 	}
-	endDate, err := time.Parse(time.RFC3339, input.EndDate)
+
+	// Get created competition
+	createdCompetition, err := u.competitionRepo.FindByID(ctx, competitionID)
 	if err != nil {
-		return nil, fmt.Errorf("Неверная дата окончания: %w", err)
+		return nil, apperrors.WrapError("Не удалось найти созданное соревнование", err)
 	}
 
-	// Convert tours to entity.Tour
-	entityTours := make([]entity.Tour, len(input.Tours))
-	for i, tourInput := range input.Tours {
-		tourDate, err := time.Parse(time.RFC3339, tourInput.Date)
-		if err != nil {
-			return nil, fmt.Errorf("Неверная дата тура: %w", err)
-		}
-		entityTours[i] = entity.Tour{
-			Date: tourDate,
-			Time: tourInput.Time,
-		}
-	}
+	return u.entityToGraphQLCompetition(createdCompetition)
+}
 
-	var openingDateEntity *time.Time
-	if input.OpeningDate != nil && *input.OpeningDate != "" {
-		od, err := time.Parse(time.RFC3339, *input.OpeningDate)
-		if err != nil {
-			return nil, fmt.Errorf("Неверная дата открытия: %w", err)
-		}
-		openingDateEntity = &od
+// UpdateCompetition implements UseCase.UpdateCompetition
+func (u *UseCaseImpl) UpdateCompetition(ctx context.Context, userID string, id string, input *model.CompetitionInput) (*model.Competition, error) {
+	// Check if competition exists
+	existingCompetition, err := u.competitionRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("Соревнование не найдено")
 	}
 
-	var fee *float64
-	if input.Fee != nil && *input.Fee != "" {
-		f, err := strconv.ParseFloat(*input.Fee, 64)
-		if err != nil {
-			return nil, fmt.Errorf("Неверная плата: %w", err)
-		}
-		fee = &f
+	updatedCompetition, err := u.competitionFromInput(ctx, input)
+	if err != nil {
+		return nil, err
 	}
+	updatedCompetition.ID = existingCompetition.ID
+	updatedCompetition.CreatedAt = existingCompetition.CreatedAt
+	updatedCompetition.UpdatedAt = time.Now()
 
-	var teamLimitInt *int
-	if input.TeamLimit != nil && *input.TeamLimit != "" {
-		tl, err := strconv.ParseInt(*input.TeamLimit, 10, 32)
-		if err != nil {
-			return nil, fmt.Errorf("Неверное ограничение команд: %w", err)
+	// Update competition, keeping the previous version in the revision history
+	err = u.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := u.recordCompetitionRevision(ctx, userID, existingCompetition, updatedCompetition); err != nil {
+			return err
 		}
-		t := int(tl)
-		teamLimitInt = &t
-	}
-
-	var regulationsStr *string
-	if input.Regulations != nil {
-		s := strings.TrimSpace(*input.Regulations)
-		regulationsStr = &s
-	}
-
-	// Create entity.Competition
-	competitionEntity := &entity.Competition{
-		Title:            strings.TrimSpace(input.Title),
-		StartDate:        &startDate,
-		EndDate:          &endDate,
-		Location:         strings.TrimSpace(input.Location),
-		Tours:            entityTours,
-		OpeningDate:      openingDateEntity,
-		OpeningTime:      input.OpeningTime,
-		IndividualFormat: input.IndividualFormat,
-		TeamFormat:       input.TeamFormat,
-		Fee:              fee,
-		TeamLimit:        teamLimitInt,
-		Regulations:      regulationsStr,
-		CreatedAt:        time.Now(),
-		UpdatedAt:        time.Now(),
-	}
-
-	competitionID, err := u.competitionRepo.Create(ctx, competitionEntity)
+		return u.competitionRepo.Update(ctx, id, updatedCompetition)
+	})
 	if err != nil {
-		return nil, apperrors.WrapError("Не удалось создать соревнование", err)
+		return nil, apperrors.WrapError("Не удалось обновить соревнование", err)
 	}
 
-	// Get created competition
-	createdCompetition, err := u.competitionRepo.FindByID(ctx, competitionID)
+	// Get updated competition
+	updatedCompetitionDoc, err := u.competitionRepo.FindByID(ctx, id)
 	if err != nil {
-		return nil, apperrors.WrapError("Не удалось найти созданное соревнование", err)
+		return nil, apperrors.WrapError("Не удалось найти обновленное соревнование", err)
 	}
 
-	return u.entityToGraphQLCompetition(createdCompetition)
+	return u.entityToGraphQLCompetition(updatedCompetitionDoc)
 }
 
-// UpdateCompetition implements UseCase.UpdateCompetition
-func (u *UseCaseImpl) UpdateCompetition(ctx context.Context, id string, input *model.CompetitionInput) (*model.Competition, error) {
+// competitionFromInput validates competition input and converts it to a domain entity (without timestamps)
+func (u *UseCaseImpl) competitionFromInput(ctx context.Context, input *model.CompetitionInput) (*entity.Competition, error) {
 	if input == nil {
 		return nil, fmt.Errorf("Входные данные не могут быть пустыми")
 	}
@@ -874,12 +1009,6 @@ func (u *UseCaseImpl) UpdateCompetition(ctx context.Context, id string, input *m
 		return nil, fmt.Errorf("Выберите хотя бы один формат соревнований")
 	}
 
-	// Check if competition exists
-	existingCompetition, err := u.competitionRepo.FindByID(ctx, id)
-	if err != nil {
-		return nil, fmt.Errorf("Соревнование не найдено")
-	}
-
 	// Parse dates
 	startDate, err := time.Parse(time.RFC3339, input.StartDate)
 	if err != nil {
@@ -937,13 +1066,31 @@ func (u *UseCaseImpl) UpdateCompetition(ctx context.Context, id string, input *m
 		regulationsStr = &s
 	}
 
-	// Update entity.Competition
-	updatedCompetition := &entity.Competition{
-		ID:               existingCompetition.ID,
+	if input.ProtestWindowMinutes != nil && (*input.ProtestWindowMinutes < 0 || *input.ProtestWindowMinutes > maxProtestWindowMinutes) {
+		return nil, fmt.Errorf("Срок подачи протестов должен быть от 0 до %d минут", maxProtestWindowMinutes)
+	}
+
+	eligibility, err := eligibilityFromInput(input.Eligibility)
+	if err != nil {
+		return nil, err
+	}
+
+	teamRules, err := teamRulesFromInput(input.TeamRules)
+	if err != nil {
+		return nil, err
+	}
+
+	venueID, location, err := u.resolveCompetitionVenue(ctx, input.VenueID, input.Location)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.Competition{
 		Title:            strings.TrimSpace(input.Title),
 		StartDate:        &startDate,
 		EndDate:          &endDate,
-		Location:         strings.TrimSpace(input.Location),
+		Location:         location,
+		VenueID:          venueID,
 		Tours:            entityTours,
 		OpeningDate:      openingDateEntity,
 		OpeningTime:      input.OpeningTime,
@@ -952,32 +1099,32 @@ func (u *UseCaseImpl) UpdateCompetition(ctx context.Context, id string, input *m
 		Fee:              fee,
 		TeamLimit:        teamLimitInt,
 		Regulations:      regulationsStr,
-		CreatedAt:        existingCompetition.CreatedAt,
-		UpdatedAt:        time.Now(),
-	}
+		ProtestWindow:    input.ProtestWindowMinutes,
+		Eligibility:      eligibility,
+		TeamRules:        teamRules,
+	}, nil
+}
 
-	err = u.competitionRepo.Update(ctx, id, updatedCompetition)
-	if err != nil {
-		return nil, apperrors.WrapError("Не удалось обновить соревнование", err)
+// resolveCompetitionVenue validates the optional venue reference of a competition
+// If location is left empty, the venue's Russian name is used instead
+func (u *UseCaseImpl) resolveCompetitionVenue(ctx context.Context, venueID *string, location string) (*string, string, error) {
+	location = strings.TrimSpace(location)
+	if venueID == nil || *venueID == "" {
+		if location == "" {
+			return nil, "", fmt.Errorf("Укажите место проведения")
+		}
+		return nil, location, nil
 	}
 
-	// Get updated competition
-	updatedCompetitionDoc, err := u.competitionRepo.FindByID(ctx, id)
+	venue, err := u.venueRepo.FindByID(ctx, *venueID)
 	if err != nil {
-		return nil, apperrors.WrapError("Не удалось найти обновленное соревнование", err)
+		return nil, "", fmt.Errorf("Место проведения не найдено")
 	}
-
-	return u.entityToGraphQLCompetition(updatedCompetitionDoc)
-}
-
-// DeleteCompetition implements UseCase.DeleteCompetition
-func (u *UseCaseImpl) DeleteCompetition(ctx context.Context, id string) (bool, error) {
-	err := u.competitionRepo.Delete(ctx, id)
-	if err != nil {
-		return false, apperrors.WrapError("Не удалось удалить соревнование", err)
+	if location == "" {
+		location = venue.NameRu
 	}
 
-	return true, nil
+	return &venue.ID, location, nil
 }
 
 // GetAdminUsers implements UseCase.GetAdminUsers
@@ -1057,18 +1204,8 @@ func (u *UseCaseImpl) AdminUpdateUser(ctx context.Context, id string, isAdmin *b
 	return entityToGraphQLUser(finalUser), nil
 }
 
-// AdminDeleteUser implements UseCase.AdminDeleteUser
-func (u *UseCaseImpl) AdminDeleteUser(ctx context.Context, id string) (bool, error) {
-	err := u.userRepo.Delete(ctx, id)
-	if err != nil {
-		return false, apperrors.WrapError("Не удалось удалить пользователя", err)
-	}
-
-	return true, nil
-}
-
 // CreateRegistration implements UseCase.CreateRegistration
-func (u *UseCaseImpl) CreateRegistration(ctx context.Context, userID string, competitionID string, registrationType string, teamName *string, participants []ParticipantInput, coach *CoachInput) (*model.Registration, error) {
+func (u *UseCaseImpl) CreateRegistration(ctx context.Context, userID string, competitionID string, registrationType string, teamName *string, participants []ParticipantInput, coaches []CoachInput, eligibilityOverrideReason *string) (*model.Registration, error) {
 	if userID == "" {
 		return nil, fmt.Errorf("Не авторизован")
 	}
@@ -1079,6 +1216,52 @@ func (u *UseCaseImpl) CreateRegistration(ctx context.Context, userID string, com
 		return nil, fmt.Errorf("Соревнование не найдено")
 	}
 
+	registration, err := registrationFromInput(competition, registrationType, teamName, participants, coaches)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if user already registered (admins can register multiple times)
+	currentUser, err := u.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("Пользователь не найден")
+	}
+	
+	// Only check for existing registration if user is not admin
+	if !currentUser.IsAdmin {
+		existing, err := u.registrationRepo.FindByCompetitionAndUser(ctx, competitionID, userID)
+		if err == nil && existing != nil {
+			return nil, fmt.Errorf("Вы уже зарегистрированы на это соревнование")
+		}
+	}
+
+	if err := applyEligibility(competition, registration, currentUser, eligibilityOverrideReason, nil); err != nil {
+		return nil, err
+	}
+
+	registration.UserID = userID
+	registration.CreatedAt = time.Now()
+	registration.UpdatedAt = time.Now()
+
+	registrationID, err := u.registrationRepo.Create(ctx, registration)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось создать регистрацию", err)
+	}
+
+	// Get created registration
+	createdReg, err := u.registrationRepo.FindByID(ctx, registrationID)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось найти созданную регистрацию", err)
+	}
+
+	u.sendRegistrationConfirmation(currentUser, competition, createdReg)
+
+	return u.entityToGraphQLRegistration(createdReg, userID), nil
+}
+
+// registrationFromInput validates registration input against the competition formats
+// and converts it to a domain entity (without user and timestamps)
+func registrationFromInput(competition *entity.Competition, registrationType string, teamName *string, participants []ParticipantInput, coaches []CoachInput) (*entity.Registration, error) {
 	// Validate registration type
 	regType := entity.RegistrationType(registrationType)
 	if regType != entity.RegistrationTypeIndividual && regType != entity.RegistrationTypeTeam {
@@ -1093,27 +1276,33 @@ func (u *UseCaseImpl) CreateRegistration(ctx context.Context, userID string, com
 		return nil, fmt.Errorf("Командный формат не поддерживается этим соревнованием")
 	}
 
-	// Validate participants
-	if regType == entity.RegistrationTypeIndividual {
-		if len(participants) != 1 {
-			return nil, fmt.Errorf("Для индивидуальной регистрации нужен один участник")
-		}
-		if teamName != nil {
-			return nil, fmt.Errorf("Название команды не требуется для индивидуальной регистрации")
-		}
-		if coach != nil {
-			return nil, fmt.Errorf("Тренер не требуется для индивидуальной регистрации")
-		}
-	} else {
-		if len(participants) != 3 {
-			return nil, fmt.Errorf("Для командной регистрации нужно три участника")
-		}
-		if teamName == nil || strings.TrimSpace(*teamName) == "" {
-			return nil, fmt.Errorf("Название команды обязательно")
-		}
+	entityParticipants, err := participantsFromInput(participants)
+	if err != nil {
+		return nil, err
+	}
+
+	entityCoaches, err := coachesFromInput(coaches)
+	if err != nil {
+		return nil, err
 	}
 
-	// Validate participant names
+	// Validate team composition against the competition rules
+	if err := validateRegistrationComposition(competition, regType, teamName, entityParticipants, entityCoaches); err != nil {
+		return nil, err
+	}
+
+	return &entity.Registration{
+		CompetitionID: competition.ID,
+		Type:          regType,
+		TeamName:      teamName,
+		Participants:  entityParticipants,
+		Coaches:       entityCoaches,
+	}, nil
+}
+
+// participantsFromInput validates participant input and converts it to domain entities
+func participantsFromInput(participants []ParticipantInput) ([]entity.Participant, error) {
+	entityParticipants := make([]entity.Participant, len(participants))
 	for i, p := range participants {
 		if strings.TrimSpace(p.FirstName) == "" {
 			return nil, fmt.Errorf("Имя участника %d обязательно", i+1)
@@ -1121,66 +1310,42 @@ func (u *UseCaseImpl) CreateRegistration(ctx context.Context, userID string, com
 		if strings.TrimSpace(p.LastName) == "" {
 			return nil, fmt.Errorf("Фамилия участника %d обязательна", i+1)
 		}
-	}
-
-	// Check if user already registered (admins can register multiple times)
-	currentUser, err := u.userRepo.FindByID(ctx, userID)
-	if err != nil {
-		return nil, fmt.Errorf("Пользователь не найден")
-	}
-	
-	// Only check for existing registration if user is not admin
-	if !currentUser.IsAdmin {
-		existing, err := u.registrationRepo.FindByCompetitionAndUser(ctx, competitionID, userID)
-		if err == nil && existing != nil {
-			return nil, fmt.Errorf("Вы уже зарегистрированы на это соревнование")
-		}
-	}
 
-	// Convert participants
-	entityParticipants := make([]entity.Participant, len(participants))
-	for i, p := range participants {
-		entityParticipants[i] = entity.Participant{
+		participant := entity.Participant{
 			FirstName: strings.TrimSpace(p.FirstName),
 			LastName:  strings.TrimSpace(p.LastName),
+			Reserve:   p.Reserve,
+			Captain:   p.Captain,
 		}
-	}
 
-	var entityCoach *entity.Coach
-	if coach != nil {
-		if strings.TrimSpace(coach.FirstName) == "" || strings.TrimSpace(coach.LastName) == "" {
-			return nil, fmt.Errorf("Имя и фамилия тренера обязательны")
-		}
-		entityCoach = &entity.Coach{
-			FirstName: strings.TrimSpace(coach.FirstName),
-			LastName:  strings.TrimSpace(coach.LastName),
+		if p.BirthDate != nil && *p.BirthDate != "" {
+			birthDate, err := time.Parse(time.RFC3339, *p.BirthDate)
+			if err != nil {
+				return nil, fmt.Errorf("Неверная дата рождения участника %d: %w", i+1, err)
+			}
+			if birthDate.After(time.Now()) {
+				return nil, fmt.Errorf("Дата рождения участника %d в будущем", i+1)
+			}
+			participant.BirthDate = &birthDate
 		}
-	}
 
-	// Create registration entity
-	registration := &entity.Registration{
-		CompetitionID: competitionID,
-		UserID:        userID,
-		Type:          regType,
-		TeamName:      teamName,
-		Participants:  entityParticipants,
-		Coach:         entityCoach,
-		CreatedAt:     time.Now(),
-		UpdatedAt:     time.Now(),
-	}
+		if p.Gender != nil && *p.Gender != "" {
+			gender := entity.Gender(*p.Gender)
+			if !entity.IsValidGender(gender) {
+				return nil, fmt.Errorf("Неверный пол участника %d (допустимо: male, female)", i+1)
+			}
+			participant.Gender = &gender
+		}
 
-	registrationID, err := u.registrationRepo.Create(ctx, registration)
-	if err != nil {
-		return nil, apperrors.WrapError("Не удалось создать регистрацию", err)
-	}
+		if p.LicenseNumber != nil {
+			if license := strings.TrimSpace(*p.LicenseNumber); license != "" {
+				participant.LicenseNumber = &license
+			}
+		}
 
-	// Get created registration
-	createdReg, err := u.registrationRepo.FindByID(ctx, registrationID)
-	if err != nil {
-		return nil, apperrors.WrapError("Не удалось найти созданную регистрацию", err)
+		entityParticipants[i] = participant
 	}
-
-	return u.entityToGraphQLRegistration(createdReg, userID), nil
+	return entityParticipants, nil
 }
 
 // GetRegistrationsByCompetition implements UseCase.GetRegistrationsByCompetition
@@ -1199,7 +1364,7 @@ func (u *UseCaseImpl) GetRegistrationsByCompetition(ctx context.Context, competi
 }
 
 // UpdateRegistration implements UseCase.UpdateRegistration
-func (u *UseCaseImpl) UpdateRegistration(ctx context.Context, userID string, registrationID string, teamName *string, participants []ParticipantInput, coach *CoachInput) (*model.Registration, error) {
+func (u *UseCaseImpl) UpdateRegistration(ctx context.Context, userID string, registrationID string, teamName *string, participants []ParticipantInput, coaches []CoachInput, eligibilityOverrideReason *string) (*model.Registration, error) {
 	if userID == "" {
 		return nil, fmt.Errorf("Не авторизован")
 	}
@@ -1220,48 +1385,24 @@ func (u *UseCaseImpl) UpdateRegistration(ctx context.Context, userID string, reg
 		return nil, fmt.Errorf("Доступ запрещен")
 	}
 
-	// Validate participants count based on type
-	if existingReg.Type == entity.RegistrationTypeIndividual {
-		if len(participants) != 1 {
-			return nil, fmt.Errorf("Для индивидуальной регистрации нужен один участник")
-		}
-	} else {
-		if len(participants) != 3 {
-			return nil, fmt.Errorf("Для командной регистрации нужно три участника")
-		}
-		if teamName == nil || strings.TrimSpace(*teamName) == "" {
-			return nil, fmt.Errorf("Название команды обязательно")
-		}
+	competition, err := u.competitionRepo.FindByID(ctx, existingReg.CompetitionID)
+	if err != nil {
+		return nil, fmt.Errorf("Соревнование не найдено")
 	}
 
-	// Validate participant names
-	for i, p := range participants {
-		if strings.TrimSpace(p.FirstName) == "" {
-			return nil, fmt.Errorf("Имя участника %d обязательно", i+1)
-		}
-		if strings.TrimSpace(p.LastName) == "" {
-			return nil, fmt.Errorf("Фамилия участника %d обязательна", i+1)
-		}
+	entityParticipants, err := participantsFromInput(participants)
+	if err != nil {
+		return nil, err
 	}
 
-	// Convert participants
-	entityParticipants := make([]entity.Participant, len(participants))
-	for i, p := range participants {
-		entityParticipants[i] = entity.Participant{
-			FirstName: strings.TrimSpace(p.FirstName),
-			LastName:  strings.TrimSpace(p.LastName),
-		}
+	entityCoaches, err := coachesFromInput(coaches)
+	if err != nil {
+		return nil, err
 	}
 
-	var entityCoach *entity.Coach
-	if coach != nil {
-		if strings.TrimSpace(coach.FirstName) == "" || strings.TrimSpace(coach.LastName) == "" {
-			return nil, fmt.Errorf("Имя и фамилия тренера обязательны")
-		}
-		entityCoach = &entity.Coach{
-			FirstName: strings.TrimSpace(coach.FirstName),
-			LastName:  strings.TrimSpace(coach.LastName),
-		}
+	// Validate team composition against the competition rules
+	if err := validateRegistrationComposition(competition, existingReg.Type, teamName, entityParticipants, entityCoaches); err != nil {
+		return nil, err
 	}
 
 	// Update registration
@@ -1272,11 +1413,15 @@ func (u *UseCaseImpl) UpdateRegistration(ctx context.Context, userID string, reg
 		Type:          existingReg.Type,
 		TeamName:      teamName,
 		Participants:  entityParticipants,
-		Coach:         entityCoach,
+		Coaches:       entityCoaches,
 		CreatedAt:     existingReg.CreatedAt,
 		UpdatedAt:     time.Now(),
 	}
 
+	if err := applyEligibility(competition, updatedReg, currentUser, eligibilityOverrideReason, existingReg.EligibilityOverride); err != nil {
+		return nil, err
+	}
+
 	err = u.registrationRepo.Update(ctx, registrationID, updatedReg)
 	if err != nil {
 		return nil, apperrors.WrapError("Не удалось обновить регистрацию", err)
@@ -1313,7 +1458,7 @@ func (u *UseCaseImpl) DeleteRegistration(ctx context.Context, userID string, reg
 		return false, fmt.Errorf("Доступ запрещен")
 	}
 
-	err = u.registrationRepo.Delete(ctx, registrationID)
+	err = u.registrationRepo.SoftDelete(ctx, registrationID, userID)
 	if err != nil {
 		return false, apperrors.WrapError("Не удалось удалить регистрацию", err)
 	}
@@ -1327,20 +1472,16 @@ func (u *UseCaseImpl) entityToGraphQLRegistration(e *entity.Registration, curren
 		return nil
 	}
 
-	participants := make([]*model.Participant, len(e.Participants))
-	for i, p := range e.Participants {
-		participants[i] = &model.Participant{
-			FirstName: p.FirstName,
-			LastName:  p.LastName,
+	coaches := make([]*model.Coach, len(e.Coaches))
+	for i, c := range e.Coaches {
+		coaches[i] = &model.Coach{
+			FirstName: c.FirstName,
+			LastName:  c.LastName,
 		}
 	}
-
 	var coach *model.Coach
-	if e.Coach != nil {
-		coach = &model.Coach{
-			FirstName: e.Coach.FirstName,
-			LastName:  e.Coach.LastName,
-		}
+	if len(coaches) > 0 {
+		coach = coaches[0]
 	}
 
 	// Determine canEdit (user must be author or admin)
@@ -1353,16 +1494,68 @@ func (u *UseCaseImpl) entityToGraphQLRegistration(e *entity.Registration, curren
 		}
 	}
 
+	// Birth date, license and the override reason are personal data: only for those who can edit
+	participants := make([]*model.Participant, len(e.Participants))
+	for i, p := range e.Participants {
+		participants[i] = &model.Participant{
+			FirstName: p.FirstName,
+			LastName:  p.LastName,
+			Reserve:   p.Reserve,
+			Captain:   p.Captain,
+		}
+		if p.Gender != nil {
+			g := string(*p.Gender)
+			participants[i].Gender = &g
+		}
+		if canEdit {
+			if p.BirthDate != nil {
+				t := scalars.Time(*p.BirthDate)
+				participants[i].BirthDate = &t
+			}
+			participants[i].LicenseNumber = p.LicenseNumber
+		}
+	}
+
+	var paidAt *scalars.Time
+	if e.PaidAt != nil && canEdit {
+		t := scalars.Time(*e.PaidAt)
+		paidAt = &t
+	}
+
+	var deletedAt *scalars.Time
+	if e.DeletedAt != nil {
+		t := scalars.Time(*e.DeletedAt)
+		deletedAt = &t
+	}
+
+	var eligibilityOverride *model.EligibilityOverride
+	if e.EligibilityOverride != nil && canEdit {
+		eligibilityOverride = &model.EligibilityOverride{
+			AdminID:    e.EligibilityOverride.AdminID,
+			Reason:     e.EligibilityOverride.Reason,
+			Violations: e.EligibilityOverride.Violations,
+			CreatedAt:  scalars.Time(e.EligibilityOverride.CreatedAt),
+		}
+	}
+
 	return &model.Registration{
-		ID:            e.ID,
-		CompetitionID: e.CompetitionID,
-		UserID:        e.UserID,
-		Type:          string(e.Type),
-		TeamName:      e.TeamName,
-		Participants:  participants,
-		Coach:         coach,
-		CanEdit:       canEdit,
-		CreatedAt:     scalars.Time(e.CreatedAt),
-		UpdatedAt:     scalars.Time(e.UpdatedAt),
+		ID:                  e.ID,
+		CompetitionID:       e.CompetitionID,
+		UserID:              e.UserID,
+		Type:                string(e.Type),
+		TeamName:            e.TeamName,
+		Participants:        participants,
+		Coach:               coach,
+		Coaches:             coaches,
+		Sector:              e.Sector,
+		Peg:                 e.Peg,
+		CheckInCode:         checkInCodeFor(e, canEdit),
+		EligibilityOverride: eligibilityOverride,
+		PaidAt:              paidAt,
+		CanEdit:             canEdit,
+		CreatedAt:           scalars.Time(e.CreatedAt),
+		UpdatedAt:           scalars.Time(e.UpdatedAt),
+		DeletedAt:           deletedAt,
+		DeletedBy:           e.DeletedBy,
 	}
 }
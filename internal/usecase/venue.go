@@ -0,0 +1,342 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/cnpf/feeder-backend/graph/model"
+	"github.com/cnpf/feeder-backend/graph/scalars"
+	"github.com/cnpf/feeder-backend/internal/domain/entity"
+	apperrors "github.com/cnpf/feeder-backend/internal/errors"
+)
+
+const (
+	maxVenuePhotos           = 10
+	maxVenueSearchKm         = 500.0
+	defaultVenueNearKm       = 50.0
+	maxVenueNameLength       = 120
+	maxVenueDirectionsLength = 5000
+)
+
+// GetVenues implements UseCase.GetVenues
+// Without near returns all venues sorted by name, otherwise venues within radius, nearest first
+func (u *UseCaseImpl) GetVenues(ctx context.Context, near *model.NearInput) ([]*model.Venue, error) {
+	var venues []*entity.Venue
+	var err error
+
+	if near == nil {
+		venues, err = u.venueRepo.FindAll(ctx)
+	} else {
+		if err := validateCoordinates(near.Lat, near.Lon); err != nil {
+			return nil, err
+		}
+		radiusKm := near.RadiusKm
+		if radiusKm <= 0 {
+			radiusKm = defaultVenueNearKm
+		}
+		if radiusKm > maxVenueSearchKm {
+			radiusKm = maxVenueSearchKm
+		}
+		venues, err = u.venueRepo.FindNear(ctx, entity.GeoPoint{Lat: near.Lat, Lon: near.Lon}, radiusKm)
+	}
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось получить места проведения", err)
+	}
+
+	result := make([]*model.Venue, 0, len(venues))
+	for _, venue := range venues {
+		result = append(result, entityToGraphQLVenue(venue))
+	}
+
+	return result, nil
+}
+
+// GetVenue implements UseCase.GetVenue
+func (u *UseCaseImpl) GetVenue(ctx context.Context, id string) (*model.Venue, error) {
+	venue, err := u.venueRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("Место проведения не найдено")
+	}
+
+	return entityToGraphQLVenue(venue), nil
+}
+
+// CreateVenue implements UseCase.CreateVenue
+func (u *UseCaseImpl) CreateVenue(ctx context.Context, input *model.VenueInput, photos []*PhotoUpload) (*model.Venue, error) {
+	if input == nil {
+		return nil, fmt.Errorf("Входные данные не могут быть пустыми")
+	}
+
+	venueEntity, err := venueFromInput(input)
+	if err != nil {
+		return nil, err
+	}
+
+	photosList, err := readVenuePhotos(u.venueStorageAccount(), photos, 0)
+	if err != nil {
+		return nil, err
+	}
+	venueEntity.Photos = photosList
+	venueEntity.CreatedAt = time.Now()
+	venueEntity.UpdatedAt = time.Now()
+
+	venueID, err := u.venueRepo.Create(ctx, venueEntity)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось создать место проведения", err)
+	}
+
+	createdVenue, err := u.venueRepo.FindByID(ctx, venueID)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось найти созданное место проведения", err)
+	}
+
+	return entityToGraphQLVenue(createdVenue), nil
+}
+
+// UpdateVenue implements UseCase.UpdateVenue
+func (u *UseCaseImpl) UpdateVenue(ctx context.Context, id string, input *model.VenueInput, photos []*PhotoUpload) (*model.Venue, error) {
+	if input == nil {
+		return nil, fmt.Errorf("Входные данные не могут быть пустыми")
+	}
+
+	existingVenue, err := u.venueRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("Место проведения не найдено")
+	}
+
+	updatedVenue, err := venueFromInput(input)
+	if err != nil {
+		return nil, err
+	}
+	updatedVenue.ID = existingVenue.ID
+	updatedVenue.CreatedAt = existingVenue.CreatedAt
+	updatedVenue.UpdatedAt = time.Now()
+
+	updatedVenue.Photos = existingVenue.Photos
+	if input.RemoveAllPhotos != nil && *input.RemoveAllPhotos {
+		updatedVenue.Photos = []interface{}{}
+	}
+
+	newPhotos, err := readVenuePhotos(u.venueStorageAccount(), photos, len(updatedVenue.Photos))
+	if err != nil {
+		return nil, err
+	}
+	updatedVenue.Photos = append(updatedVenue.Photos, newPhotos...)
+
+	err = u.venueRepo.Update(ctx, id, updatedVenue)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось обновить место проведения", err)
+	}
+
+	updatedVenueDoc, err := u.venueRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, apperrors.WrapError("Не удалось найти обновленное место проведения", err)
+	}
+
+	return entityToGraphQLVenue(updatedVenueDoc), nil
+}
+
+// DeleteVenue implements UseCase.DeleteVenue
+// A venue referenced by competitions cannot be deleted
+func (u *UseCaseImpl) DeleteVenue(ctx context.Context, id string) (bool, error) {
+	competitions, err := u.competitionRepo.FindByVenueID(ctx, id)
+	if err != nil {
+		return false, apperrors.WrapError("Не удалось проверить соревнования места проведения", err)
+	}
+	if len(competitions) > 0 {
+		return false, fmt.Errorf("Место проведения используется в %d соревнованиях", len(competitions))
+	}
+
+	err = u.venueRepo.Delete(ctx, id)
+	if err != nil {
+		return false, apperrors.WrapError("Не удалось удалить место проведения", err)
+	}
+
+	return true, nil
+}
+
+// GetVenuePhoto implements UseCase.GetVenuePhoto
+// index is the position of the photo, as in the photo URLs of venues
+func (u *UseCaseImpl) GetVenuePhoto(ctx context.Context, venueID string, index int) (*ExportFile, error) {
+	venue, err := u.venueRepo.FindByID(ctx, venueID)
+	if err != nil {
+		return nil, fmt.Errorf("Место проведения не найдено")
+	}
+	if index < 0 || index >= len(venue.Photos) {
+		return nil, fmt.Errorf("Фотография не найдена")
+	}
+
+	photo, _ := venue.Photos[index].(map[string]interface{})
+	data, _ := photo["data"].([]byte)
+	contentType, _ := photo["contentType"].(string)
+	if len(data) == 0 || contentType == "" {
+		return nil, fmt.Errorf("Фотография не найдена")
+	}
+	return &ExportFile{
+		Data:        data,
+		ContentType: contentType,
+		FileName:    fmt.Sprintf("venue-photo-%d", index),
+	}, nil
+}
+
+// venueFromInput validates venue input and converts it to a domain entity (without photos)
+func venueFromInput(input *model.VenueInput) (*entity.Venue, error) {
+	nameRu := strings.TrimSpace(input.NameRu)
+	nameRo := strings.TrimSpace(input.NameRo)
+	if nameRu == "" || len([]rune(nameRu)) > maxVenueNameLength {
+		return nil, fmt.Errorf("Название (RU) должно быть от 1 до %d символов", maxVenueNameLength)
+	}
+	if nameRo == "" || len([]rune(nameRo)) > maxVenueNameLength {
+		return nil, fmt.Errorf("Название (RO) должно быть от 1 до %d символов", maxVenueNameLength)
+	}
+
+	if err := validateCoordinates(input.Lat, input.Lon); err != nil {
+		return nil, err
+	}
+
+	sectors := make([]entity.VenueSector, 0, len(input.Sectors))
+	for i, s := range input.Sectors {
+		name := strings.TrimSpace(s.Name)
+		if name == "" {
+			return nil, fmt.Errorf("Название сектора %d обязательно", i+1)
+		}
+		if s.PegFrom < 1 || s.PegTo < s.PegFrom {
+			return nil, fmt.Errorf("Неверный диапазон номеров в секторе %s", name)
+		}
+		sectors = append(sectors, entity.VenueSector{
+			Name:    name,
+			PegFrom: s.PegFrom,
+			PegTo:   s.PegTo,
+		})
+	}
+
+	var waterBody *string
+	if input.WaterBody != nil && strings.TrimSpace(*input.WaterBody) != "" {
+		w := strings.TrimSpace(*input.WaterBody)
+		waterBody = &w
+	}
+
+	var directions *string
+	if input.Directions != nil && strings.TrimSpace(*input.Directions) != "" {
+		d := strings.TrimSpace(*input.Directions)
+		if len([]rune(d)) > maxVenueDirectionsLength {
+			return nil, fmt.Errorf("Описание проезда должно быть не длиннее %d символов", maxVenueDirectionsLength)
+		}
+		directions = &d
+	}
+
+	return &entity.Venue{
+		NameRu:     nameRu,
+		NameRo:     nameRo,
+		Location:   entity.GeoPoint{Lat: input.Lat, Lon: input.Lon},
+		WaterBody:  waterBody,
+		Sectors:    sectors,
+		Directions: directions,
+	}, nil
+}
+
+// validateCoordinates checks that latitude and longitude are within valid ranges
+func validateCoordinates(lat, lon float64) error {
+	if math.IsNaN(lat) || lat < -90 || lat > 90 {
+		return fmt.Errorf("Неверная широта")
+	}
+	if math.IsNaN(lon) || lon < -180 || lon > 180 {
+		return fmt.Errorf("Неверная долгота")
+	}
+	return nil
+}
+
+// venueStorageAccount checks venue photos by the limits of admins, who edit venues;
+// the photos belong to the club, so they are not charged to anyone's quota
+func (u *UseCaseImpl) venueStorageAccount() *storageAccount {
+	return &storageAccount{limits: u.limitsFor(entity.RoleAdmin)}
+}
+
+// readVenuePhotos validates and reads venue photo uploads
+func readVenuePhotos(account *storageAccount, photos []*PhotoUpload, currentCount int) ([]interface{}, error) {
+	photosList := make([]interface{}, 0, len(photos))
+	if len(photos) == 0 {
+		return photosList, nil
+	}
+
+	if currentCount+len(photos) > maxVenuePhotos {
+		return nil, fmt.Errorf("Слишком много фотографий (макс %d)", maxVenuePhotos)
+	}
+
+	for _, upload := range photos {
+		if upload == nil {
+			continue
+		}
+
+		if err := account.checkFile(storageFilePhoto, upload.ContentType, upload.Size); err != nil {
+			return nil, err
+		}
+
+		data, err := io.ReadAll(upload.File)
+		if err != nil {
+			return nil, apperrors.WrapError("Не удалось прочитать файл фотографии", err)
+		}
+
+		photosList = append(photosList, map[string]interface{}{
+			"contentType": upload.ContentType,
+			"data":        data,
+		})
+	}
+
+	return photosList, nil
+}
+
+// Helper function to convert entity.Venue to model.Venue
+func entityToGraphQLVenue(venue *entity.Venue) *model.Venue {
+	if venue == nil {
+		return nil
+	}
+
+	sectors := make([]*model.VenueSector, len(venue.Sectors))
+	for i, s := range venue.Sectors {
+		sectors[i] = &model.VenueSector{
+			Name:    s.Name,
+			PegFrom: s.PegFrom,
+			PegTo:   s.PegTo,
+		}
+	}
+
+	photos := make([]*model.Photo, len(venue.Photos))
+	for i := range venue.Photos {
+		photos[i] = &model.Photo{
+			URL: fmt.Sprintf("/api/venues/%s/photos/%d", venue.ID, i),
+		}
+	}
+
+	var createdAt *scalars.Time
+	if !venue.CreatedAt.IsZero() {
+		t := scalars.Time(venue.CreatedAt)
+		createdAt = &t
+	}
+
+	var updatedAt *scalars.Time
+	if !venue.UpdatedAt.IsZero() {
+		t := scalars.Time(venue.UpdatedAt)
+		updatedAt = &t
+	}
+
+	return &model.Venue{
+		ID:     venue.ID,
+		NameRu: venue.NameRu,
+		NameRo: venue.NameRo,
+		Location: &model.GeoPoint{
+			Lat: venue.Location.Lat,
+			Lon: venue.Location.Lon,
+		},
+		WaterBody:  venue.WaterBody,
+		Sectors:    sectors,
+		Directions: venue.Directions,
+		Photos:     photos,
+		CreatedAt:  createdAt,
+		UpdatedAt:  updatedAt,
+	}
+}